@@ -9,6 +9,7 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/api"
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/json"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/rpc"
 )
@@ -29,6 +30,14 @@ type Client interface {
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) error
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
+	BackupDatabase(ctx context.Context, directory string, dbType string, options ...rpc.Option) (string, error)
+	GetBackupStatus(ctx context.Context, options ...rpc.Option) (*GetBackupStatusReply, error)
+	GetStorageUsage(ctx context.Context, options ...rpc.Option) (*GetStorageUsageReply, error)
+	BanPeer(ctx context.Context, nodeID *ids.NodeID, ip string, expiry uint64, options ...rpc.Option) error
+	UnbanPeer(ctx context.Context, nodeID *ids.NodeID, ip string, options ...rpc.Option) error
+	ListBans(ctx context.Context, options ...rpc.Option) ([]Ban, error)
+	StartCapture(ctx context.Context, chains []string, nodeIDs []ids.NodeID, maxFileSize uint32, maxFiles uint32, options ...rpc.Option) (string, error)
+	StopCapture(ctx context.Context, options ...rpc.Option) error
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -139,3 +148,67 @@ func (c *client) GetConfig(ctx context.Context, options ...rpc.Option) (interfac
 	err := c.requester.SendRequest(ctx, "admin.getConfig", struct{}{}, &res, options...)
 	return res, err
 }
+
+func (c *client) BackupDatabase(ctx context.Context, directory string, dbType string, options ...rpc.Option) (string, error) {
+	res := &BackupDatabaseReply{}
+	err := c.requester.SendRequest(ctx, "admin.backupDatabase", &BackupDatabaseArgs{
+		Directory: directory,
+		DBType:    dbType,
+	}, res, options...)
+	return res.Directory, err
+}
+
+func (c *client) GetBackupStatus(ctx context.Context, options ...rpc.Option) (*GetBackupStatusReply, error) {
+	res := &GetBackupStatusReply{}
+	err := c.requester.SendRequest(ctx, "admin.getBackupStatus", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetStorageUsage(ctx context.Context, options ...rpc.Option) (*GetStorageUsageReply, error) {
+	res := &GetStorageUsageReply{}
+	err := c.requester.SendRequest(ctx, "admin.getStorageUsage", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) BanPeer(ctx context.Context, nodeID *ids.NodeID, ip string, expiry uint64, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.banPeer", &BanPeerArgs{
+		NodeID: nodeID,
+		IP:     ip,
+		Expiry: json.Uint64(expiry),
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) UnbanPeer(ctx context.Context, nodeID *ids.NodeID, ip string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.unbanPeer", &UnbanPeerArgs{
+		NodeID: nodeID,
+		IP:     ip,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) ListBans(ctx context.Context, options ...rpc.Option) ([]Ban, error) {
+	res := &ListBansReply{}
+	err := c.requester.SendRequest(ctx, "admin.listBans", struct{}{}, res, options...)
+	return res.Bans, err
+}
+
+func (c *client) StartCapture(
+	ctx context.Context,
+	chains []string,
+	nodeIDs []ids.NodeID,
+	maxFileSize uint32,
+	maxFiles uint32,
+	options ...rpc.Option,
+) (string, error) {
+	res := &StartCaptureReply{}
+	err := c.requester.SendRequest(ctx, "admin.startCapture", &StartCaptureArgs{
+		Chains:      chains,
+		NodeIDs:     nodeIDs,
+		MaxFileSize: json.Uint32(maxFileSize),
+		MaxFiles:    json.Uint32(maxFiles),
+	}, res, options...)
+	return res.Path, err
+}
+
+func (c *client) StopCapture(ctx context.Context, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.stopCapture", struct{}{}, &api.EmptyReply{}, options...)
+}
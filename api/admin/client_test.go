@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -66,6 +67,21 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *GetLoggerLevelReply:
 		response := mc.response.(*GetLoggerLevelReply)
 		*p = *response
+	case *BackupDatabaseReply:
+		response := mc.response.(*BackupDatabaseReply)
+		*p = *response
+	case *GetBackupStatusReply:
+		response := mc.response.(*GetBackupStatusReply)
+		*p = *response
+	case *GetStorageUsageReply:
+		response := mc.response.(*GetStorageUsageReply)
+		*p = *response
+	case *ListBansReply:
+		response := mc.response.(*ListBansReply)
+		*p = *response
+	case *StartCaptureReply:
+		response := mc.response.(*StartCaptureReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -387,3 +403,156 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupDatabase(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedDirectory := "backup/pebble"
+		mockClient := client{requester: NewMockClient(&BackupDatabaseReply{
+			Directory: expectedDirectory,
+		}, nil)}
+
+		directory, err := mockClient.BackupDatabase(context.Background(), "backup", "pebble")
+		require.NoError(t, err)
+		require.Equal(t, expectedDirectory, directory)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&BackupDatabaseReply{}, errTest)}
+
+		_, err := mockClient.BackupDatabase(context.Background(), "backup", "pebble")
+
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetBackupStatus(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedStatus := &GetBackupStatusReply{
+			Directory:  "backup/pebble",
+			DBType:     "pebble",
+			InProgress: true,
+		}
+		mockClient := client{requester: NewMockClient(expectedStatus, nil)}
+
+		status, err := mockClient.GetBackupStatus(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expectedStatus, status)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetBackupStatusReply{}, errTest)}
+
+		_, err := mockClient.GetBackupStatus(context.Background())
+
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetStorageUsage(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedReply := &GetStorageUsageReply{
+			Usage: map[string]StorageUsage{
+				"keystore": {
+					NumKeys:  1,
+					NumBytes: 2,
+				},
+			},
+			LastUpdated: time.Unix(1, 0),
+		}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.GetStorageUsage(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetStorageUsageReply{}, errTest)}
+
+		_, err := mockClient.GetStorageUsage(context.Background())
+
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestBanPeer(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		nodeID := ids.GenerateTestNodeID()
+		err := mockClient.BanPeer(context.Background(), &nodeID, "192.0.2.0/24", 0)
+		require.ErrorIs(t, err, test.Err)
+	}
+}
+
+func TestUnbanPeer(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.UnbanPeer(context.Background(), nil, "192.0.2.0/24")
+		require.ErrorIs(t, err, test.Err)
+	}
+}
+
+func TestListBans(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		nodeID := ids.GenerateTestNodeID()
+		expectedBans := []Ban{
+			{
+				NodeID: &nodeID,
+			},
+			{
+				IP:     "192.0.2.0/24",
+				Expiry: 1,
+			},
+		}
+		mockClient := client{requester: NewMockClient(&ListBansReply{
+			Bans: expectedBans,
+		}, nil)}
+
+		bans, err := mockClient.ListBans(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expectedBans, bans)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&ListBansReply{}, errTest)}
+
+		_, err := mockClient.ListBans(context.Background())
+
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestStartCapture(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedPath := "captures/capture-1.bin"
+		mockClient := client{requester: NewMockClient(&StartCaptureReply{
+			Path: expectedPath,
+		}, nil)}
+
+		path, err := mockClient.StartCapture(context.Background(), []string{"X"}, nil, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, expectedPath, path)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&StartCaptureReply{}, errTest)}
+
+		_, err := mockClient.StartCapture(context.Background(), nil, nil, 0, 0)
+
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestStopCapture(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.StopCapture(context.Background())
+		require.ErrorIs(t, err, test.Err)
+	}
+}
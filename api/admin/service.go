@@ -5,8 +5,11 @@ package admin
 
 import (
 	"errors"
+	"net"
 	"net/http"
 	"path"
+	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -15,7 +18,12 @@ import (
 	"github.com/VidarSolutions/avalanchego/api"
 	"github.com/VidarSolutions/avalanchego/api/server"
 	"github.com/VidarSolutions/avalanchego/chains"
+	"github.com/VidarSolutions/avalanchego/database/manager"
+	"github.com/VidarSolutions/avalanchego/database/migrate"
+	"github.com/VidarSolutions/avalanchego/database/usage"
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/capture"
 	"github.com/VidarSolutions/avalanchego/snow/engine/common"
 	"github.com/VidarSolutions/avalanchego/utils"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
@@ -23,6 +31,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/perms"
 	"github.com/VidarSolutions/avalanchego/utils/profiler"
+	"github.com/VidarSolutions/avalanchego/utils/set"
 	"github.com/VidarSolutions/avalanchego/vms"
 	"github.com/VidarSolutions/avalanchego/vms/registry"
 )
@@ -32,11 +41,20 @@ const (
 
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
+
+	// Size, in megabytes, that capture files are rotated at if the size
+	// isn't specified
+	defaultCaptureMaxFileSize = 100
 )
 
 var (
-	errAliasTooLong = errors.New("alias length is too long")
-	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errAliasTooLong      = errors.New("alias length is too long")
+	errNoLogLevel        = errors.New("need to specify either displayLevel or logLevel")
+	errNoBackupDirectory = errors.New("need to specify a backup directory")
+	errBackupInProgress  = errors.New("a database backup is already in progress")
+	errNoBackup          = errors.New("no database backup has been started")
+	errNoStorageUsage    = errors.New("storage usage tracking is disabled")
+	errNoBanTarget       = errors.New("need to specify either nodeID or ip")
 )
 
 type Config struct {
@@ -48,12 +66,28 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	DBManager    manager.Manager
+	// DBType and DBConfig describe the database the node was started with.
+	// Backups are written using this database type unless told otherwise.
+	DBType   string
+	DBConfig []byte
+	// StorageUsage is nil if storage usage tracking is disabled
+	StorageUsage *usage.Tracker
+	Banlist      banlist.Banlist
+	Recorder     capture.Recorder
 }
 
 // Admin is the API service for node admin management
 type Admin struct {
 	Config
 	profiler profiler.Profiler
+
+	// Held while a database backup is being taken
+	backupLock sync.Mutex
+
+	backupStatusLock sync.RWMutex
+	// Status of the most recently started database backup
+	backupStatus *GetBackupStatusReply
 }
 
 // NewService returns a new admin API service.
@@ -345,3 +379,368 @@ func (a *Admin) LoadVMs(r *http.Request, _ *struct{}, reply *LoadVMsReply) error
 	reply.NewVMs, err = ids.GetRelevantAliases(a.VMManager, loadedVMs)
 	return err
 }
+
+// BackupDatabaseArgs are the arguments for calling BackupDatabase
+type BackupDatabaseArgs struct {
+	// Directory to write the backup to. The versioned databases are written
+	// using the same layout as the node's database directory, so the
+	// directory can be used as a database directory once the backup has
+	// completed. The directory must be empty or not exist.
+	Directory string `json:"directory"`
+	// DBType is the database type to write the backup with. If empty, the
+	// node's database type is used.
+	DBType string `json:"dbType"`
+}
+
+// BackupDatabaseReply is the response from calling BackupDatabase
+type BackupDatabaseReply struct {
+	// Directory that contains the versioned databases
+	Directory string `json:"directory"`
+}
+
+// BackupDatabase starts writing a consistent snapshot of the node's databases
+// to a target directory. The backup is taken in the background and the node
+// continues to process requests while it runs. GetBackupStatus reports when
+// the backup has finished.
+func (a *Admin) BackupDatabase(_ *http.Request, args *BackupDatabaseArgs, reply *BackupDatabaseReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "backupDatabase"),
+		logging.UserString("directory", args.Directory),
+		logging.UserString("dbType", args.DBType),
+	)
+
+	if len(args.Directory) == 0 {
+		return errNoBackupDirectory
+	}
+	if !a.backupLock.TryLock() {
+		return errBackupInProgress
+	}
+
+	dst := migrate.DBConfig{
+		Name:   a.DBType,
+		Path:   args.Directory,
+		Config: a.DBConfig,
+	}
+	if len(args.DBType) > 0 && args.DBType != a.DBType {
+		// The node's database config is specific to its database type.
+		dst.Name = args.DBType
+		dst.Config = nil
+	}
+
+	a.backupStatusLock.Lock()
+	a.backupStatus = &GetBackupStatusReply{
+		Directory:  dst.Dir(),
+		DBType:     dst.Name,
+		InProgress: true,
+		StartTime:  time.Now(),
+	}
+	a.backupStatusLock.Unlock()
+
+	a.Log.Info("starting database backup",
+		zap.String("dbType", dst.Name),
+		zap.String("directory", dst.Dir()),
+	)
+	go a.Log.RecoverAndPanic(func() {
+		defer a.backupLock.Unlock()
+
+		err := migrate.Backup(a.Log, a.DBManager, dst, migrate.DefaultBatchSize)
+		if err != nil {
+			a.Log.Warn("database backup failed",
+				zap.String("directory", dst.Dir()),
+				zap.Error(err),
+			)
+		} else {
+			a.Log.Info("finished database backup",
+				zap.String("directory", dst.Dir()),
+			)
+		}
+
+		a.backupStatusLock.Lock()
+		defer a.backupStatusLock.Unlock()
+
+		a.backupStatus.InProgress = false
+		a.backupStatus.EndTime = time.Now()
+		if err != nil {
+			a.backupStatus.Error = err.Error()
+		}
+	})
+
+	reply.Directory = dst.Dir()
+	return nil
+}
+
+// GetBackupStatusReply is the response from calling GetBackupStatus
+type GetBackupStatusReply struct {
+	// Directory that contains the versioned databases
+	Directory string `json:"directory"`
+	// DBType the backup is being written with
+	DBType string `json:"dbType"`
+	// InProgress is true while the backup is still being written
+	InProgress bool      `json:"inProgress"`
+	StartTime  time.Time `json:"startTime"`
+	// EndTime is unset while the backup is in progress
+	EndTime time.Time `json:"endTime"`
+	// Error is the reason the backup failed, if it did
+	Error string `json:"error,omitempty"`
+}
+
+// GetBackupStatus returns the status of the most recently started database
+// backup
+func (a *Admin) GetBackupStatus(_ *http.Request, _ *struct{}, reply *GetBackupStatusReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getBackupStatus"),
+	)
+
+	a.backupStatusLock.RLock()
+	defer a.backupStatusLock.RUnlock()
+
+	if a.backupStatus == nil {
+		return errNoBackup
+	}
+	*reply = *a.backupStatus
+	return nil
+}
+
+// StorageUsage is the estimated storage used by a part of the node's database
+type StorageUsage struct {
+	NumKeys  json.Uint64 `json:"numKeys"`
+	NumBytes json.Uint64 `json:"numBytes"`
+}
+
+// GetStorageUsageReply is the response from calling GetStorageUsage
+type GetStorageUsageReply struct {
+	// Usage maps the name of each tracked part of the database to its
+	// estimated usage
+	Usage map[string]StorageUsage `json:"usage"`
+	// LastUpdated is when the estimates were last updated
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// GetStorageUsage returns the most recent estimate of the storage used by
+// each chain and subsystem of the node. The estimates are updated
+// periodically in the background, so they may not reflect recent writes.
+func (a *Admin) GetStorageUsage(_ *http.Request, _ *struct{}, reply *GetStorageUsageReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getStorageUsage"),
+	)
+
+	if a.StorageUsage == nil {
+		return errNoStorageUsage
+	}
+
+	estimates, lastUpdated := a.StorageUsage.Usage()
+	reply.Usage = make(map[string]StorageUsage, len(estimates))
+	for name, u := range estimates {
+		reply.Usage[name] = StorageUsage{
+			NumKeys:  json.Uint64(u.NumKeys),
+			NumBytes: json.Uint64(u.NumBytes),
+		}
+	}
+	reply.LastUpdated = lastUpdated
+	return nil
+}
+
+// BanPeerArgs are the arguments for calling BanPeer
+type BanPeerArgs struct {
+	// NodeID of the node to ban. If nil, no node is banned.
+	NodeID *ids.NodeID `json:"nodeID"`
+	// IP, such as "192.0.2.1", or CIDR, such as "192.0.2.0/24", to ban. If
+	// empty, no IPs are banned.
+	IP string `json:"ip"`
+	// Unix time the ban ends at. If 0, the ban never ends.
+	Expiry json.Uint64 `json:"expiry"`
+}
+
+// BanPeer prevents connections with a node, a range of IPs, or both. The bans
+// persist across restarts. Connections with the banned peers that are already
+// established are closed, and the banned peers are no longer dialed.
+func (a *Admin) BanPeer(_ *http.Request, args *BanPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "banPeer"),
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("ip", args.IP),
+		zap.Uint64("expiry", uint64(args.Expiry)),
+	)
+
+	if args.NodeID == nil && len(args.IP) == 0 {
+		return errNoBanTarget
+	}
+
+	var expiry time.Time
+	if args.Expiry != 0 {
+		expiry = time.Unix(int64(args.Expiry), 0)
+	}
+
+	// Parse the IPs before banning anything so that invalid arguments don't
+	// result in a partial ban.
+	var bannedIPs *net.IPNet
+	if len(args.IP) > 0 {
+		var err error
+		bannedIPs, err = banlist.ParseIPs(args.IP)
+		if err != nil {
+			return err
+		}
+	}
+
+	if args.NodeID != nil {
+		if err := a.Banlist.BanNode(*args.NodeID, expiry); err != nil {
+			return err
+		}
+	}
+	if bannedIPs != nil {
+		return a.Banlist.BanIPs(bannedIPs, expiry)
+	}
+	return nil
+}
+
+// UnbanPeerArgs are the arguments for calling UnbanPeer
+type UnbanPeerArgs struct {
+	// NodeID of the node to unban. If nil, no node is unbanned.
+	NodeID *ids.NodeID `json:"nodeID"`
+	// IP or CIDR to unban. Must be the same range that was banned. If empty,
+	// no IPs are unbanned.
+	IP string `json:"ip"`
+}
+
+// UnbanPeer removes the bans of a node, a range of IPs, or both
+func (a *Admin) UnbanPeer(_ *http.Request, args *UnbanPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "unbanPeer"),
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("ip", args.IP),
+	)
+
+	if args.NodeID == nil && len(args.IP) == 0 {
+		return errNoBanTarget
+	}
+
+	var unbannedIPs *net.IPNet
+	if len(args.IP) > 0 {
+		var err error
+		unbannedIPs, err = banlist.ParseIPs(args.IP)
+		if err != nil {
+			return err
+		}
+	}
+
+	if args.NodeID != nil {
+		if err := a.Banlist.UnbanNode(*args.NodeID); err != nil {
+			return err
+		}
+	}
+	if unbannedIPs != nil {
+		return a.Banlist.UnbanIPs(unbannedIPs)
+	}
+	return nil
+}
+
+// Ban is a ban of either a node or a range of IPs
+type Ban struct {
+	NodeID *ids.NodeID `json:"nodeID,omitempty"`
+	IP     string      `json:"ip,omitempty"`
+	// Unix time the ban ends at. If 0, the ban never ends.
+	Expiry json.Uint64 `json:"expiry"`
+}
+
+// ListBansReply is the response from calling ListBans
+type ListBansReply struct {
+	Bans []Ban `json:"bans"`
+}
+
+// ListBans returns the bans that haven't ended
+func (a *Admin) ListBans(_ *http.Request, _ *struct{}, reply *ListBansReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "listBans"),
+	)
+
+	bans := a.Banlist.List()
+	reply.Bans = make([]Ban, len(bans))
+	for i, ban := range bans {
+		if !ban.Expiry.IsZero() {
+			reply.Bans[i].Expiry = json.Uint64(ban.Expiry.Unix())
+		}
+		if ban.IPs != nil {
+			reply.Bans[i].IP = ban.IPs.String()
+			continue
+		}
+		nodeID := ban.NodeID
+		reply.Bans[i].NodeID = &nodeID
+	}
+	return nil
+}
+
+// StartCaptureArgs are the arguments for calling StartCapture
+type StartCaptureArgs struct {
+	// Chains, by ID or alias, whose messages are recorded. If empty, the
+	// messages of every chain, and the messages that aren't sent to a chain,
+	// are recorded.
+	Chains []string `json:"chains"`
+	// NodeIDs of the peers whose messages are recorded. If empty, the
+	// messages of every peer are recorded.
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// Size, in megabytes, that the capture file is rotated at. If 0, it is
+	// rotated at 100 megabytes.
+	MaxFileSize json.Uint32 `json:"maxFileSize"`
+	// Number of rotated capture files to keep. If 0, every rotated file is
+	// kept.
+	MaxFiles json.Uint32 `json:"maxFiles"`
+}
+
+// StartCaptureReply is the response from calling StartCapture
+type StartCaptureReply struct {
+	// Path of the capture file
+	Path string `json:"path"`
+}
+
+// StartCapture starts recording the messages sent to and received from peers
+// to a capture file in the node's capture directory. Each message is recorded
+// with its op, the time it was sent or received, the peer's node ID and its
+// bytes. Only one capture can be in progress at a time.
+func (a *Admin) StartCapture(_ *http.Request, args *StartCaptureArgs, reply *StartCaptureReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "startCapture"),
+		logging.UserStrings("chains", args.Chains),
+		zap.Int("numNodeIDs", len(args.NodeIDs)),
+		zap.Uint32("maxFileSize", uint32(args.MaxFileSize)),
+		zap.Uint32("maxFiles", uint32(args.MaxFiles)),
+	)
+
+	config := capture.Config{
+		ChainIDs:    set.NewSet[ids.ID](len(args.Chains)),
+		NodeIDs:     set.NewSet[ids.NodeID](len(args.NodeIDs)),
+		MaxFileSize: int(args.MaxFileSize),
+		MaxFiles:    int(args.MaxFiles),
+	}
+	config.NodeIDs.Add(args.NodeIDs...)
+	for _, chain := range args.Chains {
+		chainID, err := a.ChainManager.Lookup(chain)
+		if err != nil {
+			return err
+		}
+		config.ChainIDs.Add(chainID)
+	}
+	if config.MaxFileSize == 0 {
+		config.MaxFileSize = defaultCaptureMaxFileSize
+	}
+
+	path, err := a.Recorder.Start(config)
+	reply.Path = path
+	return err
+}
+
+// StopCapture stops the capture in progress
+func (a *Admin) StopCapture(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "stopCapture"),
+	)
+
+	return a.Recorder.Stop()
+}
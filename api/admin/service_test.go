@@ -4,15 +4,30 @@
 package admin
 
 import (
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/database/manager"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/migrate"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/capture"
+	"github.com/VidarSolutions/avalanchego/utils/json"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/perms"
+	"github.com/VidarSolutions/avalanchego/version"
 	"github.com/VidarSolutions/avalanchego/vms"
 	"github.com/VidarSolutions/avalanchego/vms/registry"
 )
@@ -118,3 +133,213 @@ func TestLoadVMsGetAliasesFails(t *testing.T) {
 
 	require.Equal(t, err, errTest)
 }
+
+// Tests that BackupDatabase copies the node's databases into the backup
+// directory.
+func TestBackupDatabaseSuccess(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+	dbManager, err := manager.NewManagerFromDBs([]*manager.VersionedDatabase{
+		{
+			Database: db,
+			Version:  version.Semantic1_0_0,
+		},
+	})
+	require.NoError(err)
+
+	admin := &Admin{Config: Config{
+		Log:       logging.NoLog{},
+		DBManager: dbManager,
+		DBType:    memdb.Name,
+	}}
+
+	// A directory must be provided
+	reply := BackupDatabaseReply{}
+	err = admin.BackupDatabase(&http.Request{}, &BackupDatabaseArgs{}, &reply)
+	require.ErrorIs(err, errNoBackupDirectory)
+
+	// No backup has been started yet
+	status := GetBackupStatusReply{}
+	err = admin.GetBackupStatus(&http.Request{}, nil, &status)
+	require.ErrorIs(err, errNoBackup)
+
+	dir := t.TempDir()
+	err = admin.BackupDatabase(&http.Request{}, &BackupDatabaseArgs{
+		Directory: dir,
+		DBType:    pebble.Name,
+	}, &reply)
+	require.NoError(err)
+	require.Equal(filepath.Join(dir, pebble.Name), reply.Directory)
+
+	// The backup is taken in the background
+	require.Eventually(func() bool {
+		require.NoError(admin.GetBackupStatus(&http.Request{}, nil, &status))
+		return !status.InProgress
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Empty(status.Error)
+	require.Equal(reply.Directory, status.Directory)
+	require.Equal(pebble.Name, status.DBType)
+	require.False(status.EndTime.Before(status.StartTime))
+
+	backupDB, err := pebble.New(filepath.Join(reply.Directory, version.Semantic1_0_0.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	value, err := backupDB.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.NoError(backupDB.Close())
+}
+
+// Tests that a failed background backup is reported by GetBackupStatus.
+func TestBackupDatabaseFailure(t *testing.T) {
+	require := require.New(t)
+
+	dbManager, err := manager.NewManagerFromDBs([]*manager.VersionedDatabase{
+		{
+			Database: memdb.New(),
+			Version:  version.Semantic1_0_0,
+		},
+	})
+	require.NoError(err)
+
+	admin := &Admin{Config: Config{
+		Log:       logging.NoLog{},
+		DBManager: dbManager,
+		DBType:    memdb.Name,
+	}}
+
+	// The backup target must be empty
+	dir := t.TempDir()
+	require.NoError(os.MkdirAll(filepath.Join(dir, pebble.Name, "existing"), perms.ReadWriteExecute))
+
+	reply := BackupDatabaseReply{}
+	err = admin.BackupDatabase(&http.Request{}, &BackupDatabaseArgs{
+		Directory: dir,
+		DBType:    pebble.Name,
+	}, &reply)
+	require.NoError(err)
+
+	status := GetBackupStatusReply{}
+	require.Eventually(func() bool {
+		require.NoError(admin.GetBackupStatus(&http.Request{}, nil, &status))
+		return !status.InProgress
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Contains(status.Error, migrate.ErrTargetNotEmpty.Error())
+
+	// A new backup can be started once the previous one has finished
+	err = admin.BackupDatabase(&http.Request{}, &BackupDatabaseArgs{
+		Directory: t.TempDir(),
+		DBType:    pebble.Name,
+	}, &reply)
+	require.NoError(err)
+	require.Eventually(func() bool {
+		require.NoError(admin.GetBackupStatus(&http.Request{}, nil, &status))
+		return !status.InProgress
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Empty(status.Error)
+}
+
+// Tests that GetStorageUsage fails if storage usage tracking is disabled.
+func TestGetStorageUsageDisabled(t *testing.T) {
+	admin := &Admin{Config: Config{
+		Log: logging.NoLog{},
+	}}
+
+	reply := GetStorageUsageReply{}
+	err := admin.GetStorageUsage(&http.Request{}, nil, &reply)
+	require.ErrorIs(t, err, errNoStorageUsage)
+}
+
+// Tests that BanPeer, UnbanPeer and ListBans update and report the banlist.
+func TestBanPeerAndListBans(t *testing.T) {
+	require := require.New(t)
+
+	bans, err := banlist.New(memdb.New())
+	require.NoError(err)
+	admin := &Admin{Config: Config{
+		Log:     logging.NoLog{},
+		Banlist: bans,
+	}}
+
+	// Either a node or IPs must be provided
+	err = admin.BanPeer(&http.Request{}, &BanPeerArgs{}, nil)
+	require.ErrorIs(err, errNoBanTarget)
+
+	// Nothing is banned if the IP is invalid
+	nodeID := ids.GenerateTestNodeID()
+	err = admin.BanPeer(&http.Request{}, &BanPeerArgs{
+		NodeID: &nodeID,
+		IP:     "192.0.2",
+	}, nil)
+	require.Error(err)
+	require.False(bans.IsNodeBanned(nodeID))
+
+	expiry := time.Now().Add(time.Hour).Unix()
+	err = admin.BanPeer(&http.Request{}, &BanPeerArgs{
+		NodeID: &nodeID,
+		IP:     "192.0.2.0/24",
+		Expiry: json.Uint64(expiry),
+	}, nil)
+	require.NoError(err)
+	require.True(bans.IsNodeBanned(nodeID))
+	require.True(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+
+	reply := ListBansReply{}
+	err = admin.ListBans(&http.Request{}, nil, &reply)
+	require.NoError(err)
+	require.ElementsMatch([]Ban{
+		{
+			NodeID: &nodeID,
+			Expiry: json.Uint64(expiry),
+		},
+		{
+			IP:     "192.0.2.0/24",
+			Expiry: json.Uint64(expiry),
+		},
+	}, reply.Bans)
+
+	err = admin.UnbanPeer(&http.Request{}, &UnbanPeerArgs{
+		IP: "192.0.2.0/24",
+	}, nil)
+	require.NoError(err)
+	require.True(bans.IsNodeBanned(nodeID))
+	require.False(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+
+	err = admin.UnbanPeer(&http.Request{}, &UnbanPeerArgs{
+		NodeID: &nodeID,
+	}, nil)
+	require.NoError(err)
+	require.False(bans.IsNodeBanned(nodeID))
+
+	err = admin.ListBans(&http.Request{}, nil, &reply)
+	require.NoError(err)
+	require.Empty(reply.Bans)
+}
+
+// Tests that StartCapture and StopCapture start and stop recording messages.
+func TestStartAndStopCapture(t *testing.T) {
+	require := require.New(t)
+
+	admin := &Admin{Config: Config{
+		Log:      logging.NoLog{},
+		Recorder: capture.NewRecorder(logging.NoLog{}, t.TempDir()),
+	}}
+
+	err := admin.StopCapture(&http.Request{}, nil, nil)
+	require.Error(err)
+
+	reply := StartCaptureReply{}
+	err = admin.StartCapture(&http.Request{}, &StartCaptureArgs{
+		NodeIDs: []ids.NodeID{ids.GenerateTestNodeID()},
+	}, &reply)
+	require.NoError(err)
+	require.NotEmpty(reply.Path)
+
+	// Only one capture can be in progress at a time
+	err = admin.StartCapture(&http.Request{}, &StartCaptureArgs{}, &reply)
+	require.Error(err)
+
+	err = admin.StopCapture(&http.Request{}, nil, nil)
+	require.NoError(err)
+}
@@ -24,6 +24,7 @@ type Client interface {
 	GetNetworkName(context.Context, ...rpc.Option) (string, error)
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, ...rpc.Option) ([]Peer, error)
+	PeerStats(context.Context, []ids.NodeID, ...rpc.Option) ([]PeerStats, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
 	GetTxFee(context.Context, ...rpc.Option) (*GetTxFeeResponse, error)
 	Uptime(context.Context, ids.ID, ...rpc.Option) (*UptimeResponse, error)
@@ -86,6 +87,14 @@ func (c *client) Peers(ctx context.Context, options ...rpc.Option) ([]Peer, erro
 	return res.Peers, err
 }
 
+func (c *client) PeerStats(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]PeerStats, error) {
+	res := &PeerStatsReply{}
+	err := c.requester.SendRequest(ctx, "info.peerStats", &PeersArgs{
+		NodeIDs: nodeIDs,
+	}, res, options...)
+	return res.Peers, err
+}
+
 func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...rpc.Option) (bool, error) {
 	res := &IsBootstrappedResponse{}
 	err := c.requester.SendRequest(ctx, "info.isBootstrapped", &IsBootstrappedArgs{
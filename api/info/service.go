@@ -249,6 +249,42 @@ func (i *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply) error
 	return nil
 }
 
+type PeerStats struct {
+	peer.Stats
+
+	Benched []ids.ID `json:"benched"`
+}
+
+// PeerStatsReply are the results from calling PeerStats
+type PeerStatsReply struct {
+	// Number of elements in [Peers]
+	NumPeers json.Uint64 `json:"numPeers"`
+	// Each element is the traffic stats of a peer
+	Peers []PeerStats `json:"peers"`
+}
+
+// PeerStats returns the traffic exchanged with the requested peers, or with
+// all peers if none are requested
+func (i *Info) PeerStats(_ *http.Request, args *PeersArgs, reply *PeerStatsReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "peerStats"),
+	)
+
+	peers := i.networking.PeerStats(args.NodeIDs)
+	peerStats := make([]PeerStats, len(peers))
+	for index, peer := range peers {
+		peerStats[index] = PeerStats{
+			Stats:   peer,
+			Benched: i.benchlist.GetBenched(peer.ID),
+		}
+	}
+
+	reply.Peers = peerStats
+	reply.NumPeers = json.Uint64(len(reply.Peers))
+	return nil
+}
+
 // IsBootstrappedArgs are the arguments for calling IsBootstrapped
 type IsBootstrappedArgs struct {
 	// Alias of the chain
@@ -54,6 +54,8 @@ import (
 	"github.com/VidarSolutions/avalanchego/vms/metervm"
 	"github.com/VidarSolutions/avalanchego/vms/platformvm/warp"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/builder"
+	"github.com/VidarSolutions/avalanchego/vms/rpcchainvm/grpcutils"
 	"github.com/VidarSolutions/avalanchego/vms/tracedvm"
 
 	dbManager "github.com/VidarSolutions/avalanchego/database/manager"
@@ -76,11 +78,11 @@ const (
 )
 
 var (
-	// Commonly shared VM DB prefix
-	vmDBPrefix = []byte("vm")
+	// VMDBPrefix is the commonly shared VM DB prefix
+	VMDBPrefix = []byte("vm")
 
 	// Bootstrapping prefixes for LinearizableVMs
-	vertexDBPrefix              = []byte("vertex")
+	VertexDBPrefix              = []byte("vertex")
 	vertexBootstrappingDBPrefix = []byte("vertex_bs")
 	txBootstrappingDBPrefix     = []byte("tx_bs")
 	blockBootstrappingDBPrefix  = []byte("block_bs")
@@ -607,10 +609,10 @@ func (m *manager) createAvalancheChain(
 		return nil, err
 	}
 	prefixDBManager := meterDBManager.NewPrefixDBManager(ctx.ChainID[:])
-	vmDBManager := prefixDBManager.NewPrefixDBManager(vmDBPrefix)
+	vmDBManager := prefixDBManager.NewPrefixDBManager(VMDBPrefix)
 
 	db := prefixDBManager.Current()
-	vertexDB := prefixdb.New(vertexDBPrefix, db.Database)
+	vertexDB := prefixdb.New(VertexDBPrefix, db.Database)
 	vertexBootstrappingDB := prefixdb.New(vertexBootstrappingDBPrefix, db.Database)
 	txBootstrappingDB := prefixdb.New(txBootstrappingDBPrefix, db.Database)
 	blockBootstrappingDB := prefixdb.New(blockBootstrappingDBPrefix, db.Database)
@@ -752,6 +754,10 @@ func (m *manager) createAvalancheChain(
 	if subnetCfg, ok := m.SubnetConfigs[ctx.SubnetID]; ok {
 		minBlockDelay = subnetCfg.ProposerMinBlockDelay
 	}
+	externalBuilder, err := m.newExternalBlockBuilder(ctx.SubnetID)
+	if err != nil {
+		return nil, fmt.Errorf("error while connecting to external block builder: %w", err)
+	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.ApricotPhase4MinPChainHeight),
@@ -778,6 +784,7 @@ func (m *manager) createAvalancheChain(
 		minBlockDelay,
 		m.StakingCert.PrivateKey.(crypto.Signer),
 		m.StakingCert.Leaf,
+		externalBuilder,
 	)
 
 	if m.MeterVMEnabled {
@@ -1019,7 +1026,7 @@ func (m *manager) createSnowmanChain(
 		return nil, err
 	}
 	prefixDBManager := meterDBManager.NewPrefixDBManager(ctx.ChainID[:])
-	vmDBManager := prefixDBManager.NewPrefixDBManager(vmDBPrefix)
+	vmDBManager := prefixDBManager.NewPrefixDBManager(VMDBPrefix)
 
 	db := prefixDBManager.Current()
 	bootstrappingDB := prefixdb.New(bootstrappingDB, db.Database)
@@ -1119,6 +1126,10 @@ func (m *manager) createSnowmanChain(
 	if subnetCfg, ok := m.SubnetConfigs[ctx.SubnetID]; ok {
 		minBlockDelay = subnetCfg.ProposerMinBlockDelay
 	}
+	externalBuilder, err := m.newExternalBlockBuilder(ctx.SubnetID)
+	if err != nil {
+		return nil, fmt.Errorf("error while connecting to external block builder: %w", err)
+	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.ApricotPhase4MinPChainHeight),
@@ -1137,6 +1148,7 @@ func (m *manager) createSnowmanChain(
 		minBlockDelay,
 		m.StakingCert.PrivateKey.(crypto.Signer),
 		m.StakingCert.Leaf,
+		externalBuilder,
 	)
 
 	if m.MeterVMEnabled {
@@ -1408,6 +1420,27 @@ func (m *manager) notifyRegistrants(name string, ctx *snow.ConsensusContext, vm
 	}
 }
 
+// newExternalBlockBuilder returns the external block builder that the
+// proposervm of a chain in [subnetID] should use, or nil if the subnet doesn't
+// configure one.
+func (m *manager) newExternalBlockBuilder(subnetID ids.ID) (builder.Builder, error) {
+	subnetCfg, ok := m.SubnetConfigs[subnetID]
+	if !ok || subnetCfg.ProposerBlockBuilderAddress == "" {
+		return nil, nil
+	}
+
+	m.Log.Info("using external block builder",
+		zap.Stringer("subnetID", subnetID),
+		zap.String("address", subnetCfg.ProposerBlockBuilderAddress),
+		zap.Duration("timeout", subnetCfg.ProposerBlockBuilderTimeout),
+	)
+	conn, err := grpcutils.Dial(subnetCfg.ProposerBlockBuilderAddress)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewClient(conn, subnetCfg.ProposerBlockBuilderTimeout), nil
+}
+
 // getChainConfig returns value of a entry by looking at ID key and alias key
 // it first searches ID key, then falls back to it's corresponding primary alias
 func (m *manager) getChainConfig(id ids.ID) (ChainConfig, error) {
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package verify checks the integrity of the chain state stored in a node's
+// database without starting the chains.
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/VidarSolutions/avalanchego/chains"
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/snow/choices"
+	"github.com/VidarSolutions/avalanchego/snow/engine/avalanche/state"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/vms/avm/blocks"
+	"github.com/VidarSolutions/avalanchego/vms/avm/fxs"
+	"github.com/VidarSolutions/avalanchego/vms/avm/states"
+	"github.com/VidarSolutions/avalanchego/vms/nftfx"
+	"github.com/VidarSolutions/avalanchego/vms/propertyfx"
+	"github.com/VidarSolutions/avalanchego/vms/secp256k1fx"
+
+	platformstate "github.com/VidarSolutions/avalanchego/vms/platformvm/state"
+)
+
+var (
+	ErrInvalidPChainState = errors.New("invalid P-chain state")
+	ErrInvalidXChainState = errors.New("invalid X-chain state")
+
+	errMissingEdge = errors.New("missing accepted frontier")
+)
+
+// PrimaryNetworkState returns an error if the last accepted blocks of the
+// P-chain and the X-chain aren't present in [db] or can't be parsed. [db] must
+// be the database that the chain manager is created with.
+//
+// If the X-chain hasn't been linearized yet, its accepted frontier is verified
+// instead.
+func PrimaryNetworkState(
+	log logging.Logger,
+	db database.Database,
+	xChainID ids.ID,
+) error {
+	pChainBlk, err := platformstate.GetLastAcceptedBlock(vmDB(db, constants.PlatformChainID))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPChainState, err)
+	}
+	log.Info("verified P-chain state",
+		zap.Stringer("lastAcceptedID", pChainBlk.ID()),
+		zap.Uint64("lastAcceptedHeight", pChainBlk.Height()),
+	)
+
+	parser, err := blocks.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+		&propertyfx.Fx{},
+	})
+	if err != nil {
+		return err
+	}
+	xChainBlk, err := states.GetLastAcceptedBlock(vmDB(db, xChainID), parser)
+	switch {
+	case err == nil:
+		log.Info("verified X-chain state",
+			zap.Stringer("lastAcceptedID", xChainBlk.ID()),
+			zap.Uint64("lastAcceptedHeight", xChainBlk.Height()),
+		)
+		return nil
+	case errors.Is(err, states.ErrNotLinearized):
+		return verifyAcceptedFrontier(log, db, xChainID)
+	default:
+		return fmt.Errorf("%w: %w", ErrInvalidXChainState, err)
+	}
+}
+
+// verifyAcceptedFrontier verifies that every vertex in the accepted frontier
+// of the DAG of [chainID] is accepted and can be parsed.
+func verifyAcceptedFrontier(
+	log logging.Logger,
+	db database.Database,
+	chainID ids.ID,
+) error {
+	chainDB := prefixdb.New(chainID[:], db)
+	serializer := state.NewSerializer(state.SerializerConfig{
+		ChainID: chainID,
+		DB:      prefixdb.New(chains.VertexDBPrefix, chainDB),
+		Log:     log,
+	})
+
+	ctx := context.Background()
+	edge := serializer.Edge(ctx)
+	if len(edge) == 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidXChainState, errMissingEdge)
+	}
+	for _, vtxID := range edge {
+		vtx, err := serializer.GetVtx(ctx, vtxID)
+		if err != nil {
+			return fmt.Errorf("%w: failed to read accepted vertex %s: %w", ErrInvalidXChainState, vtxID, err)
+		}
+		if status := vtx.Status(); status != choices.Accepted {
+			return fmt.Errorf("%w: accepted vertex %s has status %s", ErrInvalidXChainState, vtxID, status)
+		}
+		if _, err := vtx.Height(); err != nil {
+			return fmt.Errorf("%w: failed to parse accepted vertex %s: %w", ErrInvalidXChainState, vtxID, err)
+		}
+	}
+	log.Info("verified X-chain state",
+		zap.Int("acceptedFrontierSize", len(edge)),
+	)
+	return nil
+}
+
+// vmDB returns the database that the VM of [chainID] is initialized with.
+func vmDB(db database.Database, chainID ids.ID) database.Database {
+	return prefixdb.New(chains.VMDBPrefix, prefixdb.New(chainID[:], db))
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package verify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+)
+
+func TestPrimaryNetworkStateMissingPChain(t *testing.T) {
+	err := PrimaryNetworkState(logging.NoLog{}, memdb.New(), ids.GenerateTestID())
+	require.ErrorIs(t, err, ErrInvalidPChainState)
+}
+
+func TestPrimaryNetworkStateCorruptPChain(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	pChainDB := vmDB(db, constants.PlatformChainID)
+
+	// These are the keys that the P-chain state stores its last accepted
+	// block under.
+	blkID := ids.GenerateTestID()
+	require.NoError(database.PutID(prefixdb.New([]byte("singleton"), pChainDB), []byte("last accepted"), blkID))
+	require.NoError(prefixdb.New([]byte("block"), pChainDB).Put(blkID[:], []byte("corrupt")))
+
+	err := PrimaryNetworkState(logging.NoLog{}, db, ids.GenerateTestID())
+	require.ErrorIs(err, ErrInvalidPChainState)
+}
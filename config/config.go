@@ -38,6 +38,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/staking"
 	"github.com/VidarSolutions/avalanchego/subnets"
 	"github.com/VidarSolutions/avalanchego/trace"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/crypto/bls"
 	"github.com/VidarSolutions/avalanchego/utils/dynamicip"
@@ -51,6 +52,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/utils/timer"
 	"github.com/VidarSolutions/avalanchego/vms/platformvm/reward"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/builder"
 )
 
 const (
@@ -61,7 +63,9 @@ const (
 )
 
 var (
-	deprecatedKeys = map[string]string{}
+	deprecatedKeys = map[string]string{
+		NetworkCompressionEnabledKey: fmt.Sprintf("use --%s instead", NetworkCompressionTypeKey),
+	}
 
 	errInvalidStakerWeights          = errors.New("staking weights must be positive")
 	errStakingDisableOnPublicNetwork = errors.New("staking disabled on public network")
@@ -80,6 +84,7 @@ var (
 	errMissingStakingSigningKeyFile  = errors.New("missing staking signing key file")
 	errTracingEndpointEmpty          = fmt.Errorf("%s cannot be empty", TracingEndpointKey)
 	errPluginDirNotADirectory        = errors.New("plugin dir is not a directory")
+	errConflictingCompressionConfig  = fmt.Errorf("cannot set both --%s and --%s", NetworkCompressionEnabledKey, NetworkCompressionTypeKey)
 )
 
 func getConsensusConfig(v *viper.Viper) avalanche.Parameters {
@@ -295,6 +300,22 @@ func getGossipConfig(v *viper.Viper) subnets.GossipConfig {
 	}
 }
 
+// getCompressionType returns the compression type for outbound messages.
+// The deprecated [NetworkCompressionEnabledKey] disables compression if it's
+// set to false.
+func getCompressionType(v *viper.Viper) (compression.Type, error) {
+	if v.IsSet(NetworkCompressionEnabledKey) {
+		if v.IsSet(NetworkCompressionTypeKey) {
+			return compression.TypeNone, errConflictingCompressionConfig
+		}
+		if v.GetBool(NetworkCompressionEnabledKey) {
+			return compression.TypeGzip, nil
+		}
+		return compression.TypeNone, nil
+	}
+	return compression.TypeFromString(v.GetString(NetworkCompressionTypeKey))
+}
+
 func getNetworkConfig(v *viper.Viper, stakingEnabled bool, halflife time.Duration) (network.Config, error) {
 	// Set the max number of recent inbound connections upgraded to be
 	// equal to the max number of inbound connections per second.
@@ -350,6 +371,8 @@ func getNetworkConfig(v *viper.Viper, stakingEnabled bool, halflife time.Duratio
 		ProxyEnabled:           v.GetBool(NetworkTCPProxyEnabledKey),
 		ProxyReadHeaderTimeout: v.GetDuration(NetworkTCPProxyReadTimeoutKey),
 
+		QUICEnabled: v.GetBool(NetworkQUICEnabledKey),
+
 		DialerConfig: dialer.Config{
 			ThrottleRps:       v.GetUint32(OutboundConnectionThrottlingRpsKey),
 			ConnectionTimeout: v.GetDuration(OutboundConnectionTimeoutKey),
@@ -376,7 +399,6 @@ func getNetworkConfig(v *viper.Viper, stakingEnabled bool, halflife time.Duratio
 		},
 
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
-		CompressionEnabled:           v.GetBool(NetworkCompressionEnabledKey),
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              v.GetBool(NetworkAllowPrivateIPsKey),
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
@@ -387,6 +409,18 @@ func getNetworkConfig(v *viper.Viper, stakingEnabled bool, halflife time.Duratio
 		PeerWriteBufferSize:       int(v.GetUint(NetworkPeerWriteBufferSizeKey)),
 	}
 
+	compressionType, err := getCompressionType(v)
+	if err != nil {
+		return network.Config{}, err
+	}
+	config.CompressionType = compressionType
+
+	if opLimits := v.GetString(InboundThrottlerOpLimitsKey); len(opLimits) > 0 {
+		if err := json.Unmarshal([]byte(opLimits), &config.ThrottlerConfig.InboundOpThrottlerConfig); err != nil {
+			return network.Config{}, fmt.Errorf("couldn't parse %s: %w", InboundThrottlerOpLimitsKey, err)
+		}
+	}
+
 	switch {
 	case config.HealthConfig.MaxTimeSinceMsgSent < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkHealthMaxTimeSinceMsgSentKey)
@@ -886,7 +920,9 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 			GetExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
 		),
-		Config: configBytes,
+		Config:                configBytes,
+		RestoreFrom:           GetExpandedArg(v, DBRestoreFromKey),
+		StorageUsageFrequency: v.GetDuration(DBStorageUsageFrequencyKey),
 	}, nil
 }
 
@@ -1132,10 +1168,11 @@ func getSubnetConfigsFromDir(v *viper.Viper, subnetIDs []ids.ID) (map[ids.ID]sub
 
 func getDefaultSubnetConfig(v *viper.Viper) subnets.Config {
 	return subnets.Config{
-		ConsensusParameters:   getConsensusConfig(v),
-		ValidatorOnly:         false,
-		GossipConfig:          getGossipConfig(v),
-		ProposerMinBlockDelay: proposervm.DefaultMinBlockDelay,
+		ConsensusParameters:         getConsensusConfig(v),
+		ValidatorOnly:               false,
+		GossipConfig:                getGossipConfig(v),
+		ProposerMinBlockDelay:       proposervm.DefaultMinBlockDelay,
+		ProposerBlockBuilderTimeout: builder.DefaultTimeout,
 	}
 }
 
@@ -1446,6 +1483,7 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	}
 
 	nodeConfig.ChainDataDir = GetExpandedArg(v, ChainDataDirKey)
+	nodeConfig.CaptureDir = GetExpandedArg(v, NetworkCaptureDirKey)
 
 	nodeConfig.ProvidedFlags = providedFlags(v)
 	return nodeConfig, nil
@@ -15,8 +15,10 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/database/leveldb"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
 	"github.com/VidarSolutions/avalanchego/genesis"
 	"github.com/VidarSolutions/avalanchego/trace"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/ulimit"
 	"github.com/VidarSolutions/avalanchego/utils/units"
@@ -48,6 +50,7 @@ var (
 	defaultSubnetConfigDir      = filepath.Join(defaultConfigDir, "subnets")
 	defaultPluginDir            = filepath.Join(defaultUnexpandedDataDir, "plugins")
 	defaultChainDataDir         = filepath.Join(defaultUnexpandedDataDir, "chainData")
+	defaultNetworkCaptureDir    = filepath.Join(defaultUnexpandedDataDir, "captures")
 )
 
 func addProcessFlags(fs *flag.FlagSet) {
@@ -89,10 +92,12 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Uint64(AddSubnetDelegatorFeeKey, genesis.LocalParams.AddSubnetDelegatorFee, "Transaction fee, in nVidar, for transactions that add new subnet delegators")
 
 	// Database
-	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s}", leveldb.Name, memdb.Name))
+	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s, %s}", leveldb.Name, memdb.Name, pebble.Name))
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
+	fs.Duration(DBStorageUsageFrequencyKey, 0, "Frequency to estimate the storage used by each chain and subsystem. Each estimate iterates over the database in the background, reading a limited number of keys per second. If 0, storage usage isn't tracked")
+	fs.String(DBRestoreFromKey, "", fmt.Sprintf("Path to a database backup to restore into %s before starting. The backup must use the database type specified by %s and is verified before it is restored. Once restored, the backup is ignored on later starts", DBPathKey, DBTypeKey))
 
 	// Logging
 	fs.String(LogsDirKey, defaultLogDir, "Logging directory for Avalanche")
@@ -143,6 +148,7 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
 	fs.Bool(NetworkCompressionEnabledKey, constants.DefaultNetworkCompressionEnabled, "If true, compress certain outbound messages. This node will be able to parse compressed inbound messages regardless of this flag's value")
+	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s, %s]. Peers that can't decompress this type are sent gzip compressed messages. This node will be able to parse compressed inbound messages regardless of this flag's value", compression.TypeGzip, compression.TypeZstd, compression.TypeNone))
 	fs.Duration(NetworkMaxClockDifferenceKey, constants.DefaultNetworkMaxClockDifference, "Max allowed clock difference value between this node and peers")
 	fs.Bool(NetworkAllowPrivateIPsKey, constants.DefaultNetworkAllowPrivateIPs, "Allows the node to initiate outbound connection attempts to peers with private IPs")
 	fs.Bool(NetworkRequireValidatorToConnectKey, constants.DefaultNetworkRequireValidatorToConnect, "If true, this node will only maintain a connection with another node if this node is a validator, the other node is a validator, or the other node is a beacon")
@@ -157,7 +163,10 @@ func addNodeFlags(fs *flag.FlagSet) {
 	// a timeout of 0 should generally not be provided.
 	fs.Duration(NetworkTCPProxyReadTimeoutKey, constants.DefaultNetworkTCPProxyReadTimeout, "Maximum duration to wait for a TCP proxy header")
 
+	fs.Bool(NetworkQUICEnabledKey, constants.DefaultNetworkQUICEnabled, "If true, QUIC connections are accepted on the UDP port with the same number as the staking port, and peers that accept QUIC connections are connected to over QUIC. Falls back to TCP if a QUIC connection can't be established")
+
 	fs.String(NetworkTLSKeyLogFileKey, "", "TLS key log file path. Should only be specified for debugging")
+	fs.String(NetworkCaptureDirKey, defaultNetworkCaptureDir, "Directory that captures of network messages, started with the admin API, are written to")
 
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, constants.DefaultBenchlistFailThreshold, "Number of consecutive failed queries before benchlisting a node")
@@ -186,6 +195,7 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Uint64(InboundThrottlerBandwidthMaxBurstSizeKey, constants.DefaultInboundThrottlerBandwidthMaxBurstSize, "Max inbound bandwidth a node can use at once. Must be at least the max message size. See BandwidthThrottler")
 	fs.Duration(InboundThrottlerCPUMaxRecheckDelayKey, constants.DefaultInboundThrottlerCPUMaxRecheckDelay, "In the CPU-based network throttler, check at least this often whether the node's CPU usage has fallen to an acceptable level")
 	fs.Duration(InboundThrottlerDiskMaxRecheckDelayKey, constants.DefaultInboundThrottlerDiskMaxRecheckDelay, "In the disk-based network throttler, check at least this often whether the node's disk usage has fallen to an acceptable level")
+	fs.String(InboundThrottlerOpLimitsKey, "", `JSON rate limits on the number of messages of each op a peer can send, with separate limits for validators and non-validators. Messages over the limit are dropped. Ops without a limit, and chits from validators, are never throttled. For example: {"nonValidatorLimits":{"get_ancestors":{"refillRate":10,"maxBurstSize":20}}}`)
 
 	// Outbound Throttling
 	fs.Uint64(OutboundThrottlerAtLargeAllocSizeKey, constants.DefaultOutboundThrottlerAtLargeAllocSize, "Size, in bytes, of at-large byte allocation in outbound message throttler")
@@ -83,6 +83,30 @@ type Compacter interface {
 	Compact(start []byte, limit []byte) error
 }
 
+// Snapshot is a read-only, consistent view of a backing data store at the time
+// the snapshot was created. Writes performed after the snapshot was created are
+// not visible through the snapshot.
+type Snapshot interface {
+	KeyValueReader
+	Iteratee
+
+	// Release releases the resources held by the snapshot. Iterators created
+	// by the snapshot should be released before the snapshot is released.
+	// After Release is called, reads from the snapshot return [ErrClosed].
+	Release()
+}
+
+// Snapshotter wraps the NewSnapshot method of a backing data store.
+//
+// Implementing Snapshotter is optional. Database wrappers should forward
+// NewSnapshot to the database they wrap and return [ErrSnapshotNotSupported]
+// if the wrapped database does not support snapshots.
+type Snapshotter interface {
+	// NewSnapshot returns a consistent, point-in-time view of the data store.
+	// The returned snapshot must be released once it is no longer needed.
+	NewSnapshot() (Snapshot, error)
+}
+
 // Database contains all the methods required to allow handling different
 // key-value data stores backing the database.
 type Database interface {
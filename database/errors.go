@@ -7,6 +7,7 @@ import "errors"
 
 // common errors
 var (
-	ErrClosed   = errors.New("closed")
-	ErrNotFound = errors.New("not found")
+	ErrClosed               = errors.New("closed")
+	ErrNotFound             = errors.New("not found")
+	ErrSnapshotNotSupported = errors.New("snapshots not supported")
 )
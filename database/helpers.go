@@ -177,3 +177,13 @@ func ClearPrefix(readerDB Iteratee, deleterDB KeyValueDeleter, prefix []byte) er
 	}
 	return iterator.Error()
 }
+
+// NewSnapshot returns a snapshot of [db]. If [db] doesn't support snapshots,
+// [ErrSnapshotNotSupported] is returned.
+func NewSnapshot(db KeyValueReader) (Snapshot, error) {
+	snapshotter, ok := db.(Snapshotter)
+	if !ok {
+		return nil, ErrSnapshotNotSupported
+	}
+	return snapshotter.NewSnapshot()
+}
@@ -66,9 +66,11 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iter)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iter)(nil)
+	_ database.Snapshot    = (*snapshot)(nil)
 )
 
 // Database is a persistent key-value store. Apart from basic data storage
@@ -327,14 +329,26 @@ func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
 // over the database starting at start and ignoring keys that do not start with
 // the provided prefix
 func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
-	iterRange := util.BytesPrefix(prefix)
-	if bytes.Compare(start, prefix) == 1 {
-		iterRange.Start = start
-	}
 	return &iter{
 		db:       db,
-		Iterator: db.DB.NewIterator(iterRange, nil),
+		Iterator: db.DB.NewIterator(keyRange(start, prefix), nil),
+	}
+}
+
+// NewSnapshot returns a consistent view of the database at the time of the
+// call.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	if db.closed.Get() {
+		return nil, database.ErrClosed
+	}
+	s, err := db.DB.GetSnapshot()
+	if err != nil {
+		return nil, updateError(err)
 	}
+	return &snapshot{
+		db:       db,
+		Snapshot: s,
+	}, nil
 }
 
 // This comment is basically copy pasted from the underlying levelDB library:
@@ -482,9 +496,63 @@ func (it *iter) Value() []byte {
 	return it.val
 }
 
+// snapshot is a wrapper around a levelDB snapshot.
+type snapshot struct {
+	db *Database
+	*leveldb.Snapshot
+}
+
+// Has returns if the key was set in the database when the snapshot was taken
+func (s *snapshot) Has(key []byte) (bool, error) {
+	if s.db.closed.Get() {
+		return false, database.ErrClosed
+	}
+	has, err := s.Snapshot.Has(key, nil)
+	return has, updateError(err)
+}
+
+// Get returns the value the key mapped to in the database when the snapshot
+// was taken
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	if s.db.closed.Get() {
+		return nil, database.ErrClosed
+	}
+	value, err := s.Snapshot.Get(key, nil)
+	return value, updateError(err)
+}
+
+func (s *snapshot) NewIterator() database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	return &iter{
+		db:       s.db,
+		Iterator: s.Snapshot.NewIterator(keyRange(start, prefix), nil),
+	}
+}
+
+// keyRange returns the range of keys that are >= [start] and have the prefix
+// [prefix].
+func keyRange(start, prefix []byte) *util.Range {
+	iterRange := util.BytesPrefix(prefix)
+	if bytes.Compare(start, prefix) == 1 {
+		iterRange.Start = start
+	}
+	return iterRange
+}
+
 func updateError(err error) error {
 	switch err {
-	case leveldb.ErrClosed:
+	case leveldb.ErrClosed, leveldb.ErrSnapshotReleased:
 		return database.ErrClosed
 	case leveldb.ErrNotFound:
 		return database.ErrNotFound
@@ -17,6 +17,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/database/leveldb"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/database/meterdb"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
 	"github.com/VidarSolutions/avalanchego/database/prefixdb"
 	"github.com/VidarSolutions/avalanchego/utils"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
@@ -96,6 +97,28 @@ func NewLevelDB(
 	)
 }
 
+// NewPebbleDB creates a database manager of pebble instances at [filePath] by
+// creating a database instance from each directory with a version <=
+// [currentVersion].
+func NewPebbleDB(
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion *version.Semantic,
+	namespace string,
+	reg prometheus.Registerer,
+) (Manager, error) {
+	return new(
+		pebble.New,
+		dbDirPath,
+		dbConfig,
+		log,
+		currentVersion,
+		namespace,
+		reg,
+	)
+}
+
 // new creates a database manager at [filePath] by creating a database instance
 // from each directory with a version <= [currentVersion]. If
 // [includePreviousVersions], opens previous database versions and includes them
@@ -22,9 +22,11 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iterator)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iterator)(nil)
+	_ database.Snapshot    = (*snapshot)(nil)
 )
 
 // Database is an ephemeral key-value store that implements the Database
@@ -165,6 +167,27 @@ func (db *Database) Compact(_, _ []byte) error {
 	return nil
 }
 
+// NewSnapshot returns a copy of the current contents of the database.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return nil, database.ErrClosed
+	}
+
+	// Values are never modified after being inserted, so they can be shared
+	// with the copy.
+	contents := NewWithSize(len(db.db))
+	for key, value := range db.db {
+		contents.db[key] = value
+	}
+	return &snapshot{
+		Database: contents,
+		parent:   db,
+	}, nil
+}
+
 func (db *Database) HealthCheck(context.Context) (interface{}, error) {
 	if db.isClosed() {
 		return nil, database.ErrClosed
@@ -254,3 +277,28 @@ func (it *iterator) Release() {
 	it.keys = nil
 	it.values = nil
 }
+
+// snapshot is a read-only copy of a memdb that becomes unusable once either
+// the copy is released or the database it was copied from is closed.
+type snapshot struct {
+	*Database
+	parent *Database
+}
+
+func (s *snapshot) Has(key []byte) (bool, error) {
+	if s.parent.isClosed() {
+		return false, database.ErrClosed
+	}
+	return s.Database.Has(key)
+}
+
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	if s.parent.isClosed() {
+		return nil, database.ErrClosed
+	}
+	return s.Database.Get(key)
+}
+
+func (s *snapshot) Release() {
+	_ = s.Database.Close()
+}
@@ -13,9 +13,10 @@ import (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iterator)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iterator)(nil)
 )
 
 // Database tracks the amount of time each operation takes and how many bytes
@@ -116,6 +117,12 @@ func (db *Database) NewIteratorWithStartAndPrefix(
 	return it
 }
 
+// NewSnapshot returns a snapshot of the underlying database. Reads from the
+// snapshot are not metered.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	return database.NewSnapshot(db.db)
+}
+
 func (db *Database) Compact(start, limit []byte) error {
 	startTime := db.clock.Time()
 	err := db.db.Compact(start, limit)
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+
+	"go.uber.org/zap"
+
+	"github.com/VidarSolutions/avalanchego/database/leveldb"
+	"github.com/VidarSolutions/avalanchego/database/migrate"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/version"
+)
+
+const (
+	networkIDKey          = "network-id"
+	sourceDBTypeKey       = "source-db-type"
+	sourceDBDirKey        = "source-db-dir"
+	sourceDBConfigFileKey = "source-db-config-file"
+	dbTypeKey             = "db-type"
+	dbDirKey              = "db-dir"
+	dbConfigFileKey       = "db-config-file"
+	batchSizeKey          = "batch-size"
+)
+
+var errMissingDir = errors.New("missing database directory")
+
+// This program converts the databases of a stopped node from one database
+// backend to another. Every versioned database is copied into the target
+// directory and verified against the source before the program exits
+// successfully.
+//
+// Example:
+//
+//	dbmigrate --source-db-type=leveldb --source-db-dir=$HOME/.avalanchego/db \
+//	  --db-type=pebble --db-dir=$HOME/.avalanchego/db-pebble
+func main() {
+	fs := pflag.NewFlagSet("dbmigrate", pflag.ContinueOnError)
+	networkName := fs.String(networkIDKey, constants.MainnetName, "Network ID the databases belong to")
+	sourceDBType := fs.String(sourceDBTypeKey, leveldb.Name, fmt.Sprintf("Database type to migrate from. Should be one of {%s, %s}", leveldb.Name, pebble.Name))
+	sourceDBDir := fs.String(sourceDBDirKey, "", "Path to the database directory to migrate from")
+	sourceDBConfigFile := fs.String(sourceDBConfigFileKey, "", "Path to the config file of the database to migrate from")
+	dbType := fs.String(dbTypeKey, pebble.Name, fmt.Sprintf("Database type to migrate to. Should be one of {%s, %s}", leveldb.Name, pebble.Name))
+	dbDir := fs.String(dbDirKey, "", "Path to the database directory to migrate to")
+	dbConfigFile := fs.String(dbConfigFileKey, "", "Path to the config file of the database to migrate to")
+	batchSize := fs.Int(batchSizeKey, migrate.DefaultBatchSize, "Number of bytes to write to the new database in a single batch")
+
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		if errors.Is(err, pflag.ErrHelp) {
+			os.Exit(0)
+		}
+		fmt.Printf("couldn't parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := run(*networkName, *sourceDBType, *sourceDBDir, *sourceDBConfigFile, *dbType, *dbDir, *dbConfigFile, *batchSize); err != nil {
+		fmt.Printf("failed to migrate database: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(
+	networkName string,
+	sourceDBType string,
+	sourceDBDir string,
+	sourceDBConfigFile string,
+	dbType string,
+	dbDir string,
+	dbConfigFile string,
+	batchSize int,
+) error {
+	networkID, err := constants.NetworkID(networkName)
+	if err != nil {
+		return err
+	}
+
+	src, err := dbConfig(networkID, sourceDBType, sourceDBDir, sourceDBConfigFile)
+	if err != nil {
+		return fmt.Errorf("invalid --%s: %w", sourceDBDirKey, err)
+	}
+	dst, err := dbConfig(networkID, dbType, dbDir, dbConfigFile)
+	if err != nil {
+		return fmt.Errorf("invalid --%s: %w", dbDirKey, err)
+	}
+
+	log := logging.NewLogger(
+		"dbmigrate",
+		logging.NewWrappedCore(
+			logging.Info,
+			os.Stdout,
+			logging.Plain.ConsoleEncoder(),
+		),
+	)
+	log.Info("starting database migration",
+		zap.String("sourceDBType", src.Name),
+		zap.String("sourceDBDir", src.Dir()),
+		zap.String("dbType", dst.Name),
+		zap.String("dbDir", dst.Dir()),
+	)
+	if err := migrate.Migrate(log, src, dst, version.CurrentDatabase, batchSize); err != nil {
+		return err
+	}
+	log.Info("finished database migration")
+	return nil
+}
+
+func dbConfig(networkID uint32, dbType, dbDir, configFile string) (migrate.DBConfig, error) {
+	if dbDir == "" {
+		return migrate.DBConfig{}, errMissingDir
+	}
+
+	var (
+		configBytes []byte
+		err         error
+	)
+	if configFile != "" {
+		configBytes, err = os.ReadFile(os.ExpandEnv(configFile))
+		if err != nil {
+			return migrate.DBConfig{}, err
+		}
+	}
+	return migrate.DBConfig{
+		Name: dbType,
+		Path: filepath.Join(
+			os.ExpandEnv(dbDir),
+			constants.NetworkName(networkID),
+		),
+		Config: configBytes,
+	}, nil
+}
@@ -0,0 +1,581 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/leveldb"
+	"github.com/VidarSolutions/avalanchego/database/manager"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/hashing"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/perms"
+	"github.com/VidarSolutions/avalanchego/utils/units"
+	"github.com/VidarSolutions/avalanchego/version"
+)
+
+const (
+	// PrefixLen is the number of leading key bytes that are used to group keys
+	// when computing a [Summary]. Every database created through prefixdb
+	// stores its keys behind a hashed prefix of this length.
+	PrefixLen = hashing.HashLen
+
+	// DefaultBatchSize is the number of bytes written to the target database
+	// in a single batch.
+	DefaultBatchSize = 4 * units.MiB
+
+	// currentFile is the name of the file that both leveldb and pebble use to
+	// point to the current manifest.
+	currentFile = "CURRENT"
+
+	// restoredFile is written next to the versioned databases once a backup
+	// has been restored into them. It records the directory that was
+	// restored so that the restore is only performed once.
+	restoredFile = "RESTORED"
+
+	// restoringSuffix is appended to the path of the database that a backup
+	// is being restored into to get the path that the backup is staged in.
+	restoringSuffix = ".restoring"
+)
+
+var (
+	ErrUnknownDBType     = errors.New("unknown database type")
+	ErrTargetNotEmpty    = errors.New("target database directory is not empty")
+	ErrSourceMissing     = errors.New("source database does not exist")
+	ErrSummaryMismatch   = errors.New("summary mismatch")
+	ErrInvalidLayout     = errors.New("invalid database layout")
+	errSameDBDirectories = errors.New("source and target database directories must differ")
+)
+
+// NewDBFunc creates a persistent database at the provided path.
+type NewDBFunc func(
+	path string,
+	config []byte,
+	log logging.Logger,
+	namespace string,
+	reg prometheus.Registerer,
+) (database.Database, error)
+
+// DBConfig describes where and how a set of versioned databases is stored.
+type DBConfig struct {
+	// Name is the type of the database. Should be one of {leveldb, pebble}.
+	Name string
+	// Path is the directory that the node was configured to use for the
+	// database, including the network name.
+	Path string
+	// Config is the backend specific database config.
+	Config []byte
+}
+
+// Dir returns the directory that contains the versioned databases described
+// by [c]. This matches the layout used by the node.
+func (c DBConfig) Dir() string {
+	if c.Name == pebble.Name {
+		return filepath.Join(c.Path, pebble.Name)
+	}
+	return c.Path
+}
+
+// NewDB returns the constructor of the persistent database type [name].
+func NewDB(name string) (NewDBFunc, error) {
+	switch name {
+	case leveldb.Name:
+		return leveldb.New, nil
+	case pebble.Name:
+		return pebble.New, nil
+	default:
+		return nil, fmt.Errorf("%w: %q should be one of {%s, %s}",
+			ErrUnknownDBType,
+			name,
+			leveldb.Name,
+			pebble.Name,
+		)
+	}
+}
+
+// PrefixSummary describes the key/value pairs stored under a single prefix.
+type PrefixSummary struct {
+	NumKeys  uint64 `json:"numKeys"`
+	NumBytes uint64 `json:"numBytes"`
+	// Checksum is the sha256 digest of every length-prefixed key and value
+	// under the prefix, in iteration order.
+	Checksum ids.ID `json:"checksum"`
+}
+
+// Summary describes the contents of a database, grouped by the first
+// [PrefixLen] bytes of each key. Keys that are shorter than [PrefixLen] are
+// grouped under their full key.
+type Summary map[string]PrefixSummary
+
+// Verify returns an error describing the first difference between [s] and
+// [other], if any.
+func (s Summary) Verify(other Summary) error {
+	prefixes := maps.Keys(s)
+	for prefix := range other {
+		if _, ok := s[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	slices.Sort(prefixes)
+
+	for _, prefix := range prefixes {
+		expected := s[prefix]
+		actual := other[prefix]
+		if expected != actual {
+			return fmt.Errorf("%w for prefix 0x%s: expected %d keys, %d bytes, checksum %s but got %d keys, %d bytes, checksum %s",
+				ErrSummaryMismatch,
+				hex.EncodeToString([]byte(prefix)),
+				expected.NumKeys,
+				expected.NumBytes,
+				expected.Checksum,
+				actual.NumKeys,
+				actual.NumBytes,
+				actual.Checksum,
+			)
+		}
+	}
+	return nil
+}
+
+type summarizer struct {
+	summary Summary
+
+	prefix  string
+	current PrefixSummary
+	hasher  hash.Hash
+	lenBuf  [4]byte
+}
+
+func newSummarizer() *summarizer {
+	return &summarizer{
+		summary: make(Summary),
+		hasher:  sha256.New(),
+	}
+}
+
+func (s *summarizer) add(key, value []byte) {
+	prefix := key
+	if len(prefix) > PrefixLen {
+		prefix = prefix[:PrefixLen]
+	}
+	if s.current.NumKeys == 0 || string(prefix) != s.prefix {
+		s.flush()
+		s.prefix = string(prefix)
+	}
+
+	s.current.NumKeys++
+	s.current.NumBytes += uint64(len(key) + len(value))
+	s.write(key)
+	s.write(value)
+}
+
+func (s *summarizer) write(b []byte) {
+	binary.BigEndian.PutUint32(s.lenBuf[:], uint32(len(b)))
+	_, _ = s.hasher.Write(s.lenBuf[:])
+	_, _ = s.hasher.Write(b)
+}
+
+// flush records the summary of the current prefix.
+func (s *summarizer) flush() {
+	if s.current.NumKeys == 0 {
+		return
+	}
+	copy(s.current.Checksum[:], s.hasher.Sum(nil))
+	s.summary[s.prefix] = s.current
+	s.current = PrefixSummary{}
+	s.hasher.Reset()
+}
+
+func (s *summarizer) finish() Summary {
+	s.flush()
+	return s.summary
+}
+
+// Summarize iterates over every key/value pair in [db] and returns its
+// summary.
+func Summarize(db database.Iteratee) (Summary, error) {
+	it := db.NewIterator()
+	defer it.Release()
+
+	s := newSummarizer()
+	for it.Next() {
+		s.add(it.Key(), it.Value())
+	}
+	return s.finish(), it.Error()
+}
+
+// Copy streams every key/value pair in [src] into [dst] using batches of
+// approximately [batchSize] bytes. The summary of the copied pairs is
+// returned.
+func Copy(src database.Iteratee, dst database.Batcher, batchSize int) (Summary, error) {
+	it := src.NewIterator()
+	defer it.Release()
+
+	var (
+		s     = newSummarizer()
+		batch = dst.NewBatch()
+	)
+	for it.Next() {
+		key := it.Key()
+		value := it.Value()
+		if err := batch.Put(key, value); err != nil {
+			return nil, err
+		}
+		s.add(key, value)
+
+		if batch.Size() < batchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return nil, err
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return s.finish(), batch.Write()
+}
+
+// Database copies [src] into [dst] and verifies that the contents of [dst]
+// match the contents of [src] once the copy has completed.
+func Database(src database.Iteratee, dst database.Database, batchSize int) (Summary, error) {
+	expected, err := Copy(src, dst, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := Summarize(dst)
+	if err != nil {
+		return nil, err
+	}
+	return expected, expected.Verify(actual)
+}
+
+// Manager copies every versioned database managed by [src] into a new
+// database of type [dst.Name] at [dst.Dir()], keeping the version directory
+// layout that the database manager expects.
+func Manager(
+	log logging.Logger,
+	src manager.Manager,
+	dst DBConfig,
+	batchSize int,
+) error {
+	dbs := src.GetDatabases()
+	srcs := make([]versionedIteratee, len(dbs))
+	for i, db := range dbs {
+		srcs[i] = versionedIteratee{
+			version:  db.Version,
+			iteratee: db.Database,
+		}
+	}
+	return copyVersions(log, srcs, dst, batchSize)
+}
+
+// Backup copies a consistent snapshot of every versioned database managed by
+// [src] into a new database of type [dst.Name] at [dst.Dir()]. Unlike
+// [Manager], [src] may continue to be written to while the backup is being
+// taken. Every database managed by [src] must support snapshots.
+func Backup(
+	log logging.Logger,
+	src manager.Manager,
+	dst DBConfig,
+	batchSize int,
+) error {
+	dbs := src.GetDatabases()
+	srcs := make([]versionedIteratee, 0, len(dbs))
+	snapshots := make([]database.Snapshot, 0, len(dbs))
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.Release()
+		}
+	}()
+
+	// All the snapshots are taken before any data is copied so that the
+	// backup is as close to a single point in time as possible.
+	for _, db := range dbs {
+		snapshot, err := database.NewSnapshot(db.Database)
+		if err != nil {
+			return fmt.Errorf("couldn't snapshot database %s: %w", db.Version, err)
+		}
+		snapshots = append(snapshots, snapshot)
+		srcs = append(srcs, versionedIteratee{
+			version:  db.Version,
+			iteratee: snapshot,
+		})
+	}
+	return copyVersions(log, srcs, dst, batchSize)
+}
+
+type versionedIteratee struct {
+	version  *version.Semantic
+	iteratee database.Iteratee
+}
+
+func copyVersions(
+	log logging.Logger,
+	srcs []versionedIteratee,
+	dst DBConfig,
+	batchSize int,
+) error {
+	newDB, err := NewDB(dst.Name)
+	if err != nil {
+		return err
+	}
+
+	dstDir := dst.Dir()
+	if err := ensureEmptyDir(dstDir); err != nil {
+		return err
+	}
+
+	for _, src := range srcs {
+		path := filepath.Join(dstDir, src.version.String())
+		log.Info("copying database",
+			zap.Stringer("version", src.version),
+			zap.String("path", path),
+		)
+
+		dstDB, err := newDB(path, dst.Config, log, "", prometheus.NewRegistry())
+		if err != nil {
+			return fmt.Errorf("couldn't create db at %s: %w", path, err)
+		}
+
+		summary, err := Database(src.iteratee, dstDB, batchSize)
+		if closeErr := dstDB.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to copy database %s: %w", src.version, err)
+		}
+
+		var numKeys, numBytes uint64
+		for _, prefixSummary := range summary {
+			numKeys += prefixSummary.NumKeys
+			numBytes += prefixSummary.NumBytes
+		}
+		log.Info("copied database",
+			zap.Stringer("version", src.version),
+			zap.Int("numPrefixes", len(summary)),
+			zap.Uint64("numKeys", numKeys),
+			zap.Uint64("numBytes", numBytes),
+		)
+	}
+	return nil
+}
+
+// OpenManager opens the existing versioned databases described by [config].
+// Unlike the node, this never creates a new database.
+func OpenManager(
+	log logging.Logger,
+	config DBConfig,
+	currentVersion *version.Semantic,
+) (manager.Manager, error) {
+	dir := config.Dir()
+	currentPath := filepath.Join(dir, currentVersion.String())
+	if _, err := os.Stat(currentPath); err != nil {
+		return nil, fmt.Errorf("%w at %s: %v", ErrSourceMissing, currentPath, err)
+	}
+
+	switch config.Name {
+	case leveldb.Name:
+		return manager.NewLevelDB(dir, config.Config, log, currentVersion, "", prometheus.NewRegistry())
+	case pebble.Name:
+		return manager.NewPebbleDB(dir, config.Config, log, currentVersion, "", prometheus.NewRegistry())
+	default:
+		_, err := NewDB(config.Name)
+		return nil, err
+	}
+}
+
+// VerifyLayout returns an error if the directory described by [config] doesn't
+// contain the versioned databases that the database manager expects when
+// opening [currentVersion]. Unlike the database manager, which ignores
+// unexpected entries, every entry in the directory must be a database named
+// after a version no newer than [currentVersion].
+func VerifyLayout(config DBConfig, currentVersion *version.Semantic) error {
+	dir := config.Dir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLayout, err)
+	}
+
+	foundCurrent := false
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if entry.Name() == restoredFile && !entry.IsDir() {
+			// Databases that were restored from a backup can themselves be
+			// used as a backup.
+			continue
+		}
+		if !entry.IsDir() {
+			return fmt.Errorf("%w: unexpected file %s", ErrInvalidLayout, path)
+		}
+		dbVersion, err := version.Parse(entry.Name())
+		if err != nil {
+			return fmt.Errorf("%w: unexpected directory %s: %v", ErrInvalidLayout, path, err)
+		}
+		if cmp := dbVersion.Compare(currentVersion); cmp > 0 {
+			return fmt.Errorf("%w: database %s is newer than the current database version %s", ErrInvalidLayout, path, currentVersion)
+		} else if cmp == 0 {
+			foundCurrent = true
+		}
+
+		// A directory without a manifest would be silently initialized as an
+		// empty database.
+		if _, err := os.Stat(filepath.Join(path, currentFile)); err != nil {
+			return fmt.Errorf("%w: %s doesn't contain a database: %v", ErrInvalidLayout, path, err)
+		}
+	}
+	if !foundCurrent {
+		return fmt.Errorf("%w: missing database for the current version %s in %s", ErrInvalidLayout, currentVersion, dir)
+	}
+	return nil
+}
+
+// Restore copies the versioned databases described by [src] into [dst.Dir()],
+// which must be empty. [src] must pass [VerifyLayout] and [verify] is called
+// with the current source database before anything is written.
+//
+// The databases are copied into a staging directory next to [dst] and only
+// moved into [dst.Dir()] once the copy has completed, so an interrupted restore
+// never leaves a partially written database behind. The staging directory of
+// an interrupted restore is removed by the next call.
+//
+// Once the restore has completed, it is recorded in [dst.Dir()]. Later calls
+// that restore the same [src] into [dst] do nothing and return false, so a
+// node can be restarted without removing its restore config.
+func Restore(
+	log logging.Logger,
+	src DBConfig,
+	dst DBConfig,
+	currentVersion *version.Semantic,
+	batchSize int,
+	verify func(database.Database) error,
+) (bool, error) {
+	srcDir, err := filepath.Abs(src.Dir())
+	if err != nil {
+		return false, err
+	}
+
+	staging := dst
+	staging.Path += restoringSuffix
+	if err := os.RemoveAll(staging.Path); err != nil {
+		return false, err
+	}
+
+	dstDir := dst.Dir()
+	restoredFrom, err := os.ReadFile(filepath.Join(dstDir, restoredFile))
+	switch {
+	case err == nil && string(restoredFrom) == srcDir:
+		return false, nil
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return false, err
+	}
+	if err := ensureEmptyDir(dstDir); err != nil {
+		return false, err
+	}
+
+	log.Info("verifying database backup",
+		zap.String("path", srcDir),
+	)
+	if err := VerifyLayout(src, currentVersion); err != nil {
+		return false, err
+	}
+
+	srcManager, err := OpenManager(log, src, currentVersion)
+	if err != nil {
+		return false, err
+	}
+	err = verify(srcManager.Current().Database)
+	if err == nil {
+		log.Info("restoring database backup",
+			zap.String("path", dstDir),
+		)
+		err = Manager(log, srcManager, staging, batchSize)
+	}
+	if closeErr := srcManager.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.WriteFile(filepath.Join(staging.Dir(), restoredFile), []byte(srcDir), perms.ReadWrite)
+	}
+	if err != nil {
+		_ = os.RemoveAll(staging.Path)
+		return false, err
+	}
+
+	// [dstDir] is empty, but it may exist.
+	if err := os.Remove(dstDir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dstDir), perms.ReadWriteExecute); err != nil {
+		return false, err
+	}
+	if err := os.Rename(staging.Dir(), dstDir); err != nil {
+		return false, err
+	}
+	return true, os.RemoveAll(staging.Path)
+}
+
+// Migrate converts the databases described by [src] into the databases
+// described by [dst].
+func Migrate(
+	log logging.Logger,
+	src DBConfig,
+	dst DBConfig,
+	currentVersion *version.Semantic,
+	batchSize int,
+) error {
+	srcDir, err := filepath.Abs(src.Dir())
+	if err != nil {
+		return err
+	}
+	dstDir, err := filepath.Abs(dst.Dir())
+	if err != nil {
+		return err
+	}
+	if srcDir == dstDir {
+		return errSameDBDirectories
+	}
+
+	srcManager, err := OpenManager(log, src, currentVersion)
+	if err != nil {
+		return err
+	}
+	err = Manager(log, srcManager, dst, batchSize)
+	if closeErr := srcManager.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func ensureEmptyDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	case len(entries) > 0:
+		return fmt.Errorf("%w: %s", ErrTargetNotEmpty, dir)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package migrate
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/leveldb"
+	"github.com/VidarSolutions/avalanchego/database/manager"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/perms"
+	"github.com/VidarSolutions/avalanchego/version"
+)
+
+func populate(t *testing.T, db database.Database) {
+	require := require.New(t)
+
+	for _, prefix := range []string{"chain0", "chain1", "keystore"} {
+		prefixDB := prefixdb.New([]byte(prefix), db)
+		for i := 0; i < 100; i++ {
+			require.NoError(prefixDB.Put([]byte{byte(i)}, []byte(prefix)))
+		}
+	}
+	// Keys shorter than [PrefixLen] are grouped under their full key.
+	require.NoError(db.Put([]byte("short"), []byte("value")))
+}
+
+func TestCopy(t *testing.T) {
+	require := require.New(t)
+
+	src := memdb.New()
+	populate(t, src)
+
+	dst := memdb.New()
+	summary, err := Database(src, dst, 64)
+	require.NoError(err)
+	require.Len(summary, 4)
+
+	for prefix, prefixSummary := range summary {
+		if prefix == "short" {
+			require.Equal(uint64(1), prefixSummary.NumKeys)
+			continue
+		}
+		require.Len(prefix, PrefixLen)
+		require.Equal(uint64(100), prefixSummary.NumKeys)
+	}
+
+	srcSummary, err := Summarize(src)
+	require.NoError(err)
+	require.NoError(srcSummary.Verify(summary))
+}
+
+func TestVerifyDetectsDifferences(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	populate(t, db)
+
+	expected, err := Summarize(db)
+	require.NoError(err)
+
+	chainDB := prefixdb.New([]byte("chain0"), db)
+
+	// Modifying a value must change the checksum.
+	require.NoError(chainDB.Put([]byte{0}, []byte("modified")))
+	actual, err := Summarize(db)
+	require.NoError(err)
+	require.ErrorIs(expected.Verify(actual), ErrSummaryMismatch)
+
+	// Removing a key must change the count.
+	require.NoError(chainDB.Delete([]byte{0}))
+	actual, err = Summarize(db)
+	require.NoError(err)
+	require.ErrorIs(expected.Verify(actual), ErrSummaryMismatch)
+
+	// Adding a new prefix must be reported.
+	require.NoError(chainDB.Put([]byte{0}, []byte("chain0")))
+	require.NoError(prefixdb.New([]byte("chain2"), db).Put([]byte{0}, nil))
+	actual, err = Summarize(db)
+	require.NoError(err)
+	require.ErrorIs(expected.Verify(actual), ErrSummaryMismatch)
+	require.ErrorIs(actual.Verify(expected), ErrSummaryMismatch)
+}
+
+func TestMigrate(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		dir = t.TempDir()
+		v0  = version.Semantic1_0_0
+		v1  = &version.Semantic{
+			Major: 1,
+			Minor: 1,
+			Patch: 0,
+		}
+		src = DBConfig{
+			Name: leveldb.Name,
+			Path: dir,
+		}
+		dst = DBConfig{
+			Name: pebble.Name,
+			Path: dir,
+		}
+	)
+
+	expectedSummaries := make(map[string]Summary)
+	for _, v := range []*version.Semantic{v0, v1} {
+		db, err := leveldb.New(filepath.Join(src.Dir(), v.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(err)
+		populate(t, db)
+		require.NoError(db.Put([]byte(v.String()), nil))
+
+		expectedSummaries[v.String()], err = Summarize(db)
+		require.NoError(err)
+		require.NoError(db.Close())
+	}
+
+	require.NoError(Migrate(logging.NoLog{}, src, dst, v1, DefaultBatchSize))
+
+	dstManager, err := OpenManager(logging.NoLog{}, dst, v1)
+	require.NoError(err)
+
+	dbs := dstManager.GetDatabases()
+	require.Len(dbs, 2)
+	for _, db := range dbs {
+		summary, err := Summarize(db.Database)
+		require.NoError(err)
+		require.NoError(expectedSummaries[db.Version.String()].Verify(summary))
+	}
+	require.NoError(dstManager.Close())
+
+	// Migrating into a populated directory must fail.
+	err = Migrate(logging.NoLog{}, src, dst, v1, DefaultBatchSize)
+	require.ErrorIs(err, ErrTargetNotEmpty)
+}
+
+func TestBackup(t *testing.T) {
+	require := require.New(t)
+
+	dst := DBConfig{
+		Name: pebble.Name,
+		Path: t.TempDir(),
+	}
+
+	db := memdb.New()
+	populate(t, db)
+	src, err := manager.NewManagerFromDBs([]*manager.VersionedDatabase{
+		{
+			Database: db,
+			Version:  version.Semantic1_0_0,
+		},
+	})
+	require.NoError(err)
+
+	expectedSummary, err := Summarize(db)
+	require.NoError(err)
+
+	require.NoError(Backup(logging.NoLog{}, src, dst, DefaultBatchSize))
+
+	// The source database should still be usable after the backup.
+	require.NoError(db.Put([]byte("after backup"), nil))
+
+	dstManager, err := OpenManager(logging.NoLog{}, dst, version.Semantic1_0_0)
+	require.NoError(err)
+
+	summary, err := Summarize(dstManager.Current().Database)
+	require.NoError(err)
+	require.NoError(expectedSummary.Verify(summary))
+	require.NoError(dstManager.Close())
+
+	// Backing up into a populated directory must fail.
+	err = Backup(logging.NoLog{}, src, dst, DefaultBatchSize)
+	require.ErrorIs(err, ErrTargetNotEmpty)
+}
+
+func TestVerifyLayout(t *testing.T) {
+	require := require.New(t)
+
+	for _, name := range []string{leveldb.Name, pebble.Name} {
+		config := DBConfig{
+			Name: name,
+			Path: t.TempDir(),
+		}
+		newDB, err := NewDB(name)
+		require.NoError(err)
+
+		// The current version is required.
+		err = VerifyLayout(config, version.Semantic1_0_0)
+		require.ErrorIs(err, ErrInvalidLayout)
+
+		db, err := newDB(filepath.Join(config.Dir(), version.Semantic1_0_0.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(err)
+		require.NoError(db.Close())
+		require.NoError(VerifyLayout(config, version.Semantic1_0_0))
+
+		// Databases newer than the current version are reported.
+		newer := &version.Semantic{
+			Major: 1,
+			Minor: 1,
+			Patch: 0,
+		}
+		db, err = newDB(filepath.Join(config.Dir(), newer.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(err)
+		require.NoError(db.Close())
+		err = VerifyLayout(config, version.Semantic1_0_0)
+		require.ErrorIs(err, ErrInvalidLayout)
+		require.NoError(VerifyLayout(config, newer))
+
+		// Version directories must contain a database.
+		require.NoError(os.Mkdir(filepath.Join(config.Dir(), "v1.0.1"), perms.ReadWriteExecute))
+		err = VerifyLayout(config, newer)
+		require.ErrorIs(err, ErrInvalidLayout)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		src = DBConfig{
+			Name: pebble.Name,
+			Path: t.TempDir(),
+		}
+		dst = DBConfig{
+			Name: pebble.Name,
+			Path: t.TempDir(),
+		}
+		verified int
+		verify   = func(database.Database) error {
+			verified++
+			return nil
+		}
+	)
+
+	db, err := pebble.New(filepath.Join(src.Dir(), version.Semantic1_0_0.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	populate(t, db)
+	expectedSummary, err := Summarize(db)
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	restored, err := Restore(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize, verify)
+	require.NoError(err)
+	require.True(restored)
+	require.Equal(1, verified)
+
+	// The node writes to the restored database after it starts.
+	dstManager, err := OpenManager(logging.NoLog{}, dst, version.Semantic1_0_0)
+	require.NoError(err)
+	require.NoError(dstManager.Current().Database.Put([]byte("after restore"), nil))
+	require.NoError(dstManager.Close())
+
+	// Restarting with the same backup must leave the database untouched.
+	restored, err = Restore(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize, verify)
+	require.NoError(err)
+	require.False(restored)
+	require.Equal(1, verified)
+
+	dstManager, err = OpenManager(logging.NoLog{}, dst, version.Semantic1_0_0)
+	require.NoError(err)
+	has, err := dstManager.Current().Database.Has([]byte("after restore"))
+	require.NoError(err)
+	require.True(has)
+	require.NoError(dstManager.Current().Database.Delete([]byte("after restore")))
+	summary, err := Summarize(dstManager.Current().Database)
+	require.NoError(err)
+	require.NoError(expectedSummary.Verify(summary))
+	require.NoError(dstManager.Close())
+
+	// A restored database can itself be used as a backup.
+	require.NoError(VerifyLayout(dst, version.Semantic1_0_0))
+
+	// A different backup can't be restored over the restored database.
+	_, err = Restore(logging.NoLog{}, dst, src, version.Semantic1_0_0, DefaultBatchSize, verify)
+	require.ErrorIs(err, ErrTargetNotEmpty)
+}
+
+func TestRestoreVerifyFailure(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		src = DBConfig{
+			Name: leveldb.Name,
+			Path: t.TempDir(),
+		}
+		dst = DBConfig{
+			Name: leveldb.Name,
+			Path: filepath.Join(t.TempDir(), "db"),
+		}
+		errVerify = errors.New("verify failed")
+	)
+
+	db, err := leveldb.New(filepath.Join(src.Dir(), version.Semantic1_0_0.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	_, err = Restore(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize, func(database.Database) error {
+		return errVerify
+	})
+	require.ErrorIs(err, errVerify)
+
+	// Nothing is written if the backup fails verification.
+	_, err = os.Stat(dst.Dir())
+	require.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestRestoreInterrupted(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		src = DBConfig{
+			Name: pebble.Name,
+			Path: t.TempDir(),
+		}
+		dst = DBConfig{
+			Name: pebble.Name,
+			Path: filepath.Join(t.TempDir(), "db"),
+		}
+		verify = func(database.Database) error {
+			return nil
+		}
+	)
+
+	db, err := pebble.New(filepath.Join(src.Dir(), version.Semantic1_0_0.String()), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	populate(t, db)
+	expectedSummary, err := Summarize(db)
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	// Simulate a restore that was interrupted while the database was being
+	// copied.
+	stagingDir := filepath.Join(dst.Path+restoringSuffix, pebble.Name, version.Semantic1_0_0.String())
+	require.NoError(os.MkdirAll(stagingDir, perms.ReadWriteExecute))
+	require.NoError(os.WriteFile(filepath.Join(stagingDir, currentFile), []byte("partial"), perms.ReadWrite))
+
+	restored, err := Restore(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize, verify)
+	require.NoError(err)
+	require.True(restored)
+
+	_, err = os.Stat(dst.Path + restoringSuffix)
+	require.ErrorIs(err, os.ErrNotExist)
+
+	dstManager, err := OpenManager(logging.NoLog{}, dst, version.Semantic1_0_0)
+	require.NoError(err)
+	summary, err := Summarize(dstManager.Current().Database)
+	require.NoError(err)
+	require.NoError(expectedSummary.Verify(summary))
+	require.NoError(dstManager.Close())
+}
+
+func TestRestoreCorruptBackup(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		src = DBConfig{
+			Name: leveldb.Name,
+			Path: t.TempDir(),
+		}
+		dst = DBConfig{
+			Name: leveldb.Name,
+			Path: filepath.Join(t.TempDir(), "db"),
+		}
+		verify = func(database.Database) error {
+			return nil
+		}
+	)
+
+	// The database directory exists, but its contents are missing.
+	require.NoError(os.MkdirAll(filepath.Join(src.Dir(), version.Semantic1_0_0.String()), perms.ReadWriteExecute))
+
+	_, err := Restore(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize, verify)
+	require.ErrorIs(err, ErrInvalidLayout)
+
+	for _, path := range []string{dst.Dir(), dst.Path + restoringSuffix} {
+		_, err = os.Stat(path)
+		require.ErrorIs(err, os.ErrNotExist)
+	}
+}
+
+func TestMigrateMissingSource(t *testing.T) {
+	src := DBConfig{
+		Name: leveldb.Name,
+		Path: t.TempDir(),
+	}
+	dst := DBConfig{
+		Name: pebble.Name,
+		Path: t.TempDir(),
+	}
+	err := Migrate(logging.NoLog{}, src, dst, version.Semantic1_0_0, DefaultBatchSize)
+	require.ErrorIs(t, err, ErrSourceMissing)
+}
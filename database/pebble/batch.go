@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/VidarSolutions/avalanchego/database"
+)
+
+var (
+	_ database.Batch = (*batch)(nil)
+
+	errInvalidOperation = errors.New("invalid operation")
+)
+
+// batch is a wrapper around a pebble batch to contain sizes.
+type batch struct {
+	batch *pebble.Batch
+	db    *Database
+	size  int
+
+	// True iff [batch] has been written to the database since the last time
+	// [Reset] was called.
+	written bool
+}
+
+// Put the value into the batch for later writing
+func (b *batch) Put(key, value []byte) error {
+	b.size += len(key) + len(value) + pebbleByteOverhead
+	return b.batch.Set(key, value, pebble.Sync)
+}
+
+// Delete the key during writing
+func (b *batch) Delete(key []byte) error {
+	b.size += len(key) + pebbleByteOverhead
+	return b.batch.Delete(key, pebble.Sync)
+}
+
+// Size retrieves the amount of data queued up for writing.
+func (b *batch) Size() int {
+	return b.size
+}
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if b.db.closed {
+		return database.ErrClosed
+	}
+
+	if !b.written {
+		// This batch has not been written to the database yet.
+		b.written = true
+		return updateError(b.batch.Commit(pebble.Sync))
+	}
+
+	// pebble doesn't support writing a batch twice, so we copy the batch
+	// contents into a new batch and commit that instead.
+	newBatch := b.db.pebbleDB.NewBatch()
+	if err := newBatch.SetRepr(b.batch.Repr()); err != nil {
+		return err
+	}
+	b.batch = newBatch
+	return updateError(b.batch.Commit(pebble.Sync))
+}
+
+// Reset resets the batch for reuse.
+func (b *batch) Reset() {
+	b.batch.Reset()
+	b.written = false
+	b.size = 0
+}
+
+// Replay the batch contents.
+func (b *batch) Replay(w database.KeyValueWriterDeleter) error {
+	reader := b.batch.Reader()
+	for {
+		kind, k, v, ok := reader.Next()
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(k, v); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(k); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: %v", errInvalidOperation, kind)
+		}
+	}
+}
+
+// Inner returns itself
+func (b *batch) Inner() database.Batch {
+	return b
+}
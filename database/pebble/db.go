@@ -0,0 +1,467 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/utils/units"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+const (
+	// Name is the name of this database for database switches
+	Name = "pebble"
+
+	// DefaultCacheSize is the number of bytes to use for block caching in
+	// pebble.
+	DefaultCacheSize = 512 * units.MiB
+
+	// DefaultBytesPerSync is the number of bytes written to an sstable before
+	// pebble calls sync in the background.
+	DefaultBytesPerSync = units.MiB
+
+	// DefaultWALBytesPerSync is the number of bytes written to the WAL before
+	// pebble calls sync in the background.
+	DefaultWALBytesPerSync = 0
+
+	// DefaultMemTableStopWritesThreshold is the number of memtables that can
+	// be queued for flushing before writes are stopped.
+	DefaultMemTableStopWritesThreshold = 8
+
+	// DefaultMemTableSize is the number of bytes to use for a single memtable.
+	DefaultMemTableSize = 16 * units.MiB
+
+	// DefaultMaxOpenFiles is the number of files descriptors to cap pebble to
+	// use.
+	DefaultMaxOpenFiles = 4096
+
+	// DefaultMaxConcurrentCompactions is the maximum number of compactions
+	// that pebble will run concurrently.
+	DefaultMaxConcurrentCompactions = 1
+
+	// DefaultMetricUpdateFrequency is the frequency to poll the pebble
+	// metrics.
+	DefaultMetricUpdateFrequency = 10 * time.Second
+
+	// pebbleByteOverhead is the number of bytes of constant overhead that
+	// should be added to a batch size per operation.
+	pebbleByteOverhead = 8
+)
+
+var _ database.Database = (*Database)(nil)
+
+// Database is a persistent key-value store backed by pebble. Apart from basic
+// data storage functionality it also supports batch writes and iterating over
+// the keyspace in binary-alphabetical order.
+type Database struct {
+	lock          sync.RWMutex
+	pebbleDB      *pebble.DB
+	closed        bool
+	openIterators set.Set[*iter]
+	openSnapshots set.Set[*snapshot]
+
+	// metrics is only initialized and used when [MetricUpdateFrequency] is > 0
+	// in the config
+	metrics metrics
+	// closeCh is closed when Close() is called.
+	closeCh chan struct{}
+	// closeWg is used to wait for all goroutines created by New() to exit.
+	closeWg sync.WaitGroup
+}
+
+type config struct {
+	// CacheSize is the number of bytes to use for the block cache.
+	//
+	// The default value is 512MiB.
+	CacheSize int `json:"cacheSize"`
+	// BytesPerSync is the number of bytes written to an sstable before a sync
+	// is performed in the background.
+	//
+	// The default value is 1MiB.
+	BytesPerSync int `json:"bytesPerSync"`
+	// WALBytesPerSync is the number of bytes written to the WAL before a sync
+	// is performed in the background. 0 disables background syncing.
+	//
+	// The default value is 0.
+	WALBytesPerSync int `json:"walBytesPerSync"`
+	// MemTableStopWritesThreshold is the number of memtables that can be
+	// queued for flushing before writes are stopped.
+	//
+	// The default value is 8.
+	MemTableStopWritesThreshold int `json:"memTableStopWritesThreshold"`
+	// MemTableSize is the size of a single memtable.
+	//
+	// The default value is 16MiB.
+	MemTableSize int `json:"memTableSize"`
+	// MaxOpenFiles is the maximum number of open files that pebble may use.
+	//
+	// The default value is 4096.
+	MaxOpenFiles int `json:"maxOpenFiles"`
+	// MaxConcurrentCompactions is the maximum number of concurrent
+	// compactions.
+	//
+	// The default value is 1.
+	MaxConcurrentCompactions int `json:"maxConcurrentCompactions"`
+	// L0CompactionThreshold is the number of L0 read-amplification units that
+	// trigger an L0 compaction.
+	//
+	// The default value is pebble's default.
+	L0CompactionThreshold int `json:"l0CompactionThreshold"`
+	// L0StopWritesThreshold is the number of L0 read-amplification units that
+	// stop writes until compactions catch up.
+	//
+	// The default value is pebble's default.
+	L0StopWritesThreshold int `json:"l0StopWritesThreshold"`
+
+	// MetricUpdateFrequency is the frequency to poll pebble metrics.
+	// If <= 0, pebble metrics aren't polled.
+	MetricUpdateFrequency time.Duration `json:"metricUpdateFrequency"`
+}
+
+// New returns a wrapped pebble database.
+func New(file string, configBytes []byte, log logging.Logger, namespace string, reg prometheus.Registerer) (database.Database, error) {
+	parsedConfig := config{
+		CacheSize:                   DefaultCacheSize,
+		BytesPerSync:                DefaultBytesPerSync,
+		WALBytesPerSync:             DefaultWALBytesPerSync,
+		MemTableStopWritesThreshold: DefaultMemTableStopWritesThreshold,
+		MemTableSize:                DefaultMemTableSize,
+		MaxOpenFiles:                DefaultMaxOpenFiles,
+		MaxConcurrentCompactions:    DefaultMaxConcurrentCompactions,
+		MetricUpdateFrequency:       DefaultMetricUpdateFrequency,
+	}
+	if len(configBytes) > 0 {
+		if err := json.Unmarshal(configBytes, &parsedConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse db config: %w", err)
+		}
+	}
+
+	log.Info("creating pebble",
+		zap.Reflect("config", parsedConfig),
+	)
+
+	wrappedDB := &Database{
+		openIterators: set.Set[*iter]{},
+		openSnapshots: set.Set[*snapshot]{},
+		closeCh:       make(chan struct{}),
+	}
+
+	metricsEnabled := parsedConfig.MetricUpdateFrequency > 0
+	if metricsEnabled {
+		metrics, err := newMetrics(namespace, reg)
+		if err != nil {
+			return nil, err
+		}
+		wrappedDB.metrics = metrics
+	}
+
+	opts := &pebble.Options{
+		Cache:                       pebble.NewCache(int64(parsedConfig.CacheSize)),
+		BytesPerSync:                parsedConfig.BytesPerSync,
+		WALBytesPerSync:             parsedConfig.WALBytesPerSync,
+		MemTableStopWritesThreshold: parsedConfig.MemTableStopWritesThreshold,
+		MemTableSize:                parsedConfig.MemTableSize,
+		MaxOpenFiles:                parsedConfig.MaxOpenFiles,
+		MaxConcurrentCompactions: func() int {
+			return parsedConfig.MaxConcurrentCompactions
+		},
+		L0CompactionThreshold: parsedConfig.L0CompactionThreshold,
+		L0StopWritesThreshold: parsedConfig.L0StopWritesThreshold,
+	}
+	if metricsEnabled {
+		listener := wrappedDB.metrics.eventListener()
+		opts.EventListener = &listener
+	}
+	opts.Experimental.ReadSamplingMultiplier = -1 // Disable seek compaction
+
+	// The cache is reference counted. Opening the database takes a reference,
+	// so we release ours once the database has been opened.
+	db, err := pebble.Open(file, opts)
+	opts.Cache.Unref()
+	if err != nil {
+		return nil, err
+	}
+	wrappedDB.pebbleDB = db
+
+	if metricsEnabled {
+		wrappedDB.closeWg.Add(1)
+		go func() {
+			t := time.NewTicker(parsedConfig.MetricUpdateFrequency)
+			defer func() {
+				t.Stop()
+				wrappedDB.closeWg.Done()
+			}()
+
+			for {
+				wrappedDB.updateMetrics()
+
+				select {
+				case <-t.C:
+				case <-wrappedDB.closeCh:
+					return
+				}
+			}
+		}()
+	}
+	return wrappedDB, nil
+}
+
+// Has returns if the key is set in the database
+func (db *Database) Has(key []byte) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return false, database.ErrClosed
+	}
+
+	_, closer, err := db.pebbleDB.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, updateError(err)
+	}
+	return true, closer.Close()
+}
+
+// Get returns the value the key maps to in the database
+func (db *Database) Get(key []byte) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	data, closer, err := db.pebbleDB.Get(key)
+	if err != nil {
+		return nil, updateError(err)
+	}
+	// The returned slice is only valid until [closer] is closed.
+	value := slices.Clone(data)
+	return value, closer.Close()
+}
+
+// Put sets the value of the provided key to the provided value
+func (db *Database) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.pebbleDB.Set(key, value, pebble.Sync))
+}
+
+// Delete removes the key from the database
+func (db *Database) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.pebbleDB.Delete(key, pebble.Sync))
+}
+
+// NewBatch creates a write/delete-only buffer that is atomically committed to
+// the database when write is called
+func (db *Database) NewBatch() database.Batch {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		// The pebble database can't create batches once it is closed. The
+		// returned batch buffers writes as usual, but Write will return
+		// [database.ErrClosed].
+		return &batch{
+			db:    db,
+			batch: &pebble.Batch{},
+		}
+	}
+	return &batch{
+		db:    db,
+		batch: db.pebbleDB.NewBatch(),
+	}
+}
+
+// NewIterator creates a lexicographically ordered iterator over the database
+func (db *Database) NewIterator() database.Iterator {
+	return db.newIter(nil, nil)
+}
+
+// NewIteratorWithStart creates a lexicographically ordered iterator over the
+// database starting at the provided key
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.newIter(start, nil)
+}
+
+// NewIteratorWithPrefix creates a lexicographically ordered iterator over the
+// database ignoring keys that do not start with the provided prefix
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.newIter(nil, prefix)
+}
+
+// NewIteratorWithStartAndPrefix creates a lexicographically ordered iterator
+// over the database starting at start and ignoring keys that do not start with
+// the provided prefix
+func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	return db.newIter(start, prefix)
+}
+
+func (db *Database) newIter(start, prefix []byte) database.Iterator {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return &database.IteratorError{
+			Err: database.ErrClosed,
+		}
+	}
+
+	it := &iter{
+		db:   db,
+		iter: db.pebbleDB.NewIter(keyRange(start, prefix)),
+	}
+	db.openIterators.Add(it)
+	return it
+}
+
+// Compact the underlying DB for the given key range.
+//
+// A nil start is treated as a key before all keys in the DB.
+// And a nil limit is treated as a key after all keys in the DB.
+// Therefore if both are nil then it will compact entire DB.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	if limit != nil {
+		if bytes.Compare(start, limit) >= 0 {
+			// pebble requires [start] < [limit]. An empty range has nothing to
+			// compact.
+			return nil
+		}
+		return updateError(db.pebbleDB.Compact(start, limit, true /* parallelize */))
+	}
+
+	// pebble doesn't support a nil limit, so we find the last key in the
+	// database and compact through the successor of it.
+	it := db.pebbleDB.NewIter(&pebble.IterOptions{})
+	if !it.Last() {
+		// The database is empty.
+		return it.Close()
+	}
+	limit = append(slices.Clone(it.Key()), 0)
+	if err := it.Close(); err != nil {
+		return err
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return updateError(db.pebbleDB.Compact(start, limit, true /* parallelize */))
+}
+
+func (db *Database) Close() error {
+	db.lock.Lock()
+	if db.closed {
+		db.lock.Unlock()
+		return database.ErrClosed
+	}
+
+	db.closed = true
+	close(db.closeCh)
+
+	// pebble requires all iterators and snapshots to be closed before the
+	// database is closed.
+	errs := wrappers.Errs{}
+	for it := range db.openIterators {
+		errs.Add(it.release())
+	}
+	db.openIterators.Clear()
+	for s := range db.openSnapshots {
+		errs.Add(s.release())
+	}
+	db.openSnapshots.Clear()
+	errs.Add(updateError(db.pebbleDB.Close()))
+	db.lock.Unlock()
+
+	// The metrics goroutine stops reading from the database once [closed] is
+	// set, so it is safe to wait for it without holding the lock.
+	db.closeWg.Wait()
+	return errs.Err
+}
+
+func (db *Database) HealthCheck(context.Context) (interface{}, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+	return nil, nil
+}
+
+// keyRange returns the iterator bounds that cover every key that is >= [start]
+// and has the prefix [prefix].
+func keyRange(start, prefix []byte) *pebble.IterOptions {
+	opts := &pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixToUpperBound(prefix),
+	}
+	if bytes.Compare(start, prefix) == 1 {
+		opts.LowerBound = start
+	}
+	return opts
+}
+
+// prefixToUpperBound returns the smallest key that is larger than every key
+// with the prefix [prefix]. If no such key exists, nil is returned, which is
+// treated as unbounded.
+func prefixToUpperBound(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xFF {
+			upperBound := slices.Clone(prefix[:i+1])
+			upperBound[i]++
+			return upperBound
+		}
+	}
+	return nil
+}
+
+func updateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, pebble.ErrClosed):
+		return database.ErrClosed
+	case errors.Is(err, pebble.ErrNotFound):
+		return database.ErrNotFound
+	default:
+		return err
+	}
+}
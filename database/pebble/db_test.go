@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+)
+
+func TestInterface(t *testing.T) {
+	for _, test := range database.Tests {
+		folder := t.TempDir()
+		db, err := New(folder, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(t, err)
+
+		test(t, db)
+
+		// The database may have been closed by the test, so we don't care if it
+		// errors here.
+		_ = db.Close()
+	}
+}
+
+func TestSnapshotInterface(t *testing.T) {
+	for _, test := range database.SnapshotTests {
+		folder := t.TempDir()
+		db, err := New(folder, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(t, err)
+
+		test(t, db)
+
+		// The database may have been closed by the test, so we don't care if it
+		// errors here.
+		_ = db.Close()
+	}
+}
+
+func FuzzInterface(f *testing.F) {
+	for _, test := range database.FuzzTests {
+		folder := f.TempDir()
+		db, err := New(folder, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+		require.NoError(f, err)
+
+		defer db.Close()
+
+		test(f, db)
+
+		// The database may have been closed by the test, so we don't care if it
+		// errors here.
+		_ = db.Close()
+	}
+}
+
+func BenchmarkInterface(b *testing.B) {
+	for _, size := range database.BenchmarkSizes {
+		keys, values := database.SetupBenchmark(b, size[0], size[1], size[2])
+		for _, bench := range database.Benchmarks {
+			folder := b.TempDir()
+
+			db, err := New(folder, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			bench(b, db, "pebble", keys, values)
+
+			// The database may have been closed by the test, so we don't care if it
+			// errors here.
+			_ = db.Close()
+		}
+	}
+}
+
+func TestKeyRange(t *testing.T) {
+	type test struct {
+		start         []byte
+		prefix        []byte
+		expectedLower []byte
+		expectedUpper []byte
+	}
+
+	tests := []test{
+		{
+			start:         nil,
+			prefix:        nil,
+			expectedLower: nil,
+			expectedUpper: nil,
+		},
+		{
+			start:         nil,
+			prefix:        []byte{},
+			expectedLower: []byte{},
+			expectedUpper: nil,
+		},
+		{
+			start:         nil,
+			prefix:        []byte{0x00},
+			expectedLower: []byte{0x00},
+			expectedUpper: []byte{0x01},
+		},
+		{
+			start:         []byte{0x00, 0x02},
+			prefix:        []byte{0x00},
+			expectedLower: []byte{0x00, 0x02},
+			expectedUpper: []byte{0x01},
+		},
+		{
+			start:         []byte{0x01},
+			prefix:        []byte{0x00, 0xFF},
+			expectedLower: []byte{0x01},
+			expectedUpper: []byte{0x01},
+		},
+		{
+			start:         nil,
+			prefix:        []byte{0xFF, 0xFF},
+			expectedLower: []byte{0xFF, 0xFF},
+			expectedUpper: nil,
+		},
+	}
+	for _, tt := range tests {
+		bounds := keyRange(tt.start, tt.prefix)
+		require.Equal(t, tt.expectedLower, bounds.LowerBound)
+		require.Equal(t, tt.expectedUpper, bounds.UpperBound)
+	}
+}
+
+func TestNewBatchAfterClose(t *testing.T) {
+	require := require.New(t)
+
+	db, err := New(t.TempDir(), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	batch := db.NewBatch()
+	require.NoError(batch.Put([]byte("key"), []byte("value")))
+	require.NoError(batch.Delete([]byte("key")))
+	require.ErrorIs(batch.Write(), database.ErrClosed)
+
+	replayed := memdb.New()
+	require.NoError(batch.Replay(replayed))
+	has, err := replayed.Has([]byte("key"))
+	require.NoError(err)
+	require.False(has)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+)
+
+var _ database.Iterator = (*iter)(nil)
+
+type iter struct {
+	// [db] must be locked when accessing the fields below.
+	db   *Database
+	iter *pebble.Iterator
+
+	initialized bool
+	closed      bool
+
+	key, val []byte
+	err      error
+}
+
+func (it *iter) Next() bool {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	// Short-circuit and set an error if the underlying database has been
+	// closed.
+	if it.db.closed {
+		it.markClosed()
+		return false
+	}
+	if it.closed || it.err != nil {
+		return false
+	}
+
+	var hasNext bool
+	if !it.initialized {
+		hasNext = it.iter.First()
+		it.initialized = true
+	} else {
+		hasNext = it.iter.Next()
+	}
+
+	if !hasNext {
+		it.key = nil
+		it.val = nil
+		return false
+	}
+
+	it.key = slices.Clone(it.iter.Key())
+
+	value, err := it.iter.ValueAndErr()
+	if err != nil {
+		it.key = nil
+		it.val = nil
+		it.err = err
+		return false
+	}
+	it.val = slices.Clone(value)
+	return true
+}
+
+func (it *iter) Error() error {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	if it.err != nil {
+		return it.err
+	}
+	if it.closed {
+		return nil
+	}
+	return updateError(it.iter.Error())
+}
+
+func (it *iter) Key() []byte {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	return it.key
+}
+
+func (it *iter) Value() []byte {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	return it.val
+}
+
+func (it *iter) Release() {
+	it.db.lock.Lock()
+	defer it.db.lock.Unlock()
+
+	if it.closed {
+		return
+	}
+	if err := it.release(); err != nil && it.err == nil {
+		it.err = err
+	}
+	it.db.openIterators.Remove(it)
+}
+
+// markClosed resets the iterator to report [database.ErrClosed].
+//
+// Assumes [it.db.lock] is held.
+func (it *iter) markClosed() {
+	it.key = nil
+	it.val = nil
+	it.err = database.ErrClosed
+}
+
+// release closes the underlying pebble iterator.
+//
+// Assumes [it.db.lock] is write locked.
+func (it *iter) release() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+	return updateError(it.iter.Close())
+}
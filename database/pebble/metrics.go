@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+var levelLabels = []string{"level"}
+
+// metrics mirrors the metrics reported by the leveldb implementation where
+// pebble exposes an equivalent statistic.
+type metrics struct {
+	// total number of writes that have been delayed due to compaction
+	writesDelayedCount prometheus.Counter
+	// total amount of time (in ns) that writes that have been delayed due to
+	// compaction
+	writesDelayedDuration prometheus.Counter
+	// set to 1 if there is currently at least one write that is being delayed
+	// due to compaction
+	writeIsDelayed prometheus.Gauge
+
+	// number of currently alive snapshots
+	aliveSnapshots prometheus.Gauge
+	// number of currently alive iterators
+	aliveIterators prometheus.Gauge
+
+	// total amount of data written
+	ioWrite prometheus.Counter
+	// total amount of data read
+	ioRead prometheus.Counter
+
+	// total number of bytes of cached data blocks
+	blockCacheSize prometheus.Gauge
+	// current number of open tables
+	openTables prometheus.Gauge
+
+	// number of tables per level
+	levelTableCount *prometheus.GaugeVec
+	// size of each level
+	levelSize *prometheus.GaugeVec
+	// amount of bytes read while compacting each level
+	levelReads *prometheus.CounterVec
+	// amount of bytes written while compacting each level
+	levelWrites *prometheus.CounterVec
+
+	// total number memory compactions (flushes) performed
+	memCompactions prometheus.Counter
+	// total number of compactions performed
+	compactions prometheus.Counter
+
+	// unix time (in ns) at which the current write stall started, or 0 if
+	// writes are not currently stalled. Must be accessed atomically.
+	stallStart int64
+
+	priorStats *pebble.Metrics
+}
+
+func newMetrics(namespace string, reg prometheus.Registerer) (metrics, error) {
+	m := metrics{
+		writesDelayedCount: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "writes_delayed",
+			Help:      "number of cumulative writes that have been delayed due to compaction",
+		}),
+		writesDelayedDuration: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "writes_delayed_duration",
+			Help:      "amount of time (in ns) that writes have been delayed due to compaction",
+		}),
+		writeIsDelayed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "write_delayed",
+			Help:      "1 if there is currently a write that is being delayed due to compaction",
+		}),
+
+		aliveSnapshots: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "alive_snapshots",
+			Help:      "number of currently alive snapshots",
+		}),
+		aliveIterators: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "alive_iterators",
+			Help:      "number of currently alive iterators",
+		}),
+
+		ioWrite: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "io_write",
+			Help:      "cumulative amount of io write during compaction",
+		}),
+		ioRead: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "io_read",
+			Help:      "cumulative amount of io read during compaction",
+		}),
+
+		blockCacheSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "block_cache_size",
+			Help:      "total size of cached blocks",
+		}),
+		openTables: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "open_tables",
+			Help:      "number of currently opened tables",
+		}),
+
+		levelTableCount: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "table_count",
+				Help:      "number of tables allocated by level",
+			},
+			levelLabels,
+		),
+		levelSize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "size",
+				Help:      "amount of bytes allocated by level",
+			},
+			levelLabels,
+		),
+		levelReads: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "reads",
+				Help:      "amount of bytes read during compaction by level",
+			},
+			levelLabels,
+		),
+		levelWrites: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "writes",
+				Help:      "amount of bytes written during compaction by level",
+			},
+			levelLabels,
+		),
+
+		memCompactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mem_comps",
+			Help:      "total number of memory compactions performed",
+		}),
+		compactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "comps",
+			Help:      "total number of compactions performed",
+		}),
+
+		priorStats: &pebble.Metrics{},
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		reg.Register(m.writesDelayedCount),
+		reg.Register(m.writesDelayedDuration),
+		reg.Register(m.writeIsDelayed),
+
+		reg.Register(m.aliveSnapshots),
+		reg.Register(m.aliveIterators),
+
+		reg.Register(m.ioWrite),
+		reg.Register(m.ioRead),
+
+		reg.Register(m.blockCacheSize),
+		reg.Register(m.openTables),
+
+		reg.Register(m.levelTableCount),
+		reg.Register(m.levelSize),
+		reg.Register(m.levelReads),
+		reg.Register(m.levelWrites),
+
+		reg.Register(m.memCompactions),
+		reg.Register(m.compactions),
+	)
+	return m, errs.Err
+}
+
+// eventListener returns the pebble callbacks used to track write stalls.
+func (m *metrics) eventListener() pebble.EventListener {
+	return pebble.EventListener{
+		WriteStallBegin: func(pebble.WriteStallBeginInfo) {
+			atomic.StoreInt64(&m.stallStart, time.Now().UnixNano())
+			m.writesDelayedCount.Inc()
+			m.writeIsDelayed.Set(1)
+		},
+		WriteStallEnd: func() {
+			if start := atomic.SwapInt64(&m.stallStart, 0); start != 0 {
+				m.writesDelayedDuration.Add(float64(time.Now().UnixNano() - start))
+			}
+			m.writeIsDelayed.Set(0)
+		},
+	}
+}
+
+func (db *Database) updateMetrics() {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return
+	}
+
+	metrics := &db.metrics
+
+	priorStats := metrics.priorStats
+	currentStats := db.pebbleDB.Metrics()
+
+	metrics.aliveSnapshots.Set(float64(currentStats.Snapshots.Count))
+	metrics.aliveIterators.Set(float64(len(db.openIterators)))
+
+	metrics.blockCacheSize.Set(float64(currentStats.BlockCache.Size))
+	metrics.openTables.Set(float64(currentStats.TableCache.Count))
+
+	var (
+		totalRead, priorTotalRead   uint64
+		totalWrite, priorTotalWrite uint64
+	)
+	for level, levelStats := range currentStats.Levels {
+		levelStr := strconv.Itoa(level)
+		priorLevelStats := priorStats.Levels[level]
+
+		levelRead := levelStats.BytesRead
+		levelWrite := levelStats.BytesCompacted + levelStats.BytesFlushed
+		priorLevelRead := priorLevelStats.BytesRead
+		priorLevelWrite := priorLevelStats.BytesCompacted + priorLevelStats.BytesFlushed
+
+		metrics.levelTableCount.WithLabelValues(levelStr).Set(float64(levelStats.NumFiles))
+		metrics.levelSize.WithLabelValues(levelStr).Set(float64(levelStats.Size))
+		metrics.levelReads.WithLabelValues(levelStr).Add(float64(levelRead - priorLevelRead))
+		metrics.levelWrites.WithLabelValues(levelStr).Add(float64(levelWrite - priorLevelWrite))
+
+		totalRead += levelRead
+		totalWrite += levelWrite
+		priorTotalRead += priorLevelRead
+		priorTotalWrite += priorLevelWrite
+	}
+
+	metrics.ioRead.Add(float64(totalRead - priorTotalRead))
+	metrics.ioWrite.Add(float64(totalWrite - priorTotalWrite))
+
+	metrics.memCompactions.Add(float64(currentStats.Flush.Count - priorStats.Flush.Count))
+	metrics.compactions.Add(float64(currentStats.Compact.Count - priorStats.Compact.Count))
+
+	// update the priorStats to update the counters correctly next time this
+	// method is called
+	metrics.priorStats = currentStats
+}
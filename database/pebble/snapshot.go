@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+)
+
+var (
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Snapshot    = (*snapshot)(nil)
+)
+
+// NewSnapshot returns a consistent view of the database at the time of the
+// call.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	s := &snapshot{
+		db:       db,
+		snapshot: db.pebbleDB.NewSnapshot(),
+	}
+	db.openSnapshots.Add(s)
+	return s, nil
+}
+
+// snapshot is a wrapper around a pebble snapshot.
+type snapshot struct {
+	// [db] must be locked when accessing the fields below.
+	db       *Database
+	snapshot *pebble.Snapshot
+	closed   bool
+}
+
+// Has returns if the key was set in the database when the snapshot was taken
+func (s *snapshot) Has(key []byte) (bool, error) {
+	s.db.lock.RLock()
+	defer s.db.lock.RUnlock()
+
+	if s.closed {
+		return false, database.ErrClosed
+	}
+
+	_, closer, err := s.snapshot.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, updateError(err)
+	}
+	return true, closer.Close()
+}
+
+// Get returns the value the key mapped to in the database when the snapshot
+// was taken
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	s.db.lock.RLock()
+	defer s.db.lock.RUnlock()
+
+	if s.closed {
+		return nil, database.ErrClosed
+	}
+
+	data, closer, err := s.snapshot.Get(key)
+	if err != nil {
+		return nil, updateError(err)
+	}
+	value := slices.Clone(data)
+	return value, closer.Close()
+}
+
+func (s *snapshot) NewIterator() database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+
+	if s.closed {
+		return &database.IteratorError{
+			Err: database.ErrClosed,
+		}
+	}
+
+	it := &iter{
+		db:   s.db,
+		iter: s.snapshot.NewIter(keyRange(start, prefix)),
+	}
+	s.db.openIterators.Add(it)
+	return it
+}
+
+func (s *snapshot) Release() {
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+
+	// The error is dropped because the snapshot can't be used after it has
+	// been released.
+	_ = s.release()
+	s.db.openSnapshots.Remove(s)
+}
+
+// release closes the underlying pebble snapshot.
+//
+// Assumes [s.db.lock] is write locked.
+func (s *snapshot) release() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return updateError(s.snapshot.Close())
+}
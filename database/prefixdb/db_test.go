@@ -22,6 +22,15 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestSnapshotInterface(t *testing.T) {
+	for _, test := range database.SnapshotTests {
+		db := memdb.New()
+		test(t, New([]byte("hello"), db))
+		test(t, New([]byte("world"), db))
+		test(t, NewNested([]byte("wor"), New([]byte("ld"), db)))
+	}
+}
+
 func FuzzInterface(f *testing.F) {
 	for _, test := range database.FuzzTests {
 		test(f, New([]byte(""), memdb.New()))
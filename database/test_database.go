@@ -58,6 +58,14 @@ var Tests = []func(t *testing.T, db Database){
 	TestPutGetEmpty,
 }
 
+// SnapshotTests is a list of tests for databases that implement [Snapshotter]
+var SnapshotTests = []func(t *testing.T, db Database){
+	TestSnapshot,
+	TestSnapshotIterator,
+	TestSnapshotRelease,
+	TestSnapshotClosed,
+}
+
 var FuzzTests = []func(*testing.F, Database){
 	FuzzKeyValue,
 }
@@ -1138,6 +1146,155 @@ func TestPutGetEmpty(t *testing.T, db Database) {
 	require.Empty(value) // May be nil or empty byte slice.
 }
 
+// TestSnapshot tests to make sure that writes performed after a snapshot was
+// created are not visible through the snapshot.
+func TestSnapshot(t *testing.T, db Database) {
+	require := require.New(t)
+
+	key1 := []byte("hello1")
+	value1 := []byte("world1")
+	key2 := []byte("hello2")
+	value2 := []byte("world2")
+	key3 := []byte("hello3")
+	value3 := []byte("world3")
+
+	require.NoError(db.Put(key1, value1))
+	require.NoError(db.Put(key2, value2))
+
+	snapshot, err := NewSnapshot(db)
+	require.NoError(err)
+	defer snapshot.Release()
+
+	require.NoError(db.Put(key1, value2))
+	require.NoError(db.Delete(key2))
+	require.NoError(db.Put(key3, value3))
+
+	value, err := snapshot.Get(key1)
+	require.NoError(err)
+	require.Equal(value1, value)
+
+	has, err := snapshot.Has(key2)
+	require.NoError(err)
+	require.True(has)
+
+	value, err = snapshot.Get(key2)
+	require.NoError(err)
+	require.Equal(value2, value)
+
+	has, err = snapshot.Has(key3)
+	require.NoError(err)
+	require.False(has)
+
+	_, err = snapshot.Get(key3)
+	require.Equal(ErrNotFound, err)
+
+	value, err = db.Get(key1)
+	require.NoError(err)
+	require.Equal(value2, value)
+}
+
+// TestSnapshotIterator tests to make sure that iterators created from a
+// snapshot only report the key/value pairs that existed when the snapshot was
+// created.
+func TestSnapshotIterator(t *testing.T, db Database) {
+	require := require.New(t)
+
+	key1 := []byte("hello1")
+	value1 := []byte("world1")
+	key2 := []byte("hello2")
+	value2 := []byte("world2")
+	key3 := []byte("goodbye3")
+	value3 := []byte("world3")
+
+	require.NoError(db.Put(key1, value1))
+	require.NoError(db.Put(key2, value2))
+	require.NoError(db.Put(key3, value3))
+
+	snapshot, err := NewSnapshot(db)
+	require.NoError(err)
+	defer snapshot.Release()
+
+	require.NoError(db.Delete(key1))
+	require.NoError(db.Put(key2, value1))
+	require.NoError(db.Put([]byte("hello0"), value1))
+
+	iterator := snapshot.NewIterator()
+	require.True(iterator.Next())
+	require.Equal(key3, iterator.Key())
+	require.Equal(value3, iterator.Value())
+	require.True(iterator.Next())
+	require.Equal(key1, iterator.Key())
+	require.Equal(value1, iterator.Value())
+	require.True(iterator.Next())
+	require.Equal(key2, iterator.Key())
+	require.Equal(value2, iterator.Value())
+	require.False(iterator.Next())
+	require.NoError(iterator.Error())
+	iterator.Release()
+
+	iterator = snapshot.NewIteratorWithStartAndPrefix(key2, []byte("hello"))
+	require.True(iterator.Next())
+	require.Equal(key2, iterator.Key())
+	require.Equal(value2, iterator.Value())
+	require.False(iterator.Next())
+	require.NoError(iterator.Error())
+	iterator.Release()
+}
+
+// TestSnapshotRelease tests to make sure that a released snapshot reports
+// [ErrClosed] and doesn't affect the database.
+func TestSnapshotRelease(t *testing.T, db Database) {
+	require := require.New(t)
+
+	key := []byte("hello")
+	value := []byte("world")
+
+	require.NoError(db.Put(key, value))
+
+	snapshot, err := NewSnapshot(db)
+	require.NoError(err)
+	snapshot.Release()
+
+	_, err = snapshot.Has(key)
+	require.Equal(ErrClosed, err)
+
+	_, err = snapshot.Get(key)
+	require.Equal(ErrClosed, err)
+
+	iterator := snapshot.NewIterator()
+	require.False(iterator.Next())
+	require.Equal(ErrClosed, iterator.Error())
+	iterator.Release()
+
+	gotValue, err := db.Get(key)
+	require.NoError(err)
+	require.Equal(value, gotValue)
+}
+
+// TestSnapshotClosed tests to make sure that snapshots can't be created from a
+// closed database and that closing the database invalidates existing
+// snapshots.
+func TestSnapshotClosed(t *testing.T, db Database) {
+	require := require.New(t)
+
+	key := []byte("hello")
+	value := []byte("world")
+
+	require.NoError(db.Put(key, value))
+
+	snapshot, err := NewSnapshot(db)
+	require.NoError(err)
+	defer snapshot.Release()
+
+	require.NoError(db.Close())
+
+	_, err = snapshot.Get(key)
+	require.Equal(ErrClosed, err)
+
+	_, err = NewSnapshot(db)
+	require.Equal(ErrClosed, err)
+}
+
 func FuzzKeyValue(f *testing.F, db Database) {
 	f.Fuzz(func(t *testing.T, key []byte, value []byte) {
 		require := require.New(t)
@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"golang.org/x/time/rate"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+const (
+	// The number of keys that are read with a single iterator. The iterator
+	// is released between batches so that an estimate never holds on to the
+	// database for long.
+	scanBatchSize = 1024
+
+	// The max number of keys per second that are read across all the tracked
+	// databases.
+	maxScanRate = 64 * scanBatchSize
+)
+
+var (
+	usageLabels = []string{"name"}
+
+	errStopped = errors.New("usage tracker stopped")
+)
+
+// Usage is an estimate of the storage used by a database.
+type Usage struct {
+	NumKeys  uint64 `json:"numKeys"`
+	NumBytes uint64 `json:"numBytes"`
+}
+
+// Tracker periodically estimates the number of keys and bytes stored in a set
+// of named databases. The estimates are computed in the background so that
+// reading them never requires iterating over the databases.
+//
+// The databases are read incrementally, in batches of keys, and the rate at
+// which keys are read is limited so that the estimates don't compete with the
+// rest of the node for disk bandwidth.
+type Tracker struct {
+	log       logging.Logger
+	frequency time.Duration
+
+	// Limits the number of keys that are read per second
+	limiter   *rate.Limiter
+	batchSize int
+
+	numKeys  *prometheus.GaugeVec
+	numBytes *prometheus.GaugeVec
+
+	lock sync.RWMutex
+	dbs  map[string]database.Iteratee
+	// Names of the databases that have been tracked since the last update
+	pending     set.Set[string]
+	usage       map[string]Usage
+	lastUpdated time.Time
+
+	// Send a value on [refresh] to estimate the pending databases before the
+	// next tick
+	refresh chan struct{}
+	// Cancelled when the tracker is stopped
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewTracker returns a tracker that updates its estimates every [frequency]
+// once [Dispatch] is called.
+func NewTracker(
+	log logging.Logger,
+	frequency time.Duration,
+	namespace string,
+	reg prometheus.Registerer,
+) (*Tracker, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &Tracker{
+		log:       log,
+		frequency: frequency,
+		limiter:   rate.NewLimiter(maxScanRate, scanBatchSize),
+		batchSize: scanBatchSize,
+		numKeys: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "num_keys",
+				Help:      "estimated number of keys stored in the database",
+			},
+			usageLabels,
+		),
+		numBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "num_bytes",
+				Help:      "estimated number of key and value bytes stored in the database",
+			},
+			usageLabels,
+		),
+		dbs:     make(map[string]database.Iteratee),
+		usage:   make(map[string]Usage),
+		refresh: make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		reg.Register(t.numKeys),
+		reg.Register(t.numBytes),
+	)
+	return t, errs.Err
+}
+
+// Track adds [db] to the set of tracked databases under [name]. If a database
+// is already tracked under [name], it is replaced. The estimate for [db] is
+// computed in the background without waiting for the next update.
+func (t *Tracker) Track(name string, db database.Iteratee) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.dbs[name] = db
+	t.pending.Add(name)
+
+	select {
+	case t.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// Usage returns the most recent estimates and the time at which the last
+// update completed. The returned time is zero if no update has completed.
+func (t *Tracker) Usage() (map[string]Usage, time.Time) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return maps.Clone(t.usage), t.lastUpdated
+}
+
+// Dispatch updates the estimates every [frequency] until [Stop] is called.
+func (t *Tracker) Dispatch() {
+	ticker := time.NewTicker(t.frequency)
+	defer ticker.Stop()
+
+	for {
+		if err := t.update(false); err == errStopped {
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-t.refresh:
+			if err := t.update(true); err == errStopped {
+				return
+			}
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop the tracker. An in progress update exits without recording its
+// estimate.
+func (t *Tracker) Stop() {
+	t.cancel()
+}
+
+// update the estimates of the tracked databases. If [onlyPending] is true,
+// only the databases that haven't been estimated yet are updated.
+func (t *Tracker) update(onlyPending bool) error {
+	t.lock.Lock()
+	dbs := make(map[string]database.Iteratee, len(t.dbs))
+	for name, db := range t.dbs {
+		if !onlyPending || t.pending.Contains(name) {
+			dbs[name] = db
+		}
+	}
+	t.pending.Clear()
+	t.lock.Unlock()
+
+	start := time.Now()
+	for name, db := range dbs {
+		usage, err := t.estimate(db)
+		if err == errStopped {
+			return err
+		}
+		if err != nil {
+			t.log.Warn("failed to estimate storage usage",
+				zap.String("name", name),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		t.numKeys.WithLabelValues(name).Set(float64(usage.NumKeys))
+		t.numBytes.WithLabelValues(name).Set(float64(usage.NumBytes))
+
+		t.lock.Lock()
+		t.usage[name] = usage
+		t.lock.Unlock()
+	}
+
+	t.lock.Lock()
+	t.lastUpdated = time.Now()
+	t.lock.Unlock()
+
+	t.log.Debug("updated storage usage",
+		zap.Int("numDatabases", len(dbs)),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+// estimate iterates over [db] in batches of [batchSize] keys. Because each
+// batch is read with a new iterator, the estimate doesn't reflect a single
+// point in time if [db] is written to while it is being estimated.
+func (t *Tracker) estimate(db database.Iteratee) (Usage, error) {
+	var (
+		usage Usage
+		start []byte
+	)
+	for {
+		if err := t.limiter.WaitN(t.ctx, t.batchSize); err != nil {
+			return Usage{}, errStopped
+		}
+
+		lastKey, numKeys, err := t.estimateBatch(db, start, &usage)
+		if err != nil || numKeys < t.batchSize {
+			return usage, err
+		}
+		// The next batch starts at the smallest key after [lastKey].
+		start = append(lastKey, 0)
+	}
+}
+
+// estimateBatch adds the usage of at most [batchSize] keys in [db], starting
+// at [start], to [usage]. Returns the last key that was read and the number of
+// keys that were read.
+func (t *Tracker) estimateBatch(db database.Iteratee, start []byte, usage *Usage) ([]byte, int, error) {
+	it := db.NewIteratorWithStart(start)
+	defer it.Release()
+
+	var (
+		lastKey []byte
+		numKeys int
+	)
+	for numKeys < t.batchSize && it.Next() {
+		key := it.Key()
+		usage.NumKeys++
+		usage.NumBytes += uint64(len(key) + len(it.Value()))
+		lastKey = key
+		numKeys++
+	}
+	return slices.Clone(lastKey), numKeys, it.Error()
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/time/rate"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+)
+
+func TestTracker(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	chainDB := prefixdb.New([]byte("chain"), db)
+	keystoreDB := prefixdb.New([]byte("keystore"), db)
+	for i := 0; i < 10; i++ {
+		require.NoError(chainDB.Put([]byte{byte(i)}, []byte{1, 2, 3}))
+	}
+	require.NoError(keystoreDB.Put([]byte{0}, nil))
+
+	tracker, err := NewTracker(logging.NoLog{}, time.Hour, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	usage, lastUpdated := tracker.Usage()
+	require.Empty(usage)
+	require.True(lastUpdated.IsZero())
+
+	tracker.Track("chain", chainDB)
+	tracker.Track("keystore", keystoreDB)
+	require.NoError(tracker.update(false))
+
+	usage, lastUpdated = tracker.Usage()
+	require.Equal(map[string]Usage{
+		"chain": {
+			NumKeys:  10,
+			NumBytes: 40,
+		},
+		"keystore": {
+			NumKeys:  1,
+			NumBytes: 1,
+		},
+	}, usage)
+	require.False(lastUpdated.IsZero())
+
+	// Writes are only reflected after the next update.
+	require.NoError(keystoreDB.Put([]byte{1}, []byte{1}))
+	usage, _ = tracker.Usage()
+	require.Equal(uint64(1), usage["keystore"].NumKeys)
+
+	require.NoError(tracker.update(false))
+	usage, _ = tracker.Usage()
+	require.Equal(Usage{NumKeys: 2, NumBytes: 3}, usage["keystore"])
+
+	// Only newly tracked databases are estimated when updating pending
+	// databases.
+	require.NoError(chainDB.Put([]byte{10}, nil))
+	tracker.Track("new", prefixdb.New([]byte("new"), db))
+	require.NoError(tracker.update(true))
+	usage, _ = tracker.Usage()
+	require.Equal(uint64(10), usage["chain"].NumKeys)
+	require.Equal(Usage{}, usage["new"])
+	require.Contains(usage, "new")
+}
+
+func TestTrackerStop(t *testing.T) {
+	tracker, err := NewTracker(logging.NoLog{}, time.Hour, "", prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	tracker.Track("db", memdb.New())
+	go tracker.Dispatch()
+	tracker.Stop()
+}
+
+func TestTrackerBatches(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	for i := 0; i < 10; i++ {
+		require.NoError(db.Put([]byte{byte(i)}, []byte{1}))
+	}
+
+	tracker, err := NewTracker(logging.NoLog{}, time.Hour, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	for _, batchSize := range []int{1, 3, 5, 10, 11} {
+		tracker.batchSize = batchSize
+		tracker.limiter = rate.NewLimiter(rate.Inf, batchSize)
+
+		usage, err := tracker.estimate(db)
+		require.NoError(err)
+		require.Equal(Usage{NumKeys: 10, NumBytes: 20}, usage)
+	}
+}
+
+func TestTrackerStopRateLimited(t *testing.T) {
+	require := require.New(t)
+
+	tracker, err := NewTracker(logging.NoLog{}, time.Hour, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	// Only the first batch can be read without waiting.
+	tracker.batchSize = 1
+	tracker.limiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	db := memdb.New()
+	require.NoError(db.Put([]byte{0}, nil))
+	require.NoError(db.Put([]byte{1}, nil))
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := tracker.estimate(db)
+		errs <- err
+	}()
+
+	tracker.Stop()
+	require.ErrorIs(<-errs, errStopped)
+}
@@ -16,10 +16,12 @@ import (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ Commitable        = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iterator)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ Commitable           = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iterator)(nil)
+	_ database.Snapshot    = (*snapshot)(nil)
 )
 
 // Commitable defines the interface that specifies that something may be
@@ -130,10 +132,22 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 		}
 	}
 
+	keys, values := sortedEntries(db.mem, start, prefix)
+	return &iterator{
+		db:       db,
+		Iterator: db.db.NewIteratorWithStartAndPrefix(start, prefix),
+		keys:     keys,
+		values:   values,
+	}
+}
+
+// sortedEntries returns the entries of [mem] that are >= [start] and have the
+// prefix [prefix], in sorted order.
+func sortedEntries(mem map[string]valueDelete, start, prefix []byte) ([]string, []valueDelete) {
 	startString := string(start)
 	prefixString := string(prefix)
-	keys := make([]string, 0, len(db.mem))
-	for key := range db.mem {
+	keys := make([]string, 0, len(mem))
+	for key := range mem {
 		if strings.HasPrefix(key, prefixString) && key >= startString {
 			keys = append(keys, key)
 		}
@@ -141,15 +155,31 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 	slices.Sort(keys) // Keys need to be in sorted order
 	values := make([]valueDelete, len(keys))
 	for i, key := range keys {
-		values[i] = db.mem[key]
+		values[i] = mem[key]
 	}
+	return keys, values
+}
 
-	return &iterator{
-		db:       db,
-		Iterator: db.db.NewIteratorWithStartAndPrefix(start, prefix),
-		keys:     keys,
-		values:   values,
+// NewSnapshot returns a consistent view of this database, including all of
+// the uncommitted operations, at the time of the call. The underlying database
+// must support snapshots.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.mem == nil {
+		return nil, database.ErrClosed
+	}
+
+	s, err := database.NewSnapshot(db.db)
+	if err != nil {
+		return nil, err
 	}
+	return &snapshot{
+		Snapshot: s,
+		db:       db,
+		mem:      maps.Clone(db.mem),
+	}, nil
 }
 
 func (db *Database) Compact(start, limit []byte) error {
@@ -302,6 +332,85 @@ func (b *batch) Inner() database.Batch {
 	return b
 }
 
+// snapshot of both the in memory database and the underlying database
+type snapshot struct {
+	database.Snapshot
+	db *Database
+
+	// mem is a copy of [db.mem] at the time the snapshot was created. It is
+	// set to nil when the snapshot is released.
+	lock sync.RWMutex
+	mem  map[string]valueDelete
+}
+
+func (s *snapshot) Has(key []byte) (bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.mem == nil || s.db.isClosed() {
+		return false, database.ErrClosed
+	}
+	if val, has := s.mem[string(key)]; has {
+		return !val.delete, nil
+	}
+	return s.Snapshot.Has(key)
+}
+
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.mem == nil || s.db.isClosed() {
+		return nil, database.ErrClosed
+	}
+	if val, has := s.mem[string(key)]; has {
+		if val.delete {
+			return nil, database.ErrNotFound
+		}
+		return slices.Clone(val.value), nil
+	}
+	return s.Snapshot.Get(key)
+}
+
+func (s *snapshot) NewIterator() database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.mem == nil || s.db.isClosed() {
+		return &database.IteratorError{
+			Err: database.ErrClosed,
+		}
+	}
+
+	keys, values := sortedEntries(s.mem, start, prefix)
+	return &iterator{
+		db:       s.db,
+		Iterator: s.Snapshot.NewIteratorWithStartAndPrefix(start, prefix),
+		keys:     keys,
+		values:   values,
+	}
+}
+
+func (s *snapshot) Release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.mem = nil
+	s.Snapshot.Release()
+}
+
 // iterator walks over both the in memory database and the underlying database
 // at the same time.
 type iterator struct {
@@ -5,6 +5,7 @@ package message
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,6 +25,10 @@ import (
 var (
 	_ InboundMessage  = (*inboundMessage)(nil)
 	_ OutboundMessage = (*outboundMessage)(nil)
+
+	// compressedTypes are the compression types that messages can be
+	// compressed with.
+	compressedTypes = []compression.Type{compression.TypeGzip, compression.TypeZstd}
 )
 
 // InboundMessage represents a set of fields for an inbound message
@@ -88,11 +93,16 @@ type OutboundMessage interface {
 	BypassThrottling() bool
 	// Op returns the op that describes this message type
 	Op() Op
+	// Message returns the message that will be sent
+	Message() any
 	// Bytes returns the bytes that will be sent
 	Bytes() []byte
 	// BytesSavedCompression returns the number of bytes that this message saved
 	// due to being compressed
 	BytesSavedCompression() int
+	// CompressionType returns the type of compression the bytes were
+	// compressed with
+	CompressionType() compression.Type
 }
 
 type outboundMessage struct {
@@ -100,6 +110,21 @@ type outboundMessage struct {
 	op                    Op
 	bytes                 []byte
 	bytesSavedCompression int
+	compressionType       compression.Type
+
+	// The message that [bytes] encodes. Kept so that the message can be
+	// inspected, or compressed with another type, without decompressing
+	// [bytes].
+	msg *p2p.Message
+	// The message wrapped by [msg]
+	message any
+
+	// Protects [recompressed]
+	recompressedLock sync.Mutex
+	// compression type --> this message compressed with that type
+	// The same message is often sent to many peers, so each type is only
+	// compressed once.
+	recompressed map[compression.Type]*outboundMessage
 }
 
 func (m *outboundMessage) BypassThrottling() bool {
@@ -110,6 +135,10 @@ func (m *outboundMessage) Op() Op {
 	return m.op
 }
 
+func (m *outboundMessage) Message() any {
+	return m.message
+}
+
 func (m *outboundMessage) Bytes() []byte {
 	return m.bytes
 }
@@ -118,12 +147,27 @@ func (m *outboundMessage) BytesSavedCompression() int {
 	return m.bytesSavedCompression
 }
 
-// TODO: add other compression algorithms with extended interface
+func (m *outboundMessage) CompressionType() compression.Type {
+	return m.compressionType
+}
+
+// compressionMetrics are the metrics of a compression algorithm, across all
+// message types.
+type compressionMetrics struct {
+	compressTime   metric.Averager
+	decompressTime metric.Averager
+	// compressed size / uncompressed size of compressed messages
+	compressionRatio metric.Averager
+}
+
 type msgBuilder struct {
-	gzipCompressor compression.Compressor
+	// compression type --> compressor
+	// Contains every compression type that compresses messages.
+	compressors map[compression.Type]compression.Compressor
 
 	compressTimeMetrics   map[Op]metric.Averager
 	decompressTimeMetrics map[Op]metric.Averager
+	compressionMetrics    map[compression.Type]*compressionMetrics
 
 	maxMessageTimeout time.Duration
 }
@@ -133,21 +177,47 @@ func newMsgBuilder(
 	metrics prometheus.Registerer,
 	maxMessageTimeout time.Duration,
 ) (*msgBuilder, error) {
-	cpr, err := compression.NewGzipCompressor(constants.DefaultMaxMessageSize)
-	if err != nil {
-		return nil, err
-	}
-
 	mb := &msgBuilder{
-		gzipCompressor: cpr,
+		compressors: make(map[compression.Type]compression.Compressor, len(compressedTypes)),
 
 		compressTimeMetrics:   make(map[Op]metric.Averager, len(ExternalOps)),
 		decompressTimeMetrics: make(map[Op]metric.Averager, len(ExternalOps)),
+		compressionMetrics:    make(map[compression.Type]*compressionMetrics, len(compressedTypes)),
 
 		maxMessageTimeout: maxMessageTimeout,
 	}
 
 	errs := wrappers.Errs{}
+	for _, compressionType := range compressedTypes {
+		compressor, err := compression.NewCompressor(compressionType, constants.DefaultMaxMessageSize)
+		if err != nil {
+			return nil, err
+		}
+		mb.compressors[compressionType] = compressor
+		mb.compressionMetrics[compressionType] = &compressionMetrics{
+			compressTime: metric.NewAveragerWithErrs(
+				namespace,
+				fmt.Sprintf("%s_compress_time", compressionType),
+				fmt.Sprintf("time (in ns) to compress messages with %s", compressionType),
+				metrics,
+				&errs,
+			),
+			decompressTime: metric.NewAveragerWithErrs(
+				namespace,
+				fmt.Sprintf("%s_decompress_time", compressionType),
+				fmt.Sprintf("time (in ns) to decompress messages with %s", compressionType),
+				metrics,
+				&errs,
+			),
+			compressionRatio: metric.NewAveragerWithErrs(
+				namespace,
+				fmt.Sprintf("%s_compression_ratio", compressionType),
+				fmt.Sprintf("compressed size / uncompressed size of messages compressed with %s", compressionType),
+				metrics,
+				&errs,
+			),
+		}
+	}
 	for _, op := range ExternalOps {
 		mb.compressTimeMetrics[op] = metric.NewAveragerWithErrs(
 			namespace,
@@ -169,14 +239,15 @@ func newMsgBuilder(
 
 func (mb *msgBuilder) marshal(
 	uncompressedMsg *p2p.Message,
-	gzipCompress bool,
+	compressionType compression.Type,
 ) ([]byte, int, time.Duration, error) {
 	uncompressedMsgBytes, err := proto.Marshal(uncompressedMsg)
 	if err != nil {
 		return nil, 0, 0, err
 	}
 
-	if !gzipCompress {
+	compressor, ok := mb.compressors[compressionType]
+	if !ok {
 		return uncompressedMsgBytes, 0, 0, nil
 	}
 
@@ -187,15 +258,21 @@ func (mb *msgBuilder) marshal(
 	// This recursive packing allows us to avoid an extra compression on/off
 	// field in the message.
 	startTime := time.Now()
-	compressedBytes, err := mb.gzipCompressor.Compress(uncompressedMsgBytes)
+	compressedBytes, err := compressor.Compress(uncompressedMsgBytes)
 	if err != nil {
 		return nil, 0, 0, err
 	}
 
-	compressedMsg := p2p.Message{
-		Message: &p2p.Message_CompressedGzip{
+	var compressedMsg p2p.Message
+	switch compressionType {
+	case compression.TypeGzip:
+		compressedMsg.Message = &p2p.Message_CompressedGzip{
 			CompressedGzip: compressedBytes,
-		},
+		}
+	case compression.TypeZstd:
+		compressedMsg.Message = &p2p.Message_CompressedZstd{
+			CompressedZstd: compressedBytes,
+		}
 	}
 	compressedMsgBytes, err := proto.Marshal(&compressedMsg)
 	if err != nil {
@@ -203,6 +280,10 @@ func (mb *msgBuilder) marshal(
 	}
 	compressTook := time.Since(startTime)
 
+	metrics := mb.compressionMetrics[compressionType]
+	metrics.compressTime.Observe(float64(compressTook))
+	metrics.compressionRatio.Observe(float64(len(compressedMsgBytes)) / float64(len(uncompressedMsgBytes)))
+
 	bytesSaved := len(uncompressedMsgBytes) - len(compressedMsgBytes)
 	return compressedMsgBytes, bytesSaved, compressTook, nil
 }
@@ -213,14 +294,24 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, bool, int, time.Duratio
 		return nil, false, 0, 0, err
 	}
 
-	compressed := m.GetCompressedGzip()
-	if len(compressed) == 0 {
+	var (
+		compressionType compression.Type
+		compressed      []byte
+	)
+	switch {
+	case len(m.GetCompressedGzip()) != 0:
+		compressionType = compression.TypeGzip
+		compressed = m.GetCompressedGzip()
+	case len(m.GetCompressedZstd()) != 0:
+		compressionType = compression.TypeZstd
+		compressed = m.GetCompressedZstd()
+	default:
 		// The message wasn't compressed
 		return m, false, 0, 0, nil
 	}
 
 	startTime := time.Now()
-	decompressed, err := mb.gzipCompressor.Decompress(compressed)
+	decompressed, err := mb.compressors[compressionType].Decompress(compressed)
 	if err != nil {
 		return nil, true, 0, 0, err
 	}
@@ -229,13 +320,14 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, bool, int, time.Duratio
 		return nil, true, 0, 0, err
 	}
 	decompressTook := time.Since(startTime)
+	mb.compressionMetrics[compressionType].decompressTime.Observe(float64(decompressTook))
 
 	bytesSavedCompression := len(decompressed) - len(compressed)
 	return m, true, bytesSavedCompression, decompressTook, nil
 }
 
-func (mb *msgBuilder) createOutbound(m *p2p.Message, gzipCompress bool, bypassThrottling bool) (*outboundMessage, error) {
-	b, saved, compressTook, err := mb.marshal(m, gzipCompress)
+func (mb *msgBuilder) createOutbound(m *p2p.Message, compressionType compression.Type, bypassThrottling bool) (*outboundMessage, error) {
+	b, saved, compressTook, err := mb.marshal(m, compressionType)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +337,14 @@ func (mb *msgBuilder) createOutbound(m *p2p.Message, gzipCompress bool, bypassTh
 		return nil, err
 	}
 
-	if gzipCompress {
+	message, err := Unwrap(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := mb.compressors[compressionType]; !ok {
+		compressionType = compression.TypeNone
+	} else {
 		mb.compressTimeMetrics[op].Observe(float64(compressTook))
 	}
 
@@ -254,9 +353,34 @@ func (mb *msgBuilder) createOutbound(m *p2p.Message, gzipCompress bool, bypassTh
 		op:                    op,
 		bytes:                 b,
 		bytesSavedCompression: saved,
+		compressionType:       compressionType,
+		msg:                   m,
+		message:               message,
 	}, nil
 }
 
+// recompress returns [msg] compressed with [compressionType]. [msg] must be
+// compressed. The result is cached in [msg], so each compression type is only
+// compressed once, regardless of the number of peers [msg] is sent to.
+func (mb *msgBuilder) recompress(msg *outboundMessage, compressionType compression.Type) (OutboundMessage, error) {
+	msg.recompressedLock.Lock()
+	defer msg.recompressedLock.Unlock()
+
+	if recompressedMsg, ok := msg.recompressed[compressionType]; ok {
+		return recompressedMsg, nil
+	}
+
+	recompressedMsg, err := mb.createOutbound(msg.msg, compressionType, msg.bypassThrottling)
+	if err != nil {
+		return nil, err
+	}
+	if msg.recompressed == nil {
+		msg.recompressed = make(map[compression.Type]*outboundMessage, len(compressedTypes))
+	}
+	msg.recompressed[compressionType] = recompressedMsg
+	return recompressedMsg, nil
+}
+
 func (mb *msgBuilder) parseInbound(
 	bytes []byte,
 	nodeID ids.NodeID,
@@ -4,6 +4,7 @@
 package message
 
 import (
+	"math/rand"
 	"net"
 	"os"
 	"testing"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
+	"github.com/VidarSolutions/avalanchego/utils/units"
 )
 
 var (
@@ -70,7 +73,7 @@ func BenchmarkMarshalVersion(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		if useBuilder {
-			_, err = codec.createOutbound(&msg, false, false)
+			_, err = codec.createOutbound(&msg, compression.TypeNone, false)
 		} else {
 			_, err = proto.Marshal(&msg)
 		}
@@ -133,3 +136,40 @@ func BenchmarkUnmarshalVersion(b *testing.B) {
 		require.NoError(err)
 	}
 }
+
+// Benchmarks compressing an "Ancestors" message, as sent while serving
+// bootstrapping nodes, with each compression type.
+func BenchmarkCompressAncestors(b *testing.B) {
+	chainID := ids.GenerateTestID()
+	containers := make([][]byte, 100)
+	for i := range containers {
+		// half of each container is random, half is compressible
+		containers[i] = make([]byte, units.KiB)
+		_, _ = rand.Read(containers[i][:units.KiB/2]) // #nosec G404
+	}
+	msg := p2p.Message{
+		Message: &p2p.Message_Ancestors_{
+			Ancestors_: &p2p.Ancestors{
+				ChainId:    chainID[:],
+				RequestId:  1,
+				Containers: containers,
+			},
+		},
+	}
+
+	for _, compressionType := range compression.Types {
+		b.Run(compressionType.String(), func(b *testing.B) {
+			require := require.New(b)
+
+			codec, err := newMsgBuilder("", prometheus.NewRegistry(), 10*time.Second)
+			require.NoError(err)
+
+			var outMsg *outboundMessage
+			for i := 0; i < b.N; i++ {
+				outMsg, err = codec.createOutbound(&msg, compressionType, false)
+				require.NoError(err)
+			}
+			b.ReportMetric(float64(len(outMsg.Bytes())), "bytes/msg")
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
 	"github.com/VidarSolutions/avalanchego/staking"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 )
 
 func TestMessage(t *testing.T) {
@@ -51,7 +52,7 @@ func TestMessage(t *testing.T) {
 		desc             string
 		op               Op
 		msg              *p2p.Message
-		gzipCompress     bool
+		compressionType  compression.Type
 		bypassThrottling bool
 		bytesSaved       bool // if true, outbound message saved bytes must be non-zero
 	}{
@@ -63,7 +64,7 @@ func TestMessage(t *testing.T) {
 					Ping: &p2p.Ping{},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -77,7 +78,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -97,7 +98,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -118,7 +119,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -140,7 +141,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -162,7 +163,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -181,7 +182,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: false,
 			bytesSaved:       false,
 		},
@@ -197,7 +198,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -213,7 +214,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -229,7 +230,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -246,7 +247,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -263,7 +264,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -279,7 +280,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -295,7 +296,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -312,7 +313,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -328,7 +329,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -346,7 +347,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -362,7 +363,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -380,7 +381,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -396,7 +397,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -412,7 +413,23 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
+			bypassThrottling: true,
+			bytesSaved:       true,
+		},
+		{
+			desc: "ancestors message with zstd compression",
+			op:   AncestorsOp,
+			msg: &p2p.Message{
+				Message: &p2p.Message_Ancestors_{
+					Ancestors_: &p2p.Ancestors{
+						ChainId:    testID[:],
+						RequestId:  12345,
+						Containers: compressibleContainers,
+					},
+				},
+			},
+			compressionType:  compression.TypeZstd,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -430,7 +447,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -447,7 +464,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -464,7 +481,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -482,7 +499,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -500,7 +517,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -518,7 +535,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -534,7 +551,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -551,7 +568,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -568,7 +585,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -584,7 +601,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -600,7 +617,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -615,7 +632,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     false,
+			compressionType:  compression.TypeNone,
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
@@ -630,7 +647,7 @@ func TestMessage(t *testing.T) {
 					},
 				},
 			},
-			gzipCompress:     true,
+			compressionType:  compression.TypeGzip,
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
@@ -638,11 +655,12 @@ func TestMessage(t *testing.T) {
 
 	for _, tv := range tests {
 		require.True(t.Run(tv.desc, func(t2 *testing.T) {
-			encodedMsg, err := mb.createOutbound(tv.msg, tv.gzipCompress, tv.bypassThrottling)
+			encodedMsg, err := mb.createOutbound(tv.msg, tv.compressionType, tv.bypassThrottling)
 			require.NoError(err)
 
 			require.Equal(tv.bypassThrottling, encodedMsg.BypassThrottling())
 			require.Equal(tv.op, encodedMsg.Op())
+			require.Equal(tv.compressionType, encodedMsg.CompressionType())
 
 			bytesSaved := encodedMsg.BytesSavedCompression()
 			require.Equal(tv.bytesSaved, bytesSaved > 0)
@@ -10,6 +10,7 @@ package message
 import (
 	reflect "reflect"
 
+	compression "github.com/VidarSolutions/avalanchego/utils/compression"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -78,6 +79,34 @@ func (mr *MockOutboundMessageMockRecorder) BytesSavedCompression() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BytesSavedCompression", reflect.TypeOf((*MockOutboundMessage)(nil).BytesSavedCompression))
 }
 
+// CompressionType mocks base method.
+func (m *MockOutboundMessage) CompressionType() compression.Type {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompressionType")
+	ret0, _ := ret[0].(compression.Type)
+	return ret0
+}
+
+// CompressionType indicates an expected call of CompressionType.
+func (mr *MockOutboundMessageMockRecorder) CompressionType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompressionType", reflect.TypeOf((*MockOutboundMessage)(nil).CompressionType))
+}
+
+// Message mocks base method.
+func (m *MockOutboundMessage) Message() interface{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Message")
+	ret0, _ := ret[0].(interface{})
+	return ret0
+}
+
+// Message indicates an expected call of Message.
+func (mr *MockOutboundMessageMockRecorder) Message() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Message", reflect.TypeOf((*MockOutboundMessage)(nil).Message))
+}
+
 // Op mocks base method.
 func (m *MockOutboundMessage) Op() Op {
 	m.ctrl.T.Helper()
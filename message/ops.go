@@ -296,6 +296,23 @@ func (op Op) String() string {
 	}
 }
 
+// MarshalText returns the name of [op], as returned by String.
+func (op Op) MarshalText() ([]byte, error) {
+	return []byte(op.String()), nil
+}
+
+// UnmarshalText sets [op] to the op with the name [text].
+func (op *Op) UnmarshalText(text []byte) error {
+	name := string(text)
+	for o := PingOp; o <= TimeoutOp; o++ {
+		if o.String() == name {
+			*op = o
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", errUnknownMessageType, name)
+}
+
 func Unwrap(m *p2p.Message) (interface{}, error) {
 	switch msg := m.GetMessage().(type) {
 	// Handshake:
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpText(t *testing.T) {
+	require := require.New(t)
+
+	for op := PingOp; op <= TimeoutOp; op++ {
+		text, err := op.MarshalText()
+		require.NoError(err)
+
+		var parsedOp Op
+		require.NoError(parsedOp.UnmarshalText(text))
+		require.Equal(op, parsedOp)
+	}
+
+	var op Op
+	err := op.UnmarshalText([]byte("unknown"))
+	require.ErrorIs(err, errUnknownMessageType)
+
+	// Ops can be used as JSON map keys
+	limits := map[Op]int{}
+	require.NoError(json.Unmarshal([]byte(`{"get_ancestors":1,"app_request":2}`), &limits))
+	require.Equal(map[Op]int{
+		GetAncestorsOp: 1,
+		AppRequestOp:   2,
+	}, limits)
+}
@@ -4,11 +4,14 @@
 package message
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
+	"github.com/VidarSolutions/avalanchego/utils/set"
 )
 
 var _ OutboundMsgBuilder = (*outMsgBuilder)(nil)
@@ -25,6 +28,7 @@ type OutboundMsgBuilder interface {
 		myVersionTime uint64,
 		sig []byte,
 		trackedSubnets []ids.ID,
+		supportsQUIC bool,
 	) (OutboundMessage, error)
 
 	PeerList(
@@ -164,21 +168,54 @@ type OutboundMsgBuilder interface {
 		chainID ids.ID,
 		msg []byte,
 	) (OutboundMessage, error)
+
+	// Recompress returns [msg] compressed with one of [compressionTypes], so
+	// that it can be sent to a peer that can only decompress those types. If
+	// [msg] isn't compressed, or is compressed with one of [compressionTypes],
+	// [msg] is returned. Otherwise, it's compressed with gzip if possible and
+	// sent uncompressed if not. [msg] is never decompressed, and each
+	// compression type is only compressed once per message.
+	Recompress(
+		msg OutboundMessage,
+		compressionTypes set.Set[compression.Type],
+	) (OutboundMessage, error)
 }
 
 type outMsgBuilder struct {
-	compress bool // set to "true" if compression is enabled
+	// the compression type of the messages that support compression
+	compressionType compression.Type
 
 	builder *msgBuilder
 }
 
 // Use "message.NewCreator" to import this function
 // since we do not expose "msgBuilder" yet
-func newOutboundBuilder(enableCompression bool, builder *msgBuilder) OutboundMsgBuilder {
+func newOutboundBuilder(compressionType compression.Type, builder *msgBuilder) OutboundMsgBuilder {
 	return &outMsgBuilder{
-		compress: enableCompression,
-		builder:  builder,
+		compressionType: compressionType,
+		builder:         builder,
+	}
+}
+
+func (b *outMsgBuilder) Recompress(
+	msg OutboundMessage,
+	compressionTypes set.Set[compression.Type],
+) (OutboundMessage, error) {
+	msgCompressionType := msg.CompressionType()
+	if msgCompressionType == compression.TypeNone || compressionTypes.Contains(msgCompressionType) {
+		return msg, nil
 	}
+
+	outMsg, ok := msg.(*outboundMessage)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnknownMessageType, msg)
+	}
+
+	compressionType := compression.TypeNone
+	if compressionTypes.Contains(compression.TypeGzip) {
+		compressionType = compression.TypeGzip
+	}
+	return b.builder.recompress(outMsg, compressionType)
 }
 
 func (b *outMsgBuilder) Ping() (OutboundMessage, error) {
@@ -188,7 +225,7 @@ func (b *outMsgBuilder) Ping() (OutboundMessage, error) {
 				Ping: &p2p.Ping{},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -206,7 +243,7 @@ func (b *outMsgBuilder) Pong(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -219,9 +256,14 @@ func (b *outMsgBuilder) Version(
 	myVersionTime uint64,
 	sig []byte,
 	trackedSubnets []ids.ID,
+	supportsQUIC bool,
 ) (OutboundMessage, error) {
 	subnetIDBytes := make([][]byte, len(trackedSubnets))
 	encodeIDs(trackedSubnets, subnetIDBytes)
+	supportedCompressionTypes := make([]uint32, len(compressedTypes))
+	for i, compressionType := range compressedTypes {
+		supportedCompressionTypes[i] = uint32(compressionType)
+	}
 	return b.builder.createOutbound(
 		&p2p.Message{
 			Message: &p2p.Message_Version{
@@ -234,10 +276,14 @@ func (b *outMsgBuilder) Version(
 					MyVersionTime:  myVersionTime,
 					Sig:            sig,
 					TrackedSubnets: subnetIDBytes,
+					// Inbound messages can be decompressed regardless of the
+					// compression type used for outbound messages.
+					SupportedCompressionTypes: supportedCompressionTypes,
+					SupportsQuic:              supportsQUIC,
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		true,
 	)
 }
@@ -262,7 +308,7 @@ func (b *outMsgBuilder) PeerList(peers []ips.ClaimedIPPort, bypassThrottling boo
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		bypassThrottling,
 	)
 }
@@ -276,7 +322,7 @@ func (b *outMsgBuilder) PeerListAck(peerAcks []*p2p.PeerAck) (OutboundMessage, e
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -296,7 +342,7 @@ func (b *outMsgBuilder) GetStateSummaryFrontier(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -316,7 +362,7 @@ func (b *outMsgBuilder) StateSummaryFrontier(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -338,7 +384,7 @@ func (b *outMsgBuilder) GetAcceptedStateSummary(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -360,7 +406,7 @@ func (b *outMsgBuilder) AcceptedStateSummary(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -382,7 +428,7 @@ func (b *outMsgBuilder) GetAcceptedFrontier(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -404,7 +450,7 @@ func (b *outMsgBuilder) AcceptedFrontier(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -430,7 +476,7 @@ func (b *outMsgBuilder) GetAccepted(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -452,7 +498,7 @@ func (b *outMsgBuilder) Accepted(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -476,7 +522,7 @@ func (b *outMsgBuilder) GetAncestors(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -496,7 +542,7 @@ func (b *outMsgBuilder) Ancestors(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -520,7 +566,7 @@ func (b *outMsgBuilder) Get(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -542,7 +588,7 @@ func (b *outMsgBuilder) Put(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -566,7 +612,7 @@ func (b *outMsgBuilder) PushQuery(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -590,7 +636,7 @@ func (b *outMsgBuilder) PullQuery(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -616,7 +662,7 @@ func (b *outMsgBuilder) Chits(
 				},
 			},
 		},
-		false,
+		compression.TypeNone,
 		false,
 	)
 }
@@ -638,7 +684,7 @@ func (b *outMsgBuilder) AppRequest(
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -654,7 +700,7 @@ func (b *outMsgBuilder) AppResponse(chainID ids.ID, requestID uint32, msg []byte
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -669,7 +715,7 @@ func (b *outMsgBuilder) AppGossip(chainID ids.ID, msg []byte) (OutboundMessage,
 				},
 			},
 		},
-		b.compress,
+		b.compressionType,
 		false,
 	)
 }
@@ -4,6 +4,7 @@
 package message
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
+	"github.com/VidarSolutions/avalanchego/utils/set"
 )
 
 func Test_newOutboundBuilder(t *testing.T) {
@@ -25,7 +29,7 @@ func Test_newOutboundBuilder(t *testing.T) {
 	)
 	require.NoError(err)
 
-	builder := newOutboundBuilder(true /*compress*/, mb)
+	builder := newOutboundBuilder(compression.TypeGzip, mb)
 
 	outMsg, err := builder.GetAcceptedStateSummary(
 		ids.GenerateTestID(),
@@ -37,3 +41,66 @@ func Test_newOutboundBuilder(t *testing.T) {
 
 	t.Logf("outbound message built with size %d", len(outMsg.Bytes()))
 }
+
+func TestRecompress(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	mb, err := newMsgBuilder(
+		"test",
+		prometheus.NewRegistry(),
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	builder := newOutboundBuilder(compression.TypeZstd, mb)
+
+	chainID := ids.GenerateTestID()
+	container := bytes.Repeat([]byte{1}, 1000)
+	outMsg, err := builder.Put(chainID, 1, container, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	require.Equal(compression.TypeZstd, outMsg.CompressionType())
+
+	// A peer that can decompress zstd gets the message as is.
+	recompressedMsg, err := builder.Recompress(outMsg, set.Set[compression.Type]{
+		compression.TypeGzip: {},
+		compression.TypeZstd: {},
+	})
+	require.NoError(err)
+	require.Equal(outMsg, recompressedMsg)
+
+	// A peer that can only decompress gzip gets a gzip compressed message.
+	recompressedMsg, err = builder.Recompress(outMsg, set.Set[compression.Type]{
+		compression.TypeGzip: {},
+	})
+	require.NoError(err)
+	require.Equal(compression.TypeGzip, recompressedMsg.CompressionType())
+	require.Equal(outMsg.Op(), recompressedMsg.Op())
+	require.Equal(outMsg.BypassThrottling(), recompressedMsg.BypassThrottling())
+
+	inMsg, err := mb.parseInbound(recompressedMsg.Bytes(), ids.EmptyNodeID, func() {})
+	require.NoError(err)
+	require.Equal(container, inMsg.Message().(*p2p.Put).Container)
+
+	// The message is only compressed with gzip once.
+	cachedMsg, err := builder.Recompress(outMsg, set.Set[compression.Type]{
+		compression.TypeGzip: {},
+	})
+	require.NoError(err)
+	require.Same(recompressedMsg, cachedMsg)
+
+	// A peer that can't decompress any type gets an uncompressed message.
+	recompressedMsg, err = builder.Recompress(outMsg, set.Set[compression.Type]{})
+	require.NoError(err)
+	require.Equal(compression.TypeNone, recompressedMsg.CompressionType())
+	inMsg, err = mb.parseInbound(recompressedMsg.Bytes(), ids.EmptyNodeID, func() {})
+	require.NoError(err)
+	require.Equal(container, inMsg.Message().(*p2p.Put).Container)
+
+	// Uncompressed messages are never recompressed.
+	pingMsg, err := builder.Ping()
+	require.NoError(err)
+	recompressedMsg, err = builder.Recompress(pingMsg, nil)
+	require.NoError(err)
+	require.Equal(pingMsg, recompressedMsg)
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package banlist
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/timer/mockable"
+)
+
+var (
+	nodeIDPrefix = []byte{0x00}
+	ipsPrefix    = []byte{0x01}
+
+	errInvalidIP  = errors.New("invalid IP or CIDR")
+	errUnknownKey = errors.New("unknown ban key")
+
+	_ Banlist = (*banlist)(nil)
+)
+
+// Checker reports whether peers are banned.
+type Checker interface {
+	// IsNodeBanned returns true if connections with [nodeID] aren't allowed.
+	IsNodeBanned(nodeID ids.NodeID) bool
+	// IsIPBanned returns true if connections with [ip] aren't allowed.
+	IsIPBanned(ip net.IP) bool
+}
+
+// Ban prevents connections with a node or with a range of IPs.
+type Ban struct {
+	// NodeID is the banned node, or [ids.EmptyNodeID] if IPs are banned.
+	NodeID ids.NodeID
+	// IPs is the banned range of IPs, or nil if a node is banned.
+	IPs *net.IPNet
+	// Expiry is the time the ban ends. If zero, the ban never ends.
+	Expiry time.Time
+}
+
+// Banlist tracks the banned peers. Bans are persisted so that they are
+// enforced across restarts.
+type Banlist interface {
+	Checker
+
+	// BanNode prevents connections with [nodeID] until [expiry]. If [expiry]
+	// is zero, the ban never ends. Replaces any existing ban of [nodeID].
+	BanNode(nodeID ids.NodeID, expiry time.Time) error
+	// BanIPs prevents connections with IPs in [ips] until [expiry]. If
+	// [expiry] is zero, the ban never ends. Replaces any existing ban of
+	// [ips].
+	BanIPs(ips *net.IPNet, expiry time.Time) error
+	// UnbanNode removes the ban of [nodeID], if there is one.
+	UnbanNode(nodeID ids.NodeID) error
+	// UnbanIPs removes the ban of [ips], if there is one. Bans of other ranges
+	// that contain [ips] aren't removed.
+	UnbanIPs(ips *net.IPNet) error
+	// List returns the bans that haven't expired.
+	List() []Ban
+
+	// When a node or a range of IPs is banned, this listener is called.
+	RegisterCallbackListener(CallbackListener)
+}
+
+type CallbackListener interface {
+	// OnBanned is called after [ban] is added. It is called without holding
+	// the banlist's lock, so the banlist can be queried by the listener.
+	OnBanned(ban Ban)
+}
+
+type banlist struct {
+	// Useful for faking time in tests
+	clock mockable.Clock
+
+	lock sync.RWMutex
+	db   database.Database
+	// Node ID --> Time the ban ends, or the zero time if it never ends
+	nodeIDs map[ids.NodeID]time.Time
+	// Range string --> Ban of that range
+	ips map[string]Ban
+
+	callbackListeners []CallbackListener
+}
+
+// New returns a banlist that persists bans in [db]. The bans already in [db]
+// are loaded and expired bans are removed.
+func New(db database.Database) (Banlist, error) {
+	b := &banlist{
+		db:      db,
+		nodeIDs: make(map[ids.NodeID]time.Time),
+		ips:     make(map[string]Ban),
+	}
+
+	it := db.NewIterator()
+	defer it.Release()
+
+	now := b.clock.Time()
+	var expired [][]byte
+	for it.Next() {
+		key := it.Key()
+		ban, err := parseBan(key, it.Value())
+		if err != nil {
+			return nil, err
+		}
+		if isExpired(ban.Expiry, now) {
+			expired = append(expired, key)
+			continue
+		}
+		b.add(ban)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range expired {
+		if err := db.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func (b *banlist) IsNodeBanned(nodeID ids.NodeID) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	expiry, ok := b.nodeIDs[nodeID]
+	return ok && !isExpired(expiry, b.clock.Time())
+}
+
+func (b *banlist) IsIPBanned(ip net.IP) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	now := b.clock.Time()
+	for _, ban := range b.ips {
+		if ban.IPs.Contains(ip) && !isExpired(ban.Expiry, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *banlist) BanNode(nodeID ids.NodeID, expiry time.Time) error {
+	return b.ban(nodeIDKey(nodeID), Ban{
+		NodeID: nodeID,
+		Expiry: expiry,
+	})
+}
+
+func (b *banlist) BanIPs(ips *net.IPNet, expiry time.Time) error {
+	return b.ban(ipsKey(ips), Ban{
+		IPs:    ips,
+		Expiry: expiry,
+	})
+}
+
+func (b *banlist) UnbanNode(nodeID ids.NodeID) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err := b.db.Delete(nodeIDKey(nodeID)); err != nil {
+		return err
+	}
+	delete(b.nodeIDs, nodeID)
+	return nil
+}
+
+func (b *banlist) UnbanIPs(ips *net.IPNet) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err := b.db.Delete(ipsKey(ips)); err != nil {
+		return err
+	}
+	delete(b.ips, ips.String())
+	return nil
+}
+
+func (b *banlist) List() []Ban {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	now := b.clock.Time()
+	bans := make([]Ban, 0, len(b.nodeIDs)+len(b.ips))
+	for nodeID, expiry := range b.nodeIDs {
+		if isExpired(expiry, now) {
+			continue
+		}
+		bans = append(bans, Ban{
+			NodeID: nodeID,
+			Expiry: expiry,
+		})
+	}
+	for _, ban := range b.ips {
+		if isExpired(ban.Expiry, now) {
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	return bans
+}
+
+func (b *banlist) RegisterCallbackListener(listener CallbackListener) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.callbackListeners = append(b.callbackListeners, listener)
+}
+
+// ban persists [ban] under [key] and then notifies the listeners.
+func (b *banlist) ban(key []byte, ban Ban) error {
+	b.lock.Lock()
+	if err := database.PutTimestamp(b.db, key, ban.Expiry); err != nil {
+		b.lock.Unlock()
+		return err
+	}
+	b.add(ban)
+	listeners := b.callbackListeners
+	b.lock.Unlock()
+
+	for _, listener := range listeners {
+		listener.OnBanned(ban)
+	}
+	return nil
+}
+
+// Assumes [b.lock] is held or [b] isn't shared yet.
+func (b *banlist) add(ban Ban) {
+	if ban.IPs == nil {
+		b.nodeIDs[ban.NodeID] = ban.Expiry
+		return
+	}
+	b.ips[ban.IPs.String()] = ban
+}
+
+// ParseIPs parses an IP, such as "192.0.2.1", or a CIDR, such as
+// "192.0.2.0/24", into the range of IPs it represents.
+func ParseIPs(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, ips, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %s", errInvalidIP, s, err)
+		}
+		return ips, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("%w %q", errInvalidIP, s)
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return &net.IPNet{
+			IP:   ipv4,
+			Mask: net.CIDRMask(8*net.IPv4len, 8*net.IPv4len),
+		}, nil
+	}
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(8*net.IPv6len, 8*net.IPv6len),
+	}, nil
+}
+
+func nodeIDKey(nodeID ids.NodeID) []byte {
+	key := make([]byte, 0, len(nodeIDPrefix)+len(nodeID))
+	key = append(key, nodeIDPrefix...)
+	return append(key, nodeID[:]...)
+}
+
+func ipsKey(ips *net.IPNet) []byte {
+	ipsStr := ips.String()
+	key := make([]byte, 0, len(ipsPrefix)+len(ipsStr))
+	key = append(key, ipsPrefix...)
+	return append(key, ipsStr...)
+}
+
+// Returns the ban stored with [key] and [value].
+func parseBan(key, value []byte) (Ban, error) {
+	expiry, err := database.ParseTimestamp(value)
+	if err != nil {
+		return Ban{}, err
+	}
+
+	switch {
+	case len(key) == len(nodeIDPrefix)+len(ids.EmptyNodeID) && key[0] == nodeIDPrefix[0]:
+		nodeID, err := ids.ToNodeID(key[len(nodeIDPrefix):])
+		return Ban{
+			NodeID: nodeID,
+			Expiry: expiry,
+		}, err
+	case len(key) > len(ipsPrefix) && key[0] == ipsPrefix[0]:
+		_, ips, err := net.ParseCIDR(string(key[len(ipsPrefix):]))
+		return Ban{
+			IPs:    ips,
+			Expiry: expiry,
+		}, err
+	default:
+		return Ban{}, fmt.Errorf("%w: %x", errUnknownKey, key)
+	}
+}
+
+// Returns true if a ban that ends at [expiry] has ended by [now].
+func isExpired(expiry, now time.Time) bool {
+	return !expiry.IsZero() && !now.Before(expiry)
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package banlist
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+func TestParseIPs(t *testing.T) {
+	tests := []struct {
+		name        string
+		ips         string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "IPv4",
+			ips:      "192.0.2.1",
+			expected: "192.0.2.1/32",
+		},
+		{
+			name:     "IPv6",
+			ips:      "2001:db8::1",
+			expected: "2001:db8::1/128",
+		},
+		{
+			name:     "IPv4 CIDR",
+			ips:      "192.0.2.1/24",
+			expected: "192.0.2.0/24",
+		},
+		{
+			name:     "IPv6 CIDR",
+			ips:      "2001:db8::1/32",
+			expected: "2001:db8::/32",
+		},
+		{
+			name:        "invalid IP",
+			ips:         "192.0.2",
+			expectedErr: errInvalidIP,
+		},
+		{
+			name:        "invalid CIDR",
+			ips:         "192.0.2.1/33",
+			expectedErr: errInvalidIP,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ips, err := ParseIPs(test.ips)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expected, ips.String())
+		})
+	}
+}
+
+func TestBanlist(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bansIntf, err := New(db)
+	require.NoError(err)
+	bans := bansIntf.(*banlist)
+	// Loaded banlists use the real time to remove expired bans
+	now := time.Unix(time.Now().Unix(), 0)
+	bans.clock.Set(now)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.False(bans.IsNodeBanned(nodeID))
+	require.NoError(bans.BanNode(nodeID, time.Time{}))
+	require.True(bans.IsNodeBanned(nodeID))
+	require.False(bans.IsNodeBanned(ids.GenerateTestNodeID()))
+
+	ips, err := ParseIPs("192.0.2.0/24")
+	require.NoError(err)
+	require.False(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+	require.NoError(bans.BanIPs(ips, now.Add(time.Hour)))
+	require.True(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+	require.True(bans.IsIPBanned(net.ParseIP("192.0.2.255")))
+	require.False(bans.IsIPBanned(net.IPv4(192, 0, 3, 1)))
+
+	require.ElementsMatch([]Ban{
+		{
+			NodeID: nodeID,
+		},
+		{
+			IPs:    ips,
+			Expiry: now.Add(time.Hour),
+		},
+	}, bans.List())
+
+	// Bans are loaded from the database
+	bansIntf, err = New(db)
+	require.NoError(err)
+	require.True(bansIntf.IsNodeBanned(nodeID))
+	require.True(bansIntf.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+	require.Len(bansIntf.List(), 2)
+
+	// Bans end at their expiry
+	bans.clock.Set(now.Add(time.Hour))
+	require.True(bans.IsNodeBanned(nodeID))
+	require.False(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+	require.Equal([]Ban{{NodeID: nodeID}}, bans.List())
+
+	require.NoError(bans.UnbanNode(nodeID))
+	require.False(bans.IsNodeBanned(nodeID))
+	require.Empty(bans.List())
+
+	// Unbanned nodes aren't loaded from the database
+	bansIntf, err = New(db)
+	require.NoError(err)
+	require.False(bansIntf.IsNodeBanned(nodeID))
+}
+
+func TestBanlistUnbanIPs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bans, err := New(db)
+	require.NoError(err)
+
+	ips, err := ParseIPs("192.0.2.0/24")
+	require.NoError(err)
+	ip, err := ParseIPs("192.0.2.1")
+	require.NoError(err)
+	require.NoError(bans.BanIPs(ips, time.Time{}))
+	require.NoError(bans.BanIPs(ip, time.Time{}))
+
+	// Unbanning an IP doesn't unban the ranges that contain it
+	require.NoError(bans.UnbanIPs(ip))
+	require.True(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+
+	require.NoError(bans.UnbanIPs(ips))
+	require.False(bans.IsIPBanned(net.IPv4(192, 0, 2, 1)))
+
+	bans, err = New(db)
+	require.NoError(err)
+	require.Empty(bans.List())
+}
+
+func TestBanlistRemovesExpiredBans(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	bans, err := New(db)
+	require.NoError(err)
+
+	// The ban expired before the banlist is loaded again
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(bans.BanNode(nodeID, time.Unix(1, 0)))
+
+	bans, err = New(db)
+	require.NoError(err)
+	require.False(bans.IsNodeBanned(nodeID))
+	require.Empty(bans.List())
+
+	it := db.NewIterator()
+	defer it.Release()
+	require.False(it.Next())
+}
+
+type testListener struct {
+	bans    Checker
+	banned  []Ban
+	checked []bool
+}
+
+func (l *testListener) OnBanned(ban Ban) {
+	l.banned = append(l.banned, ban)
+	l.checked = append(l.checked, l.bans.IsNodeBanned(ban.NodeID))
+}
+
+func TestBanlistCallbackListener(t *testing.T) {
+	require := require.New(t)
+
+	bans, err := New(memdb.New())
+	require.NoError(err)
+
+	listener := &testListener{bans: bans}
+	bans.RegisterCallbackListener(listener)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(bans.BanNode(nodeID, time.Time{}))
+
+	ips, err := ParseIPs("192.0.2.0/24")
+	require.NoError(err)
+	require.NoError(bans.BanIPs(ips, time.Time{}))
+
+	// Unbans aren't reported
+	require.NoError(bans.UnbanNode(nodeID))
+
+	require.Equal(
+		[]Ban{
+			{NodeID: nodeID},
+			{IPs: ips},
+		},
+		listener.banned,
+	)
+	// The listener is able to query the banlist when it is notified
+	require.Equal([]bool{true, false}, listener.checked)
+}
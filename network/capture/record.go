@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+const (
+	Inbound Direction = iota
+	Outbound
+)
+
+const (
+	nodeIDLen = len(ids.NodeID{})
+
+	// headerLen is the length of a record, excluding the bytes of its
+	// message: direction + time + nodeID + op + message length
+	headerLen = wrappers.ByteLen + wrappers.LongLen + nodeIDLen + wrappers.ByteLen + wrappers.IntLen
+)
+
+var errInvalidRecord = errors.New("invalid record")
+
+// Direction is whether a message was received or sent
+type Direction byte
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is a message that was sent to, or received from, a peer
+type Record struct {
+	Direction Direction
+	// Time the message was sent or received
+	Time time.Time
+	// NodeID of the peer the message was sent to or received from
+	NodeID ids.NodeID
+	Op     message.Op
+	// Bytes of the message as they were sent over the wire. The bytes may be
+	// compressed.
+	Bytes []byte
+}
+
+func (r *Record) marshal() []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, headerLen+len(r.Bytes)),
+	}
+	p.PackByte(byte(r.Direction))
+	p.PackLong(uint64(r.Time.UnixNano()))
+	p.PackFixedBytes(r.NodeID[:])
+	p.PackByte(byte(r.Op))
+	p.PackBytes(r.Bytes)
+	return p.Bytes
+}
+
+// Reader reads the records of a capture file
+type Reader struct {
+	reader io.Reader
+	header [headerLen]byte
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		reader: r,
+	}
+}
+
+// Read returns the next record. Returns io.EOF if there are no more records.
+// Returns io.ErrUnexpectedEOF if the last record was only partially written.
+func (r *Reader) Read() (*Record, error) {
+	if _, err := io.ReadFull(r.reader, r.header[:]); err != nil {
+		return nil, err
+	}
+
+	p := wrappers.Packer{Bytes: r.header[:]}
+	record := &Record{
+		Direction: Direction(p.UnpackByte()),
+		Time:      time.Unix(0, int64(p.UnpackLong())),
+	}
+	copy(record.NodeID[:], p.UnpackFixedBytes(nodeIDLen))
+	record.Op = message.Op(p.UnpackByte())
+	msgLen := p.UnpackInt()
+	if msgLen > constants.DefaultMaxMessageSize {
+		return nil, fmt.Errorf("%w: message length %d exceeds maximum %d", errInvalidRecord, msgLen, constants.DefaultMaxMessageSize)
+	}
+
+	record.Bytes = make([]byte, msgLen)
+	if _, err := io.ReadFull(r.reader, record.Bytes); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return record, nil
+}
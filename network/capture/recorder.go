@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/utils/timer/mockable"
+)
+
+var (
+	errCaptureInProgress = errors.New("a capture is already in progress")
+	errNoCapture         = errors.New("no capture is in progress")
+	errInvalidMaxSize    = errors.New("max file size must be positive")
+
+	_ Recorder = (*recorder)(nil)
+)
+
+// Config describes which messages are recorded and how large the capture
+// files may grow
+type Config struct {
+	// ChainIDs whose messages are recorded. If empty, the messages of every
+	// chain, and the messages that aren't sent to a chain, are recorded.
+	ChainIDs set.Set[ids.ID]
+	// NodeIDs whose messages are recorded. If empty, the messages of every
+	// peer are recorded.
+	NodeIDs set.Set[ids.NodeID]
+	// MaxFileSize is the size, in megabytes, that the capture file is rotated
+	// at
+	MaxFileSize int
+	// MaxFiles is the number of rotated capture files to keep. If 0, every
+	// rotated file is kept.
+	MaxFiles int
+}
+
+// Recorder writes the messages sent to and received from peers to a capture
+// file, while a capture is in progress
+type Recorder interface {
+	// Start recording the messages that match [config]. Returns the path of
+	// the capture file. Rotated capture files are written next to it, with
+	// the time they were rotated added to their names.
+	Start(config Config) (string, error)
+	// Stop the capture in progress
+	Stop() error
+
+	// Inbound records [msg], which was parsed from [msgBytes], if a capture
+	// is in progress and [msg] matches its config
+	Inbound(msg message.InboundMessage, msgBytes []byte)
+	// Outbound records [msg], which was sent to [nodeID], if a capture is in
+	// progress and [msg] matches its config
+	Outbound(nodeID ids.NodeID, msg message.OutboundMessage)
+}
+
+type recorder struct {
+	log logging.Logger
+	// Directory the capture files are written to
+	dir   string
+	clock mockable.Clock
+
+	lock   sync.RWMutex
+	config Config
+	// nil if no capture is in progress
+	writer *lumberjack.Logger
+}
+
+// NewRecorder returns a Recorder that writes capture files to [dir]
+func NewRecorder(log logging.Logger, dir string) Recorder {
+	return &recorder{
+		log: log,
+		dir: dir,
+	}
+}
+
+func (r *recorder) Start(config Config) (string, error) {
+	if config.MaxFileSize <= 0 {
+		return "", errInvalidMaxSize
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.writer != nil {
+		return "", errCaptureInProgress
+	}
+
+	path := filepath.Join(r.dir, fmt.Sprintf("capture-%d.bin", r.clock.Unix()))
+	r.config = config
+	r.writer = &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    config.MaxFileSize, // megabytes
+		MaxBackups: config.MaxFiles,    // files
+	}
+	r.log.Info("started capturing messages",
+		zap.String("path", path),
+		zap.Int("numChains", config.ChainIDs.Len()),
+		zap.Int("numNodes", config.NodeIDs.Len()),
+	)
+	return path, nil
+}
+
+func (r *recorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.writer == nil {
+		return errNoCapture
+	}
+
+	err := r.writer.Close()
+	r.writer = nil
+	r.log.Info("stopped capturing messages")
+	return err
+}
+
+func (r *recorder) Inbound(msg message.InboundMessage, msgBytes []byte) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	nodeID := msg.NodeID()
+	if r.writer == nil || !r.recordsNode(nodeID) || !r.recordsChainOf(msg.Message()) {
+		return
+	}
+
+	r.write(&Record{
+		Direction: Inbound,
+		Time:      r.clock.Time(),
+		NodeID:    nodeID,
+		Op:        msg.Op(),
+		Bytes:     msgBytes,
+	})
+}
+
+func (r *recorder) Outbound(nodeID ids.NodeID, msg message.OutboundMessage) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	// The chain of [msg] is read from the message it was built from, so
+	// [msg] is never parsed.
+	if r.writer == nil || !r.recordsNode(nodeID) || !r.recordsChainOf(msg.Message()) {
+		return
+	}
+
+	r.write(&Record{
+		Direction: Outbound,
+		Time:      r.clock.Time(),
+		NodeID:    nodeID,
+		Op:        msg.Op(),
+		Bytes:     msg.Bytes(),
+	})
+}
+
+// recordsNode returns true if the messages of [nodeID] are recorded.
+//
+// Assumes [r.lock] is held.
+func (r *recorder) recordsNode(nodeID ids.NodeID) bool {
+	return r.config.NodeIDs.Len() == 0 || r.config.NodeIDs.Contains(nodeID)
+}
+
+// recordsChainOf returns true if [msg] was sent to a recorded chain.
+//
+// Assumes [r.lock] is held.
+func (r *recorder) recordsChainOf(msg any) bool {
+	if r.config.ChainIDs.Len() == 0 {
+		return true
+	}
+	chainID, err := message.GetChainID(msg)
+	return err == nil && r.config.ChainIDs.Contains(chainID)
+}
+
+// write appends [record] to the capture file. Each record is written with a
+// single write, so rotated files never contain partial records.
+//
+// Assumes [r.lock] is held and a capture is in progress.
+func (r *recorder) write(record *Record) {
+	if _, err := r.writer.Write(record.marshal()); err != nil {
+		r.log.Warn("failed to write captured message",
+			zap.Stringer("nodeID", record.NodeID),
+			zap.Stringer("messageOp", record.Op),
+			zap.Error(err),
+		)
+	}
+}
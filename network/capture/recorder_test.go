@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+)
+
+func newMessageCreator(t *testing.T) message.Creator {
+	mc, err := message.NewCreator(
+		prometheus.NewRegistry(),
+		"",
+		compression.TypeGzip,
+		10*time.Second,
+	)
+	require.NoError(t, err)
+	return mc
+}
+
+func readAll(t *testing.T, path string) []*Record {
+	require := require.New(t)
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+
+	reader := NewReader(f)
+	var records []*Record
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(err)
+		records = append(records, record)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	require := require.New(t)
+
+	mc := newMessageCreator(t)
+	r := NewRecorder(logging.NoLog{}, t.TempDir())
+
+	chainID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	query, err := mc.PullQuery(chainID, 1, time.Second, ids.GenerateTestID(), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	otherQuery, err := mc.PullQuery(ids.GenerateTestID(), 1, time.Second, ids.GenerateTestID(), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	ping, err := mc.Ping()
+	require.NoError(err)
+	inboundQuery, err := mc.Parse(query.Bytes(), nodeID, nil)
+	require.NoError(err)
+
+	// Nothing is recorded before a capture is started
+	r.Outbound(nodeID, query)
+
+	_, err = r.Start(Config{})
+	require.ErrorIs(err, errInvalidMaxSize)
+
+	config := Config{
+		ChainIDs:    set.Set[ids.ID]{},
+		NodeIDs:     set.Set[ids.NodeID]{},
+		MaxFileSize: 1,
+	}
+	config.ChainIDs.Add(chainID)
+	config.NodeIDs.Add(nodeID)
+	path, err := r.Start(config)
+	require.NoError(err)
+
+	_, err = r.Start(config)
+	require.ErrorIs(err, errCaptureInProgress)
+
+	r.Outbound(nodeID, query)
+	r.Outbound(nodeID, otherQuery)
+	r.Outbound(ids.GenerateTestNodeID(), query)
+	r.Outbound(nodeID, ping)
+	r.Inbound(inboundQuery, query.Bytes())
+
+	require.NoError(r.Stop())
+	require.ErrorIs(r.Stop(), errNoCapture)
+
+	// Nothing is recorded after the capture is stopped
+	r.Outbound(nodeID, query)
+
+	records := readAll(t, path)
+	require.Len(records, 2)
+	for i, direction := range []Direction{Outbound, Inbound} {
+		record := records[i]
+		require.Equal(direction, record.Direction)
+		require.Equal(nodeID, record.NodeID)
+		require.Equal(message.PullQueryOp, record.Op)
+		require.Equal(query.Bytes(), record.Bytes)
+		require.False(record.Time.IsZero())
+	}
+}
+
+func TestRecorderRecordsEveryMessageByDefault(t *testing.T) {
+	require := require.New(t)
+
+	mc := newMessageCreator(t)
+	r := NewRecorder(logging.NoLog{}, t.TempDir())
+
+	ping, err := mc.Ping()
+	require.NoError(err)
+	query, err := mc.PullQuery(ids.GenerateTestID(), 1, time.Second, ids.GenerateTestID(), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+
+	path, err := r.Start(Config{
+		MaxFileSize: 1,
+	})
+	require.NoError(err)
+	r.Outbound(ids.GenerateTestNodeID(), ping)
+	r.Outbound(ids.GenerateTestNodeID(), query)
+	require.NoError(r.Stop())
+
+	records := readAll(t, path)
+	require.Len(records, 2)
+	require.Equal(message.PingOp, records[0].Op)
+	require.Equal(message.PullQueryOp, records[1].Op)
+}
+
+func TestReaderRejectsInvalidRecords(t *testing.T) {
+	require := require.New(t)
+
+	record := &Record{
+		Direction: Inbound,
+		Time:      time.Unix(1, 2),
+		NodeID:    ids.GenerateTestNodeID(),
+		Op:        message.PingOp,
+		Bytes:     []byte{1, 2, 3},
+	}
+	recordBytes := record.marshal()
+
+	parsedRecord, err := NewReader(bytes.NewReader(recordBytes)).Read()
+	require.NoError(err)
+	require.Equal(record, parsedRecord)
+
+	// The last record may only be partially written
+	_, err = NewReader(bytes.NewReader(recordBytes[:len(recordBytes)-1])).Read()
+	require.ErrorIs(err, io.ErrUnexpectedEOF)
+	_, err = NewReader(bytes.NewReader(recordBytes[:headerLen])).Read()
+	require.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	// Messages larger than the maximum message size are rejected
+	record.Bytes = make([]byte, 0)
+	recordBytes = record.marshal()
+	recordBytes[headerLen-1] = 0xff
+	recordBytes[headerLen-2] = 0xff
+	recordBytes[headerLen-3] = 0xff
+	_, err = NewReader(bytes.NewReader(recordBytes)).Read()
+	require.ErrorIs(err, errInvalidRecord)
+}
+
+func TestRecorderDoesNotParseOutboundMessages(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	r := NewRecorder(logging.NoLog{}, t.TempDir())
+	config := Config{
+		ChainIDs:    set.Set[ids.ID]{},
+		MaxFileSize: 1,
+	}
+	config.ChainIDs.Add(ids.GenerateTestID())
+	_, err := r.Start(config)
+	require.NoError(err)
+
+	// The chain of the message is read from the message it was built from.
+	// Because the message isn't recorded, its bytes are never read.
+	otherChainID := ids.GenerateTestID()
+	msg := message.NewMockOutboundMessage(ctrl)
+	msg.EXPECT().Message().Return(&p2p.PullQuery{
+		ChainId: otherChainID[:],
+	})
+	r.Outbound(ids.GenerateTestNodeID(), msg)
+
+	require.NoError(r.Stop())
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/snow/networking/handler"
+)
+
+var errHandlerStopped = errors.New("handler stopped")
+
+// Replay pushes the inbound messages read from [reader] that were sent to the
+// chain of [h] into [h], in the order they were received. Returns the number
+// of messages that were pushed.
+//
+// Each message is handled before the next one is pushed, so a capture is
+// replayed deterministically as long as the engine of [h] is deterministic.
+// Outbound messages, messages sent to other chains, and messages from nodes
+// that [h] doesn't handle messages from are skipped. Unlike the chain router,
+// responses are pushed regardless of whether a matching request is
+// outstanding.
+//
+// [h] must have been started. Replaying a capture doesn't send any messages
+// to peers, but the engine of [h] may call its sender, which should be mocked.
+func Replay(
+	ctx context.Context,
+	reader *Reader,
+	parser message.InboundMsgBuilder,
+	h handler.Handler,
+) (int, error) {
+	chainID := h.Context().ChainID
+	numPushed := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return numPushed, nil
+		}
+		if err != nil {
+			return numPushed, err
+		}
+		if record.Direction != Inbound || !h.ShouldHandle(record.NodeID) {
+			continue
+		}
+
+		handled := make(chan struct{})
+		msg, err := parser.Parse(record.Bytes, record.NodeID, func() {
+			close(handled)
+		})
+		if err != nil {
+			return numPushed, fmt.Errorf("failed to parse %s message from %s: %w", record.Op, record.NodeID, err)
+		}
+		if msgChainID, err := message.GetChainID(msg.Message()); err != nil || msgChainID != chainID {
+			continue
+		}
+
+		engineType, _ := message.GetEngineType(msg.Message())
+		h.Push(ctx, handler.Message{
+			InboundMessage: msg,
+			EngineType:     engineType,
+		})
+		numPushed++
+
+		select {
+		case <-handled:
+		case <-h.Stopped():
+			return numPushed, errHandlerStopped
+		case <-ctx.Done():
+			return numPushed, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
+	"github.com/VidarSolutions/avalanchego/snow"
+	"github.com/VidarSolutions/avalanchego/snow/engine/common"
+	"github.com/VidarSolutions/avalanchego/snow/networking/handler"
+	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
+	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/subnets"
+	"github.com/VidarSolutions/avalanchego/utils/math/meter"
+	"github.com/VidarSolutions/avalanchego/utils/resource"
+)
+
+func TestReplay(t *testing.T) {
+	require := require.New(t)
+
+	ctx := snow.DefaultConsensusContextTest()
+	resourceTracker, err := tracker.NewResourceTracker(
+		prometheus.NewRegistry(),
+		resource.NoUsage,
+		meter.ContinuousFactory{},
+		time.Second,
+	)
+	require.NoError(err)
+	h, err := handler.New(
+		ctx,
+		validators.NewSet(),
+		nil,
+		time.Second,
+		resourceTracker,
+		validators.UnhandledSubnetConnector,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+	)
+	require.NoError(err)
+
+	bootstrapper := &common.BootstrapperTest{
+		BootstrapableTest: common.BootstrapableTest{
+			T: t,
+		},
+		EngineTest: common.EngineTest{
+			T: t,
+		},
+	}
+	bootstrapper.Default(false)
+
+	type query struct {
+		nodeID      ids.NodeID
+		requestID   uint32
+		containerID ids.ID
+	}
+	var queries []query
+	engine := &common.EngineTest{T: t}
+	engine.Default(false)
+	engine.ContextF = func() *snow.ConsensusContext {
+		return ctx
+	}
+	engine.PullQueryF = func(_ context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID) error {
+		queries = append(queries, query{
+			nodeID:      nodeID,
+			requestID:   requestID,
+			containerID: containerID,
+		})
+		return nil
+	}
+	h.SetEngineManager(&handler.EngineManager{
+		Snowman: &handler.Engine{
+			Bootstrapper: bootstrapper,
+			Consensus:    engine,
+		},
+	})
+	ctx.State.Set(snow.EngineState{
+		Type:  p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+		State: snow.NormalOp,
+	})
+	h.Start(context.Background(), false)
+	defer h.Stop(context.Background())
+
+	mc := newMessageCreator(t)
+	var (
+		capture bytes.Buffer
+		want    []query
+	)
+	for i := 0; i < 10; i++ {
+		q := query{
+			nodeID:      ids.GenerateTestNodeID(),
+			requestID:   uint32(i),
+			containerID: ids.GenerateTestID(),
+		}
+		msg, err := mc.PullQuery(ctx.ChainID, q.requestID, time.Second, q.containerID, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+		require.NoError(err)
+		otherMsg, err := mc.PullQuery(ids.GenerateTestID(), q.requestID, time.Second, q.containerID, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+		require.NoError(err)
+
+		records := []*Record{
+			// Replayed
+			{
+				Direction: Inbound,
+				NodeID:    q.nodeID,
+				Op:        message.PullQueryOp,
+				Bytes:     msg.Bytes(),
+			},
+			// Sent to another chain
+			{
+				Direction: Inbound,
+				NodeID:    q.nodeID,
+				Op:        message.PullQueryOp,
+				Bytes:     otherMsg.Bytes(),
+			},
+			// Sent by this node
+			{
+				Direction: Outbound,
+				NodeID:    q.nodeID,
+				Op:        message.PullQueryOp,
+				Bytes:     msg.Bytes(),
+			},
+		}
+		for _, record := range records {
+			_, err := capture.Write(record.marshal())
+			require.NoError(err)
+		}
+		want = append(want, q)
+	}
+
+	numPushed, err := Replay(context.Background(), NewReader(&capture), mc, h)
+	require.NoError(err)
+	require.Equal(len(want), numPushed)
+	// Every message was handled, in order, before Replay returned
+	require.Equal(want, queries)
+}
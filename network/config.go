@@ -9,12 +9,16 @@ import (
 	"time"
 
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/capture"
 	"github.com/VidarSolutions/avalanchego/network/dialer"
 	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/network/peerlist"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
 	"github.com/VidarSolutions/avalanchego/snow/uptime"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/set"
 )
@@ -98,6 +102,7 @@ type DelayConfig struct {
 type ThrottlerConfig struct {
 	InboundConnUpgradeThrottlerConfig throttling.InboundConnUpgradeThrottlerConfig `json:"inboundConnUpgradeThrottlerConfig"`
 	InboundMsgThrottlerConfig         throttling.InboundMsgThrottlerConfig         `json:"inboundMsgThrottlerConfig"`
+	InboundOpThrottlerConfig          throttling.InboundOpThrottlerConfig          `json:"inboundOpThrottlerConfig"`
 	OutboundMsgThrottlerConfig        throttling.MsgByteThrottlerConfig            `json:"outboundMsgThrottlerConfig"`
 	MaxInboundConnsPerSec             float64                                      `json:"maxInboundConnsPerSec"`
 }
@@ -115,6 +120,14 @@ type Config struct {
 	DialerConfig dialer.Config `json:"dialerConfig"`
 	TLSConfig    *tls.Config   `json:"-"`
 
+	// QUICEnabled specifies whether QUIC connections are accepted, in addition
+	// to TCP connections, and used to connect to peers that accept them. QUIC
+	// connections are accepted on the UDP port with the same number as the
+	// TCP port of the network's listener. If true, [QUICDialer] must be set.
+	QUICEnabled bool `json:"quicEnabled"`
+	// Makes outbound QUIC connections
+	QUICDialer dialer.Dialer `json:"-"`
+
 	TLSKeyLogFile string `json:"tlsKeyLogFile"`
 
 	Namespace          string            `json:"namespace"`
@@ -125,9 +138,10 @@ type Config struct {
 	PingFrequency      time.Duration     `json:"pingFrequency"`
 	AllowPrivateIPs    bool              `json:"allowPrivateIPs"`
 
-	// CompressionEnabled will compress available outbound messages when set to
-	// true.
-	CompressionEnabled bool `json:"compressionEnabled"`
+	// CompressionType is the compression used for available outbound
+	// messages. Peers that can't decompress this type are sent gzip compressed
+	// messages instead.
+	CompressionType compression.Type `json:"compressionType"`
 
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
@@ -181,4 +195,16 @@ type Config struct {
 
 	// Tracks which validators have been sent to which peers
 	GossipTracker peer.GossipTracker `json:"-"`
+
+	// Banned nodes and IPs that connections aren't allowed with. Connections
+	// with peers are closed when they are banned.
+	Banlist banlist.Banlist `json:"-"`
+
+	// Persists the signed IPs of peers so that they can be dialed right away
+	// after a restart
+	PeerList peerlist.Store `json:"-"`
+
+	// Records the messages sent to and received from peers while a capture
+	// is in progress. If nil, messages aren't recorded.
+	Recorder capture.Recorder `json:"-"`
 }
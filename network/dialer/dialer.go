@@ -5,18 +5,24 @@ package dialer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 )
 
-var _ Dialer = (*dialer)(nil)
+var (
+	errBannedIP = errors.New("IP is banned")
+
+	_ Dialer = (*dialer)(nil)
+)
 
 // Dialer attempts to create a connection with the provided IP/port pair
 type Dialer interface {
@@ -30,6 +36,7 @@ type dialer struct {
 	log       logging.Logger
 	network   string
 	throttler throttling.DialThrottler
+	bans      banlist.Checker
 }
 
 type Config struct {
@@ -42,7 +49,8 @@ type Config struct {
 // [dialerConfig.connectionTimeout] gives the timeout when dialing an IP.
 // [dialerConfig.throttleRps] gives the max number of outgoing connection attempts/second.
 // If [dialerConfig.throttleRps] == 0, outgoing connections aren't rate-limited.
-func NewDialer(network string, dialerConfig Config, log logging.Logger) Dialer {
+// IPs that [bans] reports as banned aren't dialed.
+func NewDialer(network string, dialerConfig Config, log logging.Logger, bans banlist.Checker) Dialer {
 	var throttler throttling.DialThrottler
 	if dialerConfig.ThrottleRps <= 0 {
 		throttler = throttling.NewNoDialThrottler()
@@ -59,10 +67,14 @@ func NewDialer(network string, dialerConfig Config, log logging.Logger) Dialer {
 		log:       log,
 		network:   network,
 		throttler: throttler,
+		bans:      bans,
 	}
 }
 
 func (d *dialer) Dial(ctx context.Context, ip ips.IPPort) (net.Conn, error) {
+	if d.bans.IsIPBanned(ip.IP) {
+		return nil, fmt.Errorf("%w: %s", errBannedIP, ip)
+	}
 	if err := d.throttler.Acquire(ctx); err != nil {
 		return nil, err
 	}
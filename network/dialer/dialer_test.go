@@ -13,6 +13,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 )
@@ -50,6 +52,9 @@ func TestDialerCancelDial(t *testing.T) {
 		Port: uint16(port),
 	}
 
+	bans, err := banlist.New(memdb.New())
+	require.NoError(err)
+
 	// Create a dialer
 	dialer := NewDialer(
 		"tcp",
@@ -58,6 +63,7 @@ func TestDialerCancelDial(t *testing.T) {
 			ConnectionTimeout: 30 * time.Second,
 		},
 		logging.NoLog{},
+		bans,
 	)
 
 	// Make an outgoing connection with a cancelled context
@@ -71,6 +77,13 @@ func TestDialerCancelDial(t *testing.T) {
 	require.NoError(err)
 	_ = conn.Close()
 
+	// Banned IPs aren't dialed
+	bannedIPs, err := banlist.ParseIPs(myIP.IP.String())
+	require.NoError(err)
+	require.NoError(bans.BanIPs(bannedIPs, time.Time{}))
+	_, err = dialer.Dial(context.Background(), myIP)
+	require.ErrorIs(err, errBannedIP)
+
 	close(done) // stop listener goroutine
 	_ = l.Close()
 }
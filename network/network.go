@@ -26,8 +26,10 @@ import (
 	"github.com/VidarSolutions/avalanchego/api/health"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/network/dialer"
 	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/network/quic"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
 	"github.com/VidarSolutions/avalanchego/snow/networking/router"
@@ -55,12 +57,16 @@ var (
 	_ sender.ExternalSender = (*network)(nil)
 	_ Network               = (*network)(nil)
 
+	_ banlist.CallbackListener = (*network)(nil)
+
 	errMissingPrimaryValidators = errors.New("missing primary validator set")
 	errNotValidator             = errors.New("node is not a validator")
 	errNotTracked               = errors.New("subnet is not tracked")
 	errSubnetNotExist           = errors.New("subnet does not exist")
 	errExpectedProxy            = errors.New("expected proxy")
 	errExpectedTCPProtocol      = errors.New("expected TCP protocol")
+	errNodeIDMismatch           = errors.New("node ID doesn't match certificate")
+	errTimestampTooFarInFuture  = errors.New("timestamp too far in the future")
 )
 
 // Network defines the functionality of the networking library.
@@ -97,6 +103,12 @@ type Network interface {
 	// info about the peers in [nodeIDs] that have finished the handshake.
 	PeerInfo(nodeIDs []ids.NodeID) []peer.Info
 
+	// PeerStats returns the traffic exchanged with peers. If [nodeIDs] is
+	// empty, returns the stats of all peers that have finished the handshake.
+	// Otherwise, returns the stats of the peers in [nodeIDs] that have
+	// finished the handshake.
+	PeerStats(nodeIDs []ids.NodeID) []peer.Stats
+
 	// NodeUptime returns given node's [subnetID] UptimeResults in the view of
 	// this node's peer validators.
 	NodeUptime(subnetID ids.ID) (UptimeResult, error)
@@ -135,6 +147,11 @@ type network struct {
 	serverUpgrader peer.Upgrader
 	// Does TLS handshakes for outbound connections
 	clientUpgrader peer.Upgrader
+	// Listens for inbound QUIC connections if QUIC is enabled. Created by
+	// [Dispatch] and guarded by [peersLock].
+	quicListener net.Listener
+	// Authenticates inbound and outbound QUIC connections
+	quicUpgrader peer.Upgrader
 
 	// ensures the close of the network only happens once.
 	closeOnce sync.Once
@@ -162,6 +179,20 @@ type network struct {
 	connectingPeers    peer.Set
 	connectedPeers     peer.Set
 	closing            bool
+	// quicPeers contains the nodes that claimed to accept QUIC connections
+	// during their last handshake.
+	quicPeers set.Set[ids.NodeID]
+
+	// Changes to the persisted peer IPs are queued, so that the database isn't
+	// written to while [peersLock] is held, and written in the background.
+	pendingPeerIPsLock sync.Mutex
+	// Node ID --> IP to persist, or nil if the persisted IP should be deleted
+	pendingPeerIPs map[ids.NodeID]*ips.ClaimedIPPort
+	// Signalled when changes are queued in [pendingPeerIPs]
+	pendingPeerIPsSignal chan struct{}
+	// Held while the queued changes are written, so that concurrent writes
+	// can't be applied out of order
+	writePeerIPsLock sync.Mutex
 
 	// router is notified about all peer [Connected] and [Disconnected] events
 	// as well as all non-handshake peer messages.
@@ -230,6 +261,17 @@ func NewNetwork(
 		return nil, fmt.Errorf("initializing inbound message throttler failed with: %w", err)
 	}
 
+	inboundOpThrottler, err := throttling.NewInboundOpThrottler(
+		log,
+		config.Namespace,
+		metricsRegisterer,
+		primaryNetworkValidators,
+		config.ThrottlerConfig.InboundOpThrottlerConfig,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("initializing inbound op throttler failed with: %w", err)
+	}
+
 	outboundMsgThrottler, err := throttling.NewSybilOutboundMsgThrottler(
 		log,
 		config.Namespace,
@@ -259,6 +301,7 @@ func NewNetwork(
 
 		Log:                  log,
 		InboundMsgThrottler:  inboundMsgThrottler,
+		InboundOpThrottler:   inboundOpThrottler,
 		Network:              nil, // This is set below.
 		Router:               router,
 		VersionCompatibility: version.GetCompatibility(config.NetworkID),
@@ -271,6 +314,8 @@ func NewNetwork(
 		ResourceTracker:      config.ResourceTracker,
 		UptimeCalculator:     config.UptimeCalculator,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey),
+		QUICEnabled:          config.QUICEnabled,
+		Recorder:             config.Recorder,
 	}
 
 	onCloseCtx, cancel := context.WithCancel(context.Background())
@@ -280,11 +325,12 @@ func NewNetwork(
 		metrics:              metrics,
 		outboundMsgThrottler: outboundMsgThrottler,
 
-		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
+		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig, config.Banlist),
 		listener:                    listener,
 		dialer:                      dialer,
 		serverUpgrader:              peer.NewTLSServerUpgrader(config.TLSConfig),
 		clientUpgrader:              peer.NewTLSClientUpgrader(config.TLSConfig),
+		quicUpgrader:                quic.NewUpgrader(),
 
 		onCloseCtx:       onCloseCtx,
 		onCloseCtxCancel: cancel,
@@ -301,8 +347,12 @@ func NewNetwork(
 		connectingPeers: peer.NewSet(),
 		connectedPeers:  peer.NewSet(),
 		router:          router,
+
+		pendingPeerIPs:       make(map[ids.NodeID]*ips.ClaimedIPPort),
+		pendingPeerIPsSignal: make(chan struct{}, 1),
 	}
 	n.peerConfig.Network = n
+	config.Banlist.RegisterCallbackListener(n)
 	return n, nil
 }
 
@@ -419,9 +469,11 @@ func (n *network) Connected(nodeID ids.NodeID) {
 		// gossiped it. This means we don't need to reset the validator's
 		// tracked set.
 		n.peerIPs[nodeID] = newIP
+		n.persistIP(nodeID, newIP)
 	} else if prevIP.Timestamp < newIP.Timestamp {
 		// The previous IP was stale, so we should gossip the newer IP.
 		n.peerIPs[nodeID] = newIP
+		n.persistIP(nodeID, newIP)
 
 		if !prevIP.IPPort.Equal(newIP.IPPort) {
 			// This IP is actually different, so we should gossip it.
@@ -432,6 +484,12 @@ func (n *network) Connected(nodeID ids.NodeID) {
 		}
 	}
 
+	if peer.SupportsQUIC() {
+		n.quicPeers.Add(nodeID)
+	} else {
+		n.quicPeers.Remove(nodeID)
+	}
+
 	if tracked, ok := n.trackedIPs[nodeID]; ok {
 		tracked.stopTracking()
 		delete(n.trackedIPs, nodeID)
@@ -450,10 +508,13 @@ func (n *network) Connected(nodeID ids.NodeID) {
 }
 
 // AllowConnection returns true if this node should have a connection to the
-// provided nodeID. If the node is attempting to connect to the minimum number
-// of peers, then it should only connect if this node is a validator, or the
-// peer is a validator/beacon.
+// provided nodeID. Connections with banned nodes are never allowed. If the
+// node is attempting to connect to the minimum number of peers, then it should
+// only connect if this node is a validator, or the peer is a validator/beacon.
 func (n *network) AllowConnection(nodeID ids.NodeID) bool {
+	if n.config.Banlist.IsNodeBanned(nodeID) {
+		return false
+	}
 	return !n.config.RequireValidatorToConnect ||
 		validators.Contains(n.config.Validators, constants.PrimaryNetworkID, n.config.MyNodeID) ||
 		n.WantsConnection(nodeID)
@@ -526,6 +587,7 @@ func (n *network) Track(peerID ids.NodeID, claimedIPPorts []*ips.ClaimedIPPort)
 
 			// In the future, we should gossip this IP rather than the old IP.
 			n.peerIPs[nodeID] = ip
+			n.persistIP(nodeID, ip)
 
 			// If the new IP is equal to the old IP, there is no reason to
 			// refresh the references to it. This can happen when a node
@@ -559,6 +621,7 @@ func (n *network) Track(peerID ids.NodeID, claimedIPPorts []*ips.ClaimedIPPort)
 			// We don't need to reset gossip about this validator because
 			// we've never gossiped it before.
 			n.peerIPs[nodeID] = ip
+			n.persistIP(nodeID, ip)
 
 			tracked := newTrackedIP(ip.IPPort)
 			n.trackedIPs[nodeID] = tracked
@@ -714,15 +777,76 @@ func (n *network) Peers(peerID ids.NodeID) ([]ips.ClaimedIPPort, error) {
 // Dispatch starts accepting connections from other nodes attempting to connect
 // to this node.
 func (n *network) Dispatch() error {
+	n.dialPersistedIPs()
 	go n.runTimers() // Periodically perform operations
+	go n.runPeerIPWriter()
 	go n.inboundConnUpgradeThrottler.Dispatch()
+	if n.config.QUICEnabled {
+		quicListener, err := n.listenQUIC()
+		if err != nil {
+			n.inboundConnUpgradeThrottler.Stop()
+			n.StartClose()
+			return err
+		}
+		// The QUIC listener only returns connections that should be upgraded.
+		go n.acceptConns(quicListener, n.quicUpgrader, false)
+	}
+	n.acceptConns(n.listener, n.serverUpgrader, true)
+	n.inboundConnUpgradeThrottler.Stop()
+	n.StartClose()
+
+	n.peersLock.RLock()
+	connecting := n.connectingPeers.Sample(n.connectingPeers.Len(), peer.NoPrecondition)
+	connected := n.connectedPeers.Sample(n.connectedPeers.Len(), peer.NoPrecondition)
+	n.peersLock.RUnlock()
+
 	errs := wrappers.Errs{}
+	for _, peer := range append(connecting, connected...) {
+		errs.Add(peer.AwaitClosed(context.TODO()))
+	}
+
+	// Write the changes that were queued while the peers were closing.
+	n.writePeerIPs()
+	return errs.Err
+}
+
+// listenQUIC starts accepting QUIC connections on the UDP port with the same
+// number as the TCP port of [n.listener]. Connections that shouldn't be
+// upgraded are dropped before their handshake starts.
+func (n *network) listenQUIC() (net.Listener, error) {
+	quicListener, err := quic.Listen(n.listener.Addr().String(), n.config.TLSConfig, n.inboundConnUpgradeThrottler.ShouldUpgrade)
+	if err != nil {
+		return nil, fmt.Errorf("initializing QUIC listener failed with: %w", err)
+	}
+	throttledListener := throttling.NewThrottledListener(quicListener, n.config.ThrottlerConfig.MaxInboundConnsPerSec)
+
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	n.quicListener = throttledListener
+	if n.closing {
+		// The network was closed while the listener was being created, so
+		// [StartClose] couldn't close it.
+		if err := throttledListener.Close(); err != nil {
+			n.peerConfig.Log.Debug("closing the QUIC listener",
+				zap.Error(err),
+			)
+		}
+	}
+	return throttledListener, nil
+}
+
+// acceptConns accepts new connections from [listener], and upgrades them with
+// [upgrader], until the network is closed. If [throttleUpgrades] is true,
+// connections are only upgraded if the inbound connection upgrade throttler
+// allows it.
+func (n *network) acceptConns(listener net.Listener, upgrader peer.Upgrader, throttleUpgrades bool) {
 	for { // Continuously accept new connections
 		if n.onCloseCtx.Err() != nil {
-			break
+			return
 		}
 
-		conn, err := n.listener.Accept() // Returns error when n.Close() is called
+		conn, err := listener.Accept() // Returns error when n.Close() is called
 		if err != nil {
 			n.peerConfig.Log.Debug("error during server accept", zap.Error(err))
 			// Sleep for a small amount of time to try to wait for the
@@ -757,7 +881,7 @@ func (n *network) Dispatch() error {
 				return
 			}
 
-			if !n.inboundConnUpgradeThrottler.ShouldUpgrade(ip) {
+			if throttleUpgrades && !n.inboundConnUpgradeThrottler.ShouldUpgrade(ip) {
 				n.peerConfig.Log.Debug("failed to upgrade connection",
 					zap.String("reason", "rate-limiting"),
 					zap.Stringer("peerIP", ip),
@@ -773,7 +897,7 @@ func (n *network) Dispatch() error {
 				zap.Stringer("peerIP", ip),
 			)
 
-			if err := n.upgrade(conn, n.serverUpgrader); err != nil {
+			if err := n.upgrade(conn, upgrader); err != nil {
 				n.peerConfig.Log.Verbo("failed to upgrade connection",
 					zap.String("direction", "inbound"),
 					zap.Error(err),
@@ -781,18 +905,6 @@ func (n *network) Dispatch() error {
 			}
 		}()
 	}
-	n.inboundConnUpgradeThrottler.Stop()
-	n.StartClose()
-
-	n.peersLock.RLock()
-	connecting := n.connectingPeers.Sample(n.connectingPeers.Len(), peer.NoPrecondition)
-	connected := n.connectedPeers.Sample(n.connectedPeers.Len(), peer.NoPrecondition)
-	n.peersLock.RUnlock()
-
-	for _, peer := range append(connecting, connected...) {
-		errs.Add(peer.AwaitClosed(context.TODO()))
-	}
-	return errs.Err
 }
 
 func (n *network) WantsConnection(nodeID ids.NodeID) bool {
@@ -803,6 +915,9 @@ func (n *network) WantsConnection(nodeID ids.NodeID) bool {
 }
 
 func (n *network) wantsConnection(nodeID ids.NodeID) bool {
+	if n.config.Banlist.IsNodeBanned(nodeID) {
+		return false
+	}
 	return validators.Contains(n.config.Validators, constants.PrimaryNetworkID, nodeID) ||
 		n.manuallyTrackedIDs.Contains(nodeID)
 }
@@ -966,6 +1081,7 @@ func (n *network) disconnectedFromConnecting(nodeID ids.NodeID) {
 			tracked.stopTracking()
 			delete(n.peerIPs, nodeID)
 			delete(n.trackedIPs, nodeID)
+			n.deletePersistedIP(nodeID)
 		}
 	}
 
@@ -988,6 +1104,8 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 		n.dial(n.onCloseCtx, nodeID, tracked)
 	} else {
 		delete(n.peerIPs, nodeID)
+		n.quicPeers.Remove(nodeID)
+		n.deletePersistedIP(nodeID)
 	}
 
 	n.metrics.markDisconnected(peer)
@@ -1033,12 +1151,174 @@ func (n *network) authenticateIPs(ips []*ips.ClaimedIPPort) ([]*ipAuth, error) {
 	return ipAuths, nil
 }
 
+// dialPersistedIPs starts connecting to the peers whose signed IPs were
+// persisted before the last shutdown, rather than waiting to learn their IPs
+// through gossip. IPs that fail authentication, or that belong to peers we no
+// longer want to connect to, are removed.
+func (n *network) dialPersistedIPs() {
+	peerIPs, err := n.config.PeerList.GetAll()
+	if err != nil {
+		n.peerConfig.Log.Warn("failed to load persisted peer IPs",
+			zap.Error(err),
+		)
+		return
+	}
+
+	// Perform all signature verification before grabbing the peer lock.
+	maxTimestamp := n.peerConfig.Clock.Unix() + uint64(n.config.MaxClockDifference.Seconds())
+	verifiedIPs := make(map[ids.NodeID]*ips.ClaimedIPPort, len(peerIPs))
+	for nodeID, ip := range peerIPs {
+		if err := verifyPersistedIP(nodeID, ip, maxTimestamp); err != nil {
+			n.peerConfig.Log.Debug("removing invalid persisted peer IP",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+			n.deletePersistedIP(nodeID)
+			continue
+		}
+		verifiedIPs[nodeID] = ip
+	}
+
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	numDialed := 0
+	for nodeID, ip := range verifiedIPs {
+		if !n.wantsConnection(nodeID) {
+			n.deletePersistedIP(nodeID)
+			continue
+		}
+
+		// Manually tracked peers may already be dialed at their configured
+		// IP.
+		_, isTracked := n.trackedIPs[nodeID]
+		_, _, _, shouldDial := n.peerIPStatus(nodeID, ip)
+		if isTracked || !shouldDial {
+			continue
+		}
+
+		n.peerIPs[nodeID] = ip
+		tracked := newTrackedIP(ip.IPPort)
+		n.trackedIPs[nodeID] = tracked
+		n.dial(n.onCloseCtx, nodeID, tracked)
+		numDialed++
+	}
+
+	n.peerConfig.Log.Info("dialing persisted peer IPs",
+		zap.Int("numPersisted", len(peerIPs)),
+		zap.Int("numDialed", numDialed),
+	)
+}
+
+// verifyPersistedIP returns nil if [ip] was signed by [nodeID] at a time that
+// isn't after [maxTimestamp].
+func verifyPersistedIP(nodeID ids.NodeID, ip *ips.ClaimedIPPort, maxTimestamp uint64) error {
+	if certNodeID := ids.NodeIDFromCert(ip.Cert); certNodeID != nodeID {
+		return fmt.Errorf("%w: expected %s but got %s", errNodeIDMismatch, nodeID, certNodeID)
+	}
+	// Note that it is expected that the timestamp can be in the past. We are
+	// just verifying that the claimed signing time isn't too far in the future
+	// here.
+	if ip.Timestamp > maxTimestamp {
+		return fmt.Errorf("%w: %d > %d", errTimestampTooFarInFuture, ip.Timestamp, maxTimestamp)
+	}
+	signedIP := peer.SignedIP{
+		UnsignedIP: peer.UnsignedIP{
+			IPPort:    ip.IPPort,
+			Timestamp: ip.Timestamp,
+		},
+		Signature: ip.Signature,
+	}
+	return signedIP.Verify(ip.Cert)
+}
+
+// persistIP queues [ip] to be stored so that [nodeID] can be dialed right away
+// after a restart. The IPs of peers that we don't want to connect to aren't
+// persisted.
+//
+// Assumes [peersLock] is held and that [ip] was verified.
+func (n *network) persistIP(nodeID ids.NodeID, ip *ips.ClaimedIPPort) {
+	if !n.wantsConnection(nodeID) {
+		return
+	}
+	n.queuePeerIP(nodeID, ip)
+}
+
+// deletePersistedIP queues the IP persisted for [nodeID] to be removed.
+func (n *network) deletePersistedIP(nodeID ids.NodeID) {
+	n.queuePeerIP(nodeID, nil)
+}
+
+// queuePeerIP queues [ip] to be written as the persisted IP of [nodeID]. If
+// [ip] is nil, the persisted IP is removed. Replaces any change that was
+// previously queued for [nodeID].
+func (n *network) queuePeerIP(nodeID ids.NodeID, ip *ips.ClaimedIPPort) {
+	n.pendingPeerIPsLock.Lock()
+	n.pendingPeerIPs[nodeID] = ip
+	n.pendingPeerIPsLock.Unlock()
+
+	select {
+	case n.pendingPeerIPsSignal <- struct{}{}:
+	default:
+	}
+}
+
+// runPeerIPWriter writes the queued changes to the persisted peer IPs until
+// the network is closed.
+func (n *network) runPeerIPWriter() {
+	for {
+		select {
+		case <-n.onCloseCtx.Done():
+			return
+		case <-n.pendingPeerIPsSignal:
+			// Changes queued while the network is closing are written by
+			// [Dispatch] once the peers have closed.
+			if n.onCloseCtx.Err() != nil {
+				return
+			}
+			n.writePeerIPs()
+		}
+	}
+}
+
+// writePeerIPs writes the changes to the persisted peer IPs that are currently
+// queued.
+func (n *network) writePeerIPs() {
+	n.writePeerIPsLock.Lock()
+	defer n.writePeerIPsLock.Unlock()
+
+	n.pendingPeerIPsLock.Lock()
+	pending := n.pendingPeerIPs
+	n.pendingPeerIPs = make(map[ids.NodeID]*ips.ClaimedIPPort)
+	n.pendingPeerIPsLock.Unlock()
+
+	for nodeID, ip := range pending {
+		if ip == nil {
+			if err := n.config.PeerList.Delete(nodeID); err != nil {
+				n.peerConfig.Log.Warn("failed to delete persisted peer IP",
+					zap.Stringer("nodeID", nodeID),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+
+		if err := n.config.PeerList.Put(nodeID, ip); err != nil {
+			n.peerConfig.Log.Warn("failed to persist peer IP",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 // peerIPStatus assumes the caller holds [peersLock]
 func (n *network) peerIPStatus(nodeID ids.NodeID, ip *ips.ClaimedIPPort) (*ips.ClaimedIPPort, bool, bool, bool) {
 	prevIP, previouslyTracked := n.peerIPs[nodeID]
 	_, connected := n.connectedPeers.GetByID(nodeID)
 	shouldUpdateOurIP := previouslyTracked && prevIP.Timestamp < ip.Timestamp
-	shouldDial := !previouslyTracked && !connected && n.wantsConnection(nodeID)
+	shouldDial := !previouslyTracked && !connected && n.wantsConnection(nodeID) &&
+		!n.config.Banlist.IsIPBanned(ip.IPPort.IP)
 	return prevIP, previouslyTracked, shouldUpdateOurIP, shouldDial
 }
 
@@ -1055,8 +1335,8 @@ func (n *network) peerIPStatus(nodeID ids.NodeID, ip *ips.ClaimedIPPort) (*ips.C
 // If [nodeID] is marked as connecting or connected then this goroutine will
 // exit.
 //
-// If [nodeID] is no longer marked as desired then this goroutine will exit and
-// the entry in the [trackedIP]s set will be removed.
+// If [nodeID] is no longer marked as desired, or [ip] is banned, then this
+// goroutine will exit and the entry in the [trackedIP]s set will be removed.
 //
 // If initiating a connection to [ip] fails, then dial will reattempt. However,
 // there is a randomized exponential backoff to avoid spamming connection
@@ -1077,7 +1357,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 			}
 
 			n.peersLock.Lock()
-			if !n.wantsConnection(nodeID) {
+			if !n.wantsConnection(nodeID) || n.config.Banlist.IsIPBanned(ip.ip.IP) {
 				// Typically [n.trackedIPs[nodeID]] will already equal [ip], but
 				// the reference to [ip] is refreshed to avoid any potential
 				// race conditions before removing the entry.
@@ -1085,6 +1365,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 					ip.stopTracking()
 					delete(n.peerIPs, nodeID)
 					delete(n.trackedIPs, nodeID)
+					n.deletePersistedIP(nodeID)
 				}
 				n.peersLock.Unlock()
 				return
@@ -1115,7 +1396,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 				n.config.MaxReconnectDelay,
 			)
 
-			conn, err := n.dialer.Dial(ctx, ip.ip)
+			conn, upgrader, err := n.dialConn(ctx, nodeID, ip.ip)
 			if err != nil {
 				n.peerConfig.Log.Verbo(
 					"failed to reach peer, attempting again",
@@ -1130,7 +1411,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 				zap.Stringer("peerIP", ip.ip.IP),
 			)
 
-			err = n.upgrade(conn, n.clientUpgrader)
+			err = n.upgrade(conn, upgrader)
 			if err != nil {
 				n.peerConfig.Log.Verbo(
 					"failed to upgrade, attempting again",
@@ -1144,6 +1425,40 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 	}()
 }
 
+// dialConn connects to [nodeID] at [ip]. QUIC is used if it is enabled and
+// [nodeID] accepts QUIC connections, falling back to TCP if the QUIC
+// connection can't be established. Returns the connection and the upgrader
+// that must be used to upgrade it.
+func (n *network) dialConn(ctx context.Context, nodeID ids.NodeID, ip ips.IPPort) (net.Conn, peer.Upgrader, error) {
+	if n.config.QUICEnabled {
+		n.peersLock.RLock()
+		supportsQUIC := n.quicPeers.Contains(nodeID)
+		n.peersLock.RUnlock()
+
+		if supportsQUIC {
+			conn, err := n.config.QUICDialer.Dial(ctx, ip)
+			if err == nil {
+				return conn, n.quicUpgrader, nil
+			}
+
+			n.peerConfig.Log.Verbo("failed to reach peer over QUIC, falling back to TCP",
+				zap.Stringer("nodeID", nodeID),
+				zap.Stringer("peerIP", ip),
+				zap.Error(err),
+			)
+
+			// Only attempt QUIC again once the peer claims to accept QUIC
+			// connections in its next handshake.
+			n.peersLock.Lock()
+			n.quicPeers.Remove(nodeID)
+			n.peersLock.Unlock()
+		}
+	}
+
+	conn, err := n.dialer.Dial(ctx, ip)
+	return conn, n.clientUpgrader, err
+}
+
 // upgrade the provided connection, which may be an inbound connection or an
 // outbound connection, with the provided [upgrader].
 //
@@ -1275,6 +1590,16 @@ func (n *network) PeerInfo(nodeIDs []ids.NodeID) []peer.Info {
 	return n.connectedPeers.Info(nodeIDs)
 }
 
+func (n *network) PeerStats(nodeIDs []ids.NodeID) []peer.Stats {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	if len(nodeIDs) == 0 {
+		return n.connectedPeers.AllStats()
+	}
+	return n.connectedPeers.Stats(nodeIDs)
+}
+
 func (n *network) StartClose() {
 	n.closeOnce.Do(func() {
 		n.peerConfig.Log.Info("shutting down the p2p networking")
@@ -1291,6 +1616,15 @@ func (n *network) StartClose() {
 		n.closing = true
 		n.onCloseCtxCancel()
 
+		// [quicListener] is nil if [Dispatch] hasn't created it yet.
+		if n.quicListener != nil {
+			if err := n.quicListener.Close(); err != nil {
+				n.peerConfig.Log.Debug("closing the QUIC listener",
+					zap.Error(err),
+				)
+			}
+		}
+
 		for nodeID, tracked := range n.trackedIPs {
 			tracked.stopTracking()
 			delete(n.peerIPs, nodeID)
@@ -1309,6 +1643,50 @@ func (n *network) StartClose() {
 	})
 }
 
+// OnBanned closes the connections with, and stops attempting to connect to,
+// the peers that are banned by [ban].
+func (n *network) OnBanned(ban banlist.Ban) {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	for nodeID, tracked := range n.trackedIPs {
+		if !isBanned(ban, nodeID, tracked.ip.IP) {
+			continue
+		}
+		tracked.stopTracking()
+		delete(n.peerIPs, nodeID)
+		delete(n.trackedIPs, nodeID)
+		n.deletePersistedIP(nodeID)
+	}
+
+	for _, peers := range []peer.Set{n.connectingPeers, n.connectedPeers} {
+		for i := 0; i < peers.Len(); i++ {
+			peer, _ := peers.GetByIndex(i)
+			nodeID := peer.ID()
+			// If the remote address can't be parsed, the peer can only be
+			// banned by its nodeID.
+			remoteIP, _ := ips.ToIPPort(peer.RemoteAddr().String())
+			if !isBanned(ban, nodeID, remoteIP.IP) {
+				continue
+			}
+
+			n.peerConfig.Log.Debug("disconnecting from peer",
+				zap.String("reason", "peer was banned"),
+				zap.Stringer("nodeID", nodeID),
+			)
+			peer.StartClose()
+		}
+	}
+}
+
+// isBanned returns true if [ban] prevents connections with [nodeID] at [ip].
+func isBanned(ban banlist.Ban, nodeID ids.NodeID, ip net.IP) bool {
+	if ban.IPs == nil {
+		return ban.NodeID == nodeID
+	}
+	return ban.IPs.Contains(ip)
+}
+
 func (n *network) NodeUptime(subnetID ids.ID) (UptimeResult, error) {
 	if subnetID != constants.PrimaryNetworkID && !n.config.TrackedSubnets.Contains(subnetID) {
 		return UptimeResult{}, errNotTracked
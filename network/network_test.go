@@ -15,10 +15,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/network/dialer"
 	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/network/peerlist"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
 	"github.com/VidarSolutions/avalanchego/snow/networking/router"
@@ -26,6 +29,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/uptime"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
 	"github.com/VidarSolutions/avalanchego/subnets"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
@@ -110,7 +114,7 @@ var (
 		PingFrequency:      constants.DefaultPingFrequency,
 		AllowPrivateIPs:    true,
 
-		CompressionEnabled: true,
+		CompressionType: compression.TypeGzip,
 
 		UptimeCalculator:  uptime.NewManager(uptime.NewTestState()),
 		UptimeMetricFreq:  30 * time.Second,
@@ -185,7 +189,7 @@ func newMessageCreator(t *testing.T) message.Creator {
 	mc, err := message.NewCreator(
 		prometheus.NewRegistry(),
 		"",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -238,6 +242,9 @@ func newFullyConnectedTestNetwork(t *testing.T, handlers []router.InboundHandler
 		config.GossipTracker = g
 		config.Beacons = beacons
 		config.Validators = vdrs
+		config.Banlist, err = banlist.New(memdb.New())
+		require.NoError(err)
+		config.PeerList = peerlist.New(memdb.New())
 
 		var connected set.Set[ids.NodeID]
 		net, err := NewNetwork(
@@ -430,3 +437,136 @@ func TestTrackVerifiesSignatures(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestBanDisconnectsPeer(t *testing.T) {
+	require := require.New(t)
+
+	nodeIDs, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil, nil, nil})
+
+	network := networks[0].(*network)
+	bannedNodeID := nodeIDs[1]
+	err := network.config.Banlist.BanNode(bannedNodeID, time.Time{})
+	require.NoError(err)
+
+	// The connection with the banned peer is closed and isn't re-established.
+	require.Eventually(
+		func() bool {
+			return len(network.PeerInfo([]ids.NodeID{bannedNodeID})) == 0
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+	require.False(network.WantsConnection(bannedNodeID))
+
+	network.peersLock.RLock()
+	_, isTracked := network.trackedIPs[bannedNodeID]
+	_, hasIP := network.peerIPs[bannedNodeID]
+	network.peersLock.RUnlock()
+	require.False(isTracked)
+	require.False(hasIP)
+
+	// Peers that aren't banned stay connected.
+	require.Len(network.PeerInfo([]ids.NodeID{nodeIDs[2]}), 1)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestPersistIPIsQueued(t *testing.T) {
+	require := require.New(t)
+
+	_, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil})
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+
+	network := networks[0].(*network)
+	nodeID, tlsCert, _ := getTLS(t, 1)
+	err := validators.Add(network.config.Validators, constants.PrimaryNetworkID, nodeID, nil, ids.Empty, 1)
+	require.NoError(err)
+
+	ip := &ips.ClaimedIPPort{
+		Cert: tlsCert.Leaf,
+		IPPort: ips.IPPort{
+			IP:   net.IPv6loopback,
+			Port: 10000,
+		},
+		Timestamp: 1000,
+	}
+
+	// The IP isn't written while [peersLock] is held.
+	network.peersLock.Lock()
+	network.persistIP(nodeID, ip)
+	network.peersLock.Unlock()
+
+	persisted, err := network.config.PeerList.GetAll()
+	require.NoError(err)
+	require.Empty(persisted)
+
+	network.writePeerIPs()
+
+	persisted, err = network.config.PeerList.GetAll()
+	require.NoError(err)
+	require.Contains(persisted, nodeID)
+	require.Equal(ip.IPPort, persisted[nodeID].IPPort)
+
+	// Only the most recently queued change is written.
+	network.peersLock.Lock()
+	network.persistIP(nodeID, ip)
+	network.deletePersistedIP(nodeID)
+	network.peersLock.Unlock()
+
+	network.writePeerIPs()
+
+	persisted, err = network.config.PeerList.GetAll()
+	require.NoError(err)
+	require.Empty(persisted)
+}
+
+func TestDispatchCreatesQUICListener(t *testing.T) {
+	require := require.New(t)
+
+	log := logging.NoLog{}
+	netIntf, err := NewTestNetwork(
+		log,
+		constants.LocalID,
+		validators.NewSet(),
+		set.Set[ids.ID]{},
+		&testExternalHandler{log: log},
+	)
+	require.NoError(err)
+	network := netIntf.(*network)
+	network.config.QUICEnabled = true
+
+	getQUICListener := func() net.Listener {
+		network.peersLock.RLock()
+		defer network.peersLock.RUnlock()
+
+		return network.quicListener
+	}
+
+	// Connections aren't accepted until the network is dispatched.
+	require.Nil(getQUICListener())
+
+	dispatchErr := make(chan error)
+	go func() {
+		dispatchErr <- network.Dispatch()
+	}()
+	require.Eventually(
+		func() bool {
+			return getQUICListener() != nil
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+
+	network.StartClose()
+	require.NoError(<-dispatchErr)
+
+	// The QUIC listener was closed along with the network.
+	_, err = getQUICListener().Accept()
+	require.Error(err)
+}
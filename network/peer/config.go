@@ -8,6 +8,7 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/network/capture"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/snow/networking/router"
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
@@ -30,6 +31,7 @@ type Config struct {
 
 	Log                  logging.Logger
 	InboundMsgThrottler  throttling.InboundMsgThrottler
+	InboundOpThrottler   throttling.InboundOpThrottler
 	Network              Network
 	Router               router.InboundHandler
 	VersionCompatibility version.Compatibility
@@ -52,4 +54,12 @@ type Config struct {
 
 	// Signs my IP so I can send my signed IP address in the Version message
 	IPSigner *IPSigner
+
+	// True if this node accepts QUIC connections, which is advertised in the
+	// Version message
+	QUICEnabled bool
+
+	// Records the messages sent to and received from peers while a capture
+	// is in progress. If nil, messages aren't recorded.
+	Recorder capture.Recorder
 }
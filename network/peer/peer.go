@@ -21,6 +21,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/message"
 	"github.com/VidarSolutions/avalanchego/proto/pb/p2p"
 	"github.com/VidarSolutions/avalanchego/utils"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/json"
@@ -45,6 +46,10 @@ type Peer interface {
 	// authenticate their messages.
 	Cert() *x509.Certificate
 
+	// RemoteAddr returns the address of the remote end of the connection with
+	// the peer.
+	RemoteAddr() net.Addr
+
 	// LastSent returns the last time a message was sent to the peer.
 	LastSent() time.Time
 
@@ -64,6 +69,10 @@ type Peer interface {
 	// called after [Ready] returns true.
 	Info() Info
 
+	// Stats returns the traffic exchanged with this peer since the connection
+	// was established. It should only be called after [Ready] returns true.
+	Stats() Stats
+
 	// IP returns the claimed IP and signature provided by this peer during the
 	// handshake. It should only be called after [Ready] returns true.
 	IP() *SignedIP
@@ -76,6 +85,10 @@ type Peer interface {
 	// be called after [Ready] returns true.
 	TrackedSubnets() set.Set[ids.ID]
 
+	// SupportsQUIC returns true if this peer accepts QUIC connections. It
+	// should only be called after [Ready] returns true.
+	SupportsQUIC() bool
+
 	// ObservedUptime returns the local node's subnet uptime according to the
 	// peer. The value ranges from [0, 100]. It should only be called after
 	// [Ready] returns true.
@@ -128,6 +141,13 @@ type peer struct {
 	// trackedSubnets is the subset of subnetIDs the peer sent us in the Version
 	// message that we are also tracking.
 	trackedSubnets set.Set[ids.ID]
+	// compressionTypes are the compression types the peer can decompress.
+	// Until the peer's Version message is received, it's assumed that only
+	// gzip is supported.
+	compressionTypes utils.Atomic[set.Set[compression.Type]]
+	// supportsQUIC is true if the peer claimed in the Version message that it
+	// accepts QUIC connections.
+	supportsQUIC bool
 
 	observedUptimesLock sync.RWMutex
 	// [observedUptimesLock] must be held while accessing [observedUptime]
@@ -164,6 +184,9 @@ type peer struct {
 	// Must only be accessed atomically
 	lastSent, lastReceived int64
 
+	// stats tracks the traffic exchanged with this peer
+	stats stats
+
 	// peerListChan signals that we should attempt to send a PeerList to this
 	// peer
 	peerListChan chan struct{}
@@ -195,6 +218,9 @@ func Start(
 		observedUptimes:    make(map[ids.ID]uint32),
 		peerListChan:       make(chan struct{}, 1),
 	}
+	p.compressionTypes.Set(set.Set[compression.Type]{
+		compression.TypeGzip: {},
+	})
 
 	go p.readMessages()
 	go p.writeMessages()
@@ -211,6 +237,10 @@ func (p *peer) Cert() *x509.Certificate {
 	return p.cert
 }
 
+func (p *peer) RemoteAddr() net.Addr {
+	return p.conn.RemoteAddr()
+}
+
 func (p *peer) LastSent() time.Time {
 	return time.Unix(
 		atomic.LoadInt64(&p.lastSent),
@@ -275,6 +305,14 @@ func (p *peer) Info() Info {
 	}
 }
 
+func (p *peer) Stats() Stats {
+	stats := p.stats.Get()
+	stats.ID = p.id
+	stats.SendQueueLength = json.Uint64(p.messageQueue.Len())
+	stats.TrackedSubnets = p.trackedSubnets.List()
+	return stats
+}
+
 func (p *peer) IP() *SignedIP {
 	return p.ip
 }
@@ -287,6 +325,10 @@ func (p *peer) TrackedSubnets() set.Set[ids.ID] {
 	return p.trackedSubnets
 }
 
+func (p *peer) SupportsQUIC() bool {
+	return p.supportsQUIC
+}
+
 func (p *peer) ObservedUptime(subnetID ids.ID) (uint32, bool) {
 	p.observedUptimesLock.RLock()
 	defer p.observedUptimesLock.RUnlock()
@@ -296,7 +338,17 @@ func (p *peer) ObservedUptime(subnetID ids.ID) (uint32, bool) {
 }
 
 func (p *peer) Send(ctx context.Context, msg message.OutboundMessage) bool {
-	return p.messageQueue.Push(ctx, msg)
+	// The message may be compressed with a type the peer doesn't support.
+	peerMsg, err := p.MessageCreator.Recompress(msg, p.compressionTypes.Get())
+	if err != nil {
+		p.Log.Error("failed to recompress message",
+			zap.Stringer("nodeID", p.id),
+			zap.Stringer("messageOp", msg.Op()),
+			zap.Error(err),
+		)
+		return false
+	}
+	return p.messageQueue.Push(ctx, peerMsg)
 }
 
 func (p *peer) StartSendPeerList() {
@@ -352,10 +404,12 @@ func (p *peer) close() {
 // Read and handle messages from this peer.
 // When this method returns, the connection is closed.
 func (p *peer) readMessages() {
-	// Track this node with the inbound message throttler.
+	// Track this node with the inbound message throttlers.
 	p.InboundMsgThrottler.AddNode(p.id)
+	p.InboundOpThrottler.AddNode(p.id)
 	defer func() {
 		p.InboundMsgThrottler.RemoveNode(p.id)
+		p.InboundOpThrottler.RemoveNode(p.id)
 		p.StartClose()
 		p.close()
 	}()
@@ -405,11 +459,13 @@ func (p *peer) readMessages() {
 		// exited before calling [Network.Disconnected] to guarantee that there
 		// can't be multiple instances of this goroutine running over different
 		// peer instances.
+		startedWaiting := p.Clock.Time()
 		onFinishedHandling := p.InboundMsgThrottler.Acquire(
 			p.onClosingCtx,
 			uint64(msgLen),
 			p.id,
 		)
+		p.stats.WaitedOnThrottler(p.Clock.Time().Sub(startedWaiting))
 
 		// If the peer is shutting down, there's no need to read the message.
 		if err := p.onClosingCtx.Err(); err != nil {
@@ -472,6 +528,22 @@ func (p *peer) readMessages() {
 		atomic.StoreInt64(&p.Config.LastReceived, now)
 		atomic.StoreInt64(&p.lastReceived, now)
 		p.Metrics.Received(msg, msgLen)
+		p.stats.Received(msg.Op(), msgLen)
+		if p.Recorder != nil {
+			p.Recorder.Inbound(msg, msgBytes)
+		}
+
+		if !p.InboundOpThrottler.Allow(p.id, msg.Op()) {
+			p.Log.Debug("dropping message",
+				zap.String("reason", "op rate limit exceeded"),
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", msg.Op()),
+			)
+
+			msg.OnFinishedHandling()
+			p.ResourceTracker.StopProcessing(p.id, p.Clock.Time())
+			continue
+		}
 
 		// Handle the message. Note that when we are done handling this message,
 		// we must call [msg.OnFinishedHandling()].
@@ -505,6 +577,7 @@ func (p *peer) writeMessages() {
 		mySignedIP.Timestamp,
 		mySignedIP.Signature,
 		p.MySubnets.List(),
+		p.QUICEnabled,
 	)
 	if err != nil {
 		p.Log.Error("failed to create message",
@@ -578,10 +651,15 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 		return
 	}
 
-	now := p.Clock.Time().Unix()
-	atomic.StoreInt64(&p.Config.LastSent, now)
-	atomic.StoreInt64(&p.lastSent, now)
+	now := p.Clock.Time()
+	nowUnix := now.Unix()
+	atomic.StoreInt64(&p.Config.LastSent, nowUnix)
+	atomic.StoreInt64(&p.lastSent, nowUnix)
 	p.Metrics.Sent(msg)
+	p.stats.Sent(msg.Op(), msgLen, now)
+	if p.Recorder != nil {
+		p.Recorder.Outbound(p.id, msg)
+	}
 }
 
 func (p *peer) sendNetworkMessages() {
@@ -750,6 +828,8 @@ func (p *peer) handlePing(*p2p.Ping) {
 }
 
 func (p *peer) handlePong(msg *p2p.Pong) {
+	p.stats.ReceivedPong(p.Clock.Time())
+
 	if msg.Uptime > 100 {
 		p.Log.Debug("dropping pong message with invalid uptime",
 			zap.Stringer("nodeID", p.id),
@@ -895,6 +975,21 @@ func (p *peer) handleVersion(msg *p2p.Version) {
 		}
 	}
 
+	// Peers that don't list their supported compression types only support
+	// gzip. Unknown compression types are ignored, as they may have been added
+	// in a newer version.
+	compressionTypes := set.Set[compression.Type]{
+		compression.TypeGzip: {},
+	}
+	for _, compressionTypeInt := range msg.SupportedCompressionTypes {
+		compressionType := compression.Type(compressionTypeInt)
+		if compressionTypeInt <= math.MaxUint8 && compressionType.Valid() {
+			compressionTypes.Add(compressionType)
+		}
+	}
+	p.compressionTypes.Set(compressionTypes)
+	p.supportsQUIC = msg.SupportsQuic
+
 	// "net.IP" type in Golang is 16-byte
 	if ipLen := len(msg.IpAddr); ipLen != net.IPv6len {
 		p.Log.Debug("message with invalid field",
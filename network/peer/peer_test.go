@@ -23,8 +23,10 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
 	"github.com/VidarSolutions/avalanchego/staking"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
+	"github.com/VidarSolutions/avalanchego/utils/json"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/math/meter"
 	"github.com/VidarSolutions/avalanchego/utils/resource"
@@ -51,7 +53,7 @@ func newMessageCreator(t *testing.T) message.Creator {
 	mc, err := message.NewCreator(
 		prometheus.NewRegistry(),
 		"",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -96,6 +98,7 @@ func makeRawTestPeers(t *testing.T) (*rawTestPeer, *rawTestPeer) {
 		MessageCreator:       mc,
 		Log:                  logging.NoLog{},
 		InboundMsgThrottler:  throttling.NewNoInboundThrottler(),
+		InboundOpThrottler:   throttling.NewNoInboundOpThrottler(),
 		VersionCompatibility: version.GetCompatibility(constants.LocalID),
 		MySubnets:            set.Set[ids.ID]{},
 		Beacons:              validators.NewSet(),
@@ -272,3 +275,87 @@ func TestSend(t *testing.T) {
 	err = peer1.AwaitClosed(context.Background())
 	require.NoError(err)
 }
+
+func TestSendNegotiatesCompression(t *testing.T) {
+	require := require.New(t)
+
+	peer0, peer1 := makeReadyTestPeers(t)
+	mc, err := message.NewCreator(
+		prometheus.NewRegistry(),
+		"",
+		compression.TypeZstd,
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	// Both peers advertised that they support zstd in their handshakes.
+	require.Contains(peer0.Peer.(*peer).compressionTypes.Get(), compression.TypeZstd)
+
+	container := make([]byte, 1024)
+	outboundPutMsg, err := mc.Put(ids.Empty, 1, container, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	require.Equal(compression.TypeZstd, outboundPutMsg.CompressionType())
+
+	sent := peer0.Send(context.Background(), outboundPutMsg)
+	require.True(sent)
+
+	inboundPutMsg := <-peer1.inboundMsgChan
+	require.Equal(message.PutOp, inboundPutMsg.Op())
+	require.Equal(container, inboundPutMsg.Message().(*p2p.Put).Container)
+
+	// Peers that don't support zstd are sent gzip compressed messages.
+	peer0.Peer.(*peer).compressionTypes.Set(set.Set[compression.Type]{
+		compression.TypeGzip: {},
+	})
+
+	sent = peer0.Send(context.Background(), outboundPutMsg)
+	require.True(sent)
+
+	inboundPutMsg = <-peer1.inboundMsgChan
+	require.Equal(message.PutOp, inboundPutMsg.Op())
+	require.Equal(container, inboundPutMsg.Message().(*p2p.Put).Container)
+
+	peer1.StartClose()
+	err = peer0.AwaitClosed(context.Background())
+	require.NoError(err)
+	err = peer1.AwaitClosed(context.Background())
+	require.NoError(err)
+}
+
+func TestStatsAfterSend(t *testing.T) {
+	require := require.New(t)
+
+	peer0, peer1 := makeReadyTestPeers(t)
+	mc := newMessageCreator(t)
+
+	outboundGetMsg, err := mc.Get(ids.Empty, 1, time.Second, ids.Empty, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	msgLen := json.Uint64(len(outboundGetMsg.Bytes()))
+
+	sent := peer0.Send(context.Background(), outboundGetMsg)
+	require.True(sent)
+
+	inboundGetMsg := <-peer1.inboundMsgChan
+	require.Equal(message.GetOp, inboundGetMsg.Op())
+
+	// The message is received after the sender records it as sent.
+	stats0 := peer0.Stats()
+	require.Equal(peer0.ID(), stats0.ID)
+	require.Equal(MessageStats{
+		NumSent:   1,
+		SentBytes: msgLen,
+	}, stats0.Messages[message.GetOp.String()])
+
+	stats1 := peer1.Stats()
+	require.Equal(peer1.ID(), stats1.ID)
+	require.Equal(MessageStats{
+		NumReceived:   1,
+		ReceivedBytes: msgLen,
+	}, stats1.Messages[message.GetOp.String()])
+
+	peer1.StartClose()
+	err = peer0.AwaitClosed(context.Background())
+	require.NoError(err)
+	err = peer1.AwaitClosed(context.Background())
+	require.NoError(err)
+}
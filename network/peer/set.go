@@ -49,6 +49,13 @@ type Set interface {
 	// Info returns information about the requested peers if they are in the
 	// set.
 	Info(nodeIDs []ids.NodeID) []Info
+
+	// Returns the traffic stats of all the peers.
+	AllStats() []Stats
+
+	// Stats returns the traffic stats of the requested peers if they are in
+	// the set.
+	Stats(nodeIDs []ids.NodeID) []Stats
 }
 
 type peerSet struct {
@@ -157,3 +164,21 @@ func (s *peerSet) Info(nodeIDs []ids.NodeID) []Info {
 	}
 	return peerInfo
 }
+
+func (s *peerSet) AllStats() []Stats {
+	peerStats := make([]Stats, len(s.peersSlice))
+	for i, peer := range s.peersSlice {
+		peerStats[i] = peer.Stats()
+	}
+	return peerStats
+}
+
+func (s *peerSet) Stats(nodeIDs []ids.NodeID) []Stats {
+	peerStats := make([]Stats, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if peer, ok := s.GetByID(nodeID); ok {
+			peerStats = append(peerStats, peer.Stats())
+		}
+	}
+	return peerStats
+}
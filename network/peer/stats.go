@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/utils/json"
+)
+
+// pingRTTHistorySize is the number of ping round trip times that are kept for
+// each peer.
+const pingRTTHistorySize = 16
+
+// MessageStats describe the messages of one type exchanged with a peer.
+type MessageStats struct {
+	NumSent       json.Uint64 `json:"numSent"`
+	SentBytes     json.Uint64 `json:"sentBytes"`
+	NumReceived   json.Uint64 `json:"numReceived"`
+	ReceivedBytes json.Uint64 `json:"receivedBytes"`
+}
+
+// Stats describe the traffic exchanged with a peer since the connection was
+// established.
+type Stats struct {
+	ID ids.NodeID `json:"nodeID"`
+	// Message op --> messages with that op exchanged with the peer. Ops that
+	// were never exchanged are omitted.
+	Messages map[string]MessageStats `json:"messages"`
+	// Number of messages waiting to be sent to the peer.
+	SendQueueLength json.Uint64 `json:"sendQueueLength"`
+	// Total time, in milliseconds, spent waiting on the inbound message
+	// throttler before reading messages from the peer.
+	ThrottlerWaitTimeMs json.Float64 `json:"throttlerWaitTimeMs"`
+	// Round trip times, in milliseconds, of the most recent pings, from
+	// oldest to newest.
+	PingRTTsMs     []json.Float64 `json:"pingRTTsMs"`
+	TrackedSubnets []ids.ID       `json:"trackedSubnets"`
+}
+
+// stats tracks the traffic exchanged with a single peer.
+// The zero value is ready to use.
+type stats struct {
+	lock sync.Mutex
+	// Op --> messages with that op exchanged with the peer
+	messages          map[message.Op]*MessageStats
+	throttlerWaitTime time.Duration
+	// Time the last ping was sent, or the zero time if the pong for it has
+	// already been received.
+	lastPingSent time.Time
+	// Round trip times of the last [pingRTTHistorySize] pings, from oldest to
+	// newest.
+	pingRTTs []time.Duration
+}
+
+// Returns the stats of messages with [op].
+// Assumes [s.lock] is held.
+func (s *stats) opStats(op message.Op) *MessageStats {
+	if s.messages == nil {
+		s.messages = make(map[message.Op]*MessageStats)
+	}
+	opStats, ok := s.messages[op]
+	if !ok {
+		opStats = &MessageStats{}
+		s.messages[op] = opStats
+	}
+	return opStats
+}
+
+// Sent records that a message with [op] and length [msgLen] was sent to the
+// peer at [now].
+func (s *stats) Sent(op message.Op, msgLen uint32, now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	opStats := s.opStats(op)
+	opStats.NumSent++
+	opStats.SentBytes += json.Uint64(msgLen)
+	if op == message.PingOp {
+		s.lastPingSent = now
+	}
+}
+
+// Received records that a message with [op] and length [msgLen] was received
+// from the peer.
+func (s *stats) Received(op message.Op, msgLen uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	opStats := s.opStats(op)
+	opStats.NumReceived++
+	opStats.ReceivedBytes += json.Uint64(msgLen)
+}
+
+// ReceivedPong records that a pong was received from the peer at [now].
+// Pongs that don't answer a ping are ignored.
+func (s *stats) ReceivedPong(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.lastPingSent.IsZero() {
+		return
+	}
+
+	s.pingRTTs = append(s.pingRTTs, now.Sub(s.lastPingSent))
+	if len(s.pingRTTs) > pingRTTHistorySize {
+		s.pingRTTs = s.pingRTTs[1:]
+	}
+	s.lastPingSent = time.Time{}
+}
+
+// WaitedOnThrottler records that [duration] was spent waiting on the inbound
+// message throttler.
+func (s *stats) WaitedOnThrottler(duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.throttlerWaitTime += duration
+}
+
+// Get returns the stats tracked so far. The ID, send queue length and tracked
+// subnets aren't populated.
+func (s *stats) Get() Stats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	messages := make(map[string]MessageStats, len(s.messages))
+	for op, opStats := range s.messages {
+		messages[op.String()] = *opStats
+	}
+	pingRTTs := make([]json.Float64, len(s.pingRTTs))
+	for i, rtt := range s.pingRTTs {
+		pingRTTs[i] = toMs(rtt)
+	}
+	return Stats{
+		Messages:            messages,
+		ThrottlerWaitTimeMs: toMs(s.throttlerWaitTime),
+		PingRTTsMs:          pingRTTs,
+	}
+}
+
+// toMs returns [duration] in milliseconds.
+func toMs(duration time.Duration) json.Float64 {
+	return json.Float64(float64(duration) / float64(time.Millisecond))
+}
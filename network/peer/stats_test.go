@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"testing"
+	"time"
+
+	stdjson "encoding/json"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/utils/json"
+)
+
+func TestStats(t *testing.T) {
+	require := require.New(t)
+
+	s := stats{}
+	now := time.Unix(1, 0)
+
+	s.Sent(message.GetOp, 10, now)
+	s.Sent(message.GetOp, 20, now)
+	s.Received(message.PutOp, 30)
+	s.WaitedOnThrottler(time.Second)
+	s.WaitedOnThrottler(time.Second)
+
+	stats := s.Get()
+	require.Equal(map[string]MessageStats{
+		message.GetOp.String(): {
+			NumSent:   2,
+			SentBytes: 30,
+		},
+		message.PutOp.String(): {
+			NumReceived:   1,
+			ReceivedBytes: 30,
+		},
+	}, stats.Messages)
+	require.Equal(json.Float64(2000), stats.ThrottlerWaitTimeMs)
+	require.Empty(stats.PingRTTsMs)
+}
+
+func TestStatsPingRTTs(t *testing.T) {
+	require := require.New(t)
+
+	s := stats{}
+	now := time.Unix(1, 0)
+
+	// A pong that doesn't answer a ping is ignored
+	s.ReceivedPong(now)
+	require.Empty(s.Get().PingRTTsMs)
+
+	expectedRTTs := []json.Float64{}
+	for i := 1; i <= pingRTTHistorySize+1; i++ {
+		rtt := time.Duration(i) * 1500 * time.Microsecond
+		s.Sent(message.PingOp, 0, now)
+		now = now.Add(rtt)
+		s.ReceivedPong(now)
+		expectedRTTs = append(expectedRTTs, json.Float64(float64(i)*1.5))
+	}
+
+	// Only the most recent round trip times are kept
+	require.Equal(expectedRTTs[1:], s.Get().PingRTTsMs)
+
+	// Only the first pong after a ping is counted
+	s.ReceivedPong(now.Add(time.Hour))
+	require.Equal(expectedRTTs[1:], s.Get().PingRTTsMs)
+}
+
+func TestStatsJSON(t *testing.T) {
+	require := require.New(t)
+
+	s := stats{}
+	now := time.Unix(1, 0)
+	s.WaitedOnThrottler(1500 * time.Microsecond)
+	s.Sent(message.PingOp, 0, now)
+	s.ReceivedPong(now.Add(20 * time.Millisecond))
+
+	statsJSON, err := stdjson.Marshal(s.Get())
+	require.NoError(err)
+	require.Contains(string(statsJSON), `"throttlerWaitTimeMs":"1.5000"`)
+	require.Contains(string(statsJSON), `"pingRTTsMs":["20.0000"]`)
+}
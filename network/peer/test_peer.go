@@ -18,6 +18,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
 	"github.com/VidarSolutions/avalanchego/staking"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
@@ -71,7 +72,7 @@ func StartTestPeer(
 	mc, err := message.NewCreator(
 		prometheus.NewRegistry(),
 		"",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	if err != nil {
@@ -106,6 +107,7 @@ func StartTestPeer(
 			MessageCreator:       mc,
 			Log:                  logging.NoLog{},
 			InboundMsgThrottler:  throttling.NewNoInboundThrottler(),
+			InboundOpThrottler:   throttling.NewNoInboundOpThrottler(),
 			Network:              TestNetwork,
 			Router:               router,
 			VersionCompatibility: version.GetCompatibility(networkID),
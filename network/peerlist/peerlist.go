@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peerlist
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/ips"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+var (
+	errInvalidEntry = errors.New("invalid peer list entry")
+
+	_ Store = (*store)(nil)
+)
+
+// Store persists the most recent signed IP of peers so that connections with
+// them can be re-established right away after a restart.
+//
+// The IPs returned from a Store are not verified and must be authenticated
+// before being used.
+type Store interface {
+	// Put persists [ip] as the most recent signed IP of [nodeID]. Replaces
+	// any IP previously persisted for [nodeID].
+	Put(nodeID ids.NodeID, ip *ips.ClaimedIPPort) error
+	// Delete removes the IP persisted for [nodeID], if there is one.
+	Delete(nodeID ids.NodeID) error
+	// GetAll returns the persisted IPs. Entries that can't be parsed are
+	// removed.
+	GetAll() (map[ids.NodeID]*ips.ClaimedIPPort, error)
+}
+
+type store struct {
+	db database.Database
+}
+
+// New returns a store that persists signed IPs in [db].
+func New(db database.Database) Store {
+	return &store{db: db}
+}
+
+func (s *store) Put(nodeID ids.NodeID, ip *ips.ClaimedIPPort) error {
+	return s.db.Put(nodeID[:], marshalIP(ip))
+}
+
+func (s *store) Delete(nodeID ids.NodeID) error {
+	return s.db.Delete(nodeID[:])
+}
+
+func (s *store) GetAll() (map[ids.NodeID]*ips.ClaimedIPPort, error) {
+	it := s.db.NewIterator()
+	defer it.Release()
+
+	var (
+		peerIPs = make(map[ids.NodeID]*ips.ClaimedIPPort)
+		invalid [][]byte
+	)
+	for it.Next() {
+		key := it.Key()
+		nodeID, err := ids.ToNodeID(key)
+		if err != nil {
+			invalid = append(invalid, key)
+			continue
+		}
+		ip, err := unmarshalIP(it.Value())
+		if err != nil {
+			invalid = append(invalid, key)
+			continue
+		}
+		peerIPs[nodeID] = ip
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range invalid {
+		if err := s.db.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+	return peerIPs, nil
+}
+
+// marshalIP returns the bytes of [ip], omitting the txID as it isn't
+// authenticated by the signature.
+func marshalIP(ip *ips.ClaimedIPPort) []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, 2*wrappers.IntLen+len(ip.Cert.Raw)+wrappers.IPLen+wrappers.LongLen+len(ip.Signature)),
+	}
+	p.PackBytes(ip.Cert.Raw)
+	ips.PackIP(&p, ip.IPPort)
+	p.PackLong(ip.Timestamp)
+	p.PackBytes(ip.Signature)
+	return p.Bytes
+}
+
+func unmarshalIP(b []byte) (*ips.ClaimedIPPort, error) {
+	p := wrappers.Packer{Bytes: b}
+	certBytes := p.UnpackBytes()
+	ip := p.UnpackFixedBytes(net.IPv6len)
+	port := p.UnpackShort()
+	timestamp := p.UnpackLong()
+	signature := p.UnpackBytes()
+	if p.Errored() {
+		return nil, fmt.Errorf("%w: %s", errInvalidEntry, p.Err)
+	}
+	if p.Offset != len(b) {
+		return nil, fmt.Errorf("%w: %d trailing bytes", errInvalidEntry, len(b)-p.Offset)
+	}
+
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidEntry, err)
+	}
+	return &ips.ClaimedIPPort{
+		Cert: cert,
+		IPPort: ips.IPPort{
+			IP:   ip,
+			Port: port,
+		},
+		Timestamp: timestamp,
+		Signature: signature,
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peerlist
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/staking"
+	"github.com/VidarSolutions/avalanchego/utils/ips"
+)
+
+func TestStore(t *testing.T) {
+	require := require.New(t)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	cert := tlsCert.Leaf
+	nodeID := ids.NodeIDFromCert(cert)
+
+	ip := &ips.ClaimedIPPort{
+		Cert: cert,
+		IPPort: ips.IPPort{
+			IP:   net.IPv4(192, 0, 2, 1),
+			Port: 9651,
+		},
+		Timestamp: 10,
+		Signature: []byte{1, 2, 3},
+		TxID:      ids.GenerateTestID(),
+	}
+
+	db := memdb.New()
+	s := New(db)
+	require.NoError(s.Put(nodeID, ip))
+
+	peerIPs, err := New(db).GetAll()
+	require.NoError(err)
+	require.Len(peerIPs, 1)
+	require.Equal(ip.Cert.Raw, peerIPs[nodeID].Cert.Raw)
+	require.True(ip.IPPort.Equal(peerIPs[nodeID].IPPort))
+	require.Equal(ip.Timestamp, peerIPs[nodeID].Timestamp)
+	require.Equal(ip.Signature, peerIPs[nodeID].Signature)
+	// The txID isn't persisted
+	require.Equal(ids.Empty, peerIPs[nodeID].TxID)
+
+	// Newer IPs replace older ones
+	ip.Timestamp = 20
+	require.NoError(s.Put(nodeID, ip))
+	peerIPs, err = s.GetAll()
+	require.NoError(err)
+	require.Len(peerIPs, 1)
+	require.Equal(uint64(20), peerIPs[nodeID].Timestamp)
+
+	require.NoError(s.Delete(nodeID))
+	peerIPs, err = s.GetAll()
+	require.NoError(err)
+	require.Empty(peerIPs)
+}
+
+func TestStoreRemovesInvalidEntries(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(db.Put(nodeID[:], []byte{1, 2, 3}))
+	require.NoError(db.Put([]byte{1}, []byte{1, 2, 3}))
+
+	peerIPs, err := New(db).GetAll()
+	require.NoError(err)
+	require.Empty(peerIPs)
+
+	it := db.NewIterator()
+	defer it.Release()
+	require.False(it.Next())
+}
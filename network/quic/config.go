@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"crypto/tls"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+)
+
+const (
+	// nextProto is the application protocol negotiated during the QUIC
+	// handshake.
+	nextProto = "avalanche-p2p"
+
+	// handshakeTimeout is the maximum amount of time to establish a QUIC
+	// connection and open its control stream.
+	handshakeTimeout = 15 * time.Second
+
+	// keepAlivePeriod prevents idle connections from timing out when peers
+	// are configured to ping each other infrequently.
+	keepAlivePeriod = 15 * time.Second
+)
+
+// newTLSConfig returns a copy of [config] that can be used to establish QUIC
+// connections.
+func newTLSConfig(config *tls.Config) *tls.Config {
+	config = config.Clone()
+	config.NextProtos = []string{nextProto}
+	return config
+}
+
+func newConfig() *quicgo.Config {
+	return &quicgo.Config{
+		HandshakeIdleTimeout: handshakeTimeout,
+		KeepAlivePeriod:      keepAlivePeriod,
+		// All messages are sent over the control stream
+		MaxIncomingStreams:    1,
+		MaxIncomingUniStreams: -1,
+	}
+}
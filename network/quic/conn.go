@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+)
+
+const (
+	// Application error codes sent when closing connections.
+	errCodeClosed   quicgo.ApplicationErrorCode = 0
+	errCodeProtocol quicgo.ApplicationErrorCode = 1
+)
+
+var _ net.Conn = (*conn)(nil)
+
+// conn is a [net.Conn] that sends the messages written by a peer over the
+// control stream of a QUIC connection.
+//
+// All messages are sent over the single bidirectional control stream, so
+// messages are received in the order they were sent, as they are over TCP. The
+// peer handlers rely on this order, for example to only handle messages after
+// the handshake messages.
+type conn struct {
+	conn    quicgo.Connection
+	control quicgo.Stream
+
+	closeOnce sync.Once
+}
+
+func newConn(qc quicgo.Connection, control quicgo.Stream) *conn {
+	return &conn{
+		conn:    qc,
+		control: control,
+	}
+}
+
+func (c *conn) Read(b []byte) (int, error) {
+	return c.control.Read(b)
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	return c.control.Write(b)
+}
+
+func (c *conn) Close() error {
+	c.closeOnce.Do(func() {
+		_ = c.conn.CloseWithError(errCodeClosed, "")
+	})
+	return nil
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	return c.control.SetDeadline(t)
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	return c.control.SetReadDeadline(t)
+}
+
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	return c.control.SetWriteDeadline(t)
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/dialer"
+	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/staking"
+	"github.com/VidarSolutions/avalanchego/utils/ips"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/units"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+func acceptAll(ips.IPPort) bool {
+	return true
+}
+
+// connect returns the two ends of a QUIC connection and the IDs of the nodes
+// on each end.
+func connect(t *testing.T) (net.Conn, ids.NodeID, net.Conn, ids.NodeID) {
+	require := require.New(t)
+
+	serverCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	clientCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	listener, err := Listen("127.0.0.1:0", peer.TLSConfig(*serverCert, nil), acceptAll)
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	ip, err := ips.ToIPPort(listener.Addr().String())
+	require.NoError(err)
+
+	bans, err := banlist.New(memdb.New())
+	require.NoError(err)
+	d := NewDialer(
+		peer.TLSConfig(*clientCert, nil),
+		dialer.Config{ConnectionTimeout: 10 * time.Second},
+		logging.NoLog{},
+		bans,
+	)
+	clientConn, err := d.Dial(context.Background(), ip)
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+	})
+
+	// The listener only returns the connection once the control stream is
+	// used.
+	_, err = clientConn.Write(frame([]byte("hello")))
+	require.NoError(err)
+
+	serverConn, err := listener.Accept()
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = serverConn.Close()
+	})
+	require.Equal([]byte("hello"), readFrame(t, serverConn))
+
+	upgrader := NewUpgrader()
+	serverID, _, _, err := upgrader.Upgrade(clientConn)
+	require.NoError(err)
+	require.Equal(ids.NodeIDFromCert(serverCert.Leaf), serverID)
+	clientID, _, _, err := upgrader.Upgrade(serverConn)
+	require.NoError(err)
+	require.Equal(ids.NodeIDFromCert(clientCert.Leaf), clientID)
+	return clientConn, clientID, serverConn, serverID
+}
+
+// frame returns [msg] prefixed with its length, as written by the peer.
+func frame(msg []byte) []byte {
+	b := make([]byte, wrappers.IntLen+len(msg))
+	binary.BigEndian.PutUint32(b, uint32(len(msg)))
+	copy(b[wrappers.IntLen:], msg)
+	return b
+}
+
+func readFrame(t *testing.T, c net.Conn) []byte {
+	require := require.New(t)
+
+	require.NoError(c.SetReadDeadline(time.Now().Add(10 * time.Second)))
+	header := make([]byte, wrappers.IntLen)
+	_, err := io.ReadFull(c, header)
+	require.NoError(err)
+	msg := make([]byte, binary.BigEndian.Uint32(header))
+	_, err = io.ReadFull(c, msg)
+	require.NoError(err)
+	return msg
+}
+
+func TestConnSendsMessages(t *testing.T) {
+	require := require.New(t)
+
+	clientConn, _, serverConn, _ := connect(t)
+
+	small0 := []byte("small0")
+	large := make([]byte, 256*units.KiB)
+	for i := range large {
+		large[i] = byte(i)
+	}
+	small1 := []byte("small1")
+
+	// Messages may be written in pieces
+	var msgs []byte
+	msgs = append(msgs, frame(small0)...)
+	msgs = append(msgs, frame(large)...)
+	msgs = append(msgs, frame(small1)...)
+	for len(msgs) > 0 {
+		n := 1000
+		if n > len(msgs) {
+			n = len(msgs)
+		}
+		_, err := serverConn.Write(msgs[:n])
+		require.NoError(err)
+		msgs = msgs[n:]
+	}
+
+	// Messages are received in the order they were sent, regardless of their
+	// size.
+	require.Equal(small0, readFrame(t, clientConn))
+	require.Equal(large, readFrame(t, clientConn))
+	require.Equal(small1, readFrame(t, clientConn))
+}
+
+func TestConnReadDeadline(t *testing.T) {
+	require := require.New(t)
+
+	clientConn, _, _, _ := connect(t)
+
+	require.NoError(clientConn.SetReadDeadline(time.Now().Add(10 * time.Millisecond)))
+	_, err := clientConn.Read(make([]byte, units.KiB))
+	var netErr net.Error
+	require.ErrorAs(err, &netErr)
+	require.True(netErr.Timeout())
+}
+
+func TestConnClose(t *testing.T) {
+	require := require.New(t)
+
+	clientConn, _, serverConn, _ := connect(t)
+
+	require.NoError(serverConn.Close())
+	require.NoError(clientConn.SetReadDeadline(time.Now().Add(10 * time.Second)))
+	_, err := clientConn.Read(make([]byte, units.KiB))
+	require.Error(err)
+}
+
+func TestDialerRejectsBannedIPs(t *testing.T) {
+	require := require.New(t)
+
+	cert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	bans, err := banlist.New(memdb.New())
+	require.NoError(err)
+	bannedIPs, err := banlist.ParseIPs("127.0.0.1")
+	require.NoError(err)
+	require.NoError(bans.BanIPs(bannedIPs, time.Time{}))
+
+	d := NewDialer(peer.TLSConfig(*cert, nil), dialer.Config{}, logging.NoLog{}, bans)
+	_, err = d.Dial(context.Background(), ips.IPPort{
+		IP:   net.IPv4(127, 0, 0, 1),
+		Port: 9651,
+	})
+	require.ErrorIs(err, errBannedIP)
+}
+
+func TestListenerRejectsBannedIPsBeforeHandshake(t *testing.T) {
+	require := require.New(t)
+
+	serverCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	clientCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	bans, err := banlist.New(memdb.New())
+	require.NoError(err)
+	bannedIPs, err := banlist.ParseIPs("127.0.0.1")
+	require.NoError(err)
+	require.NoError(bans.BanIPs(bannedIPs, time.Time{}))
+
+	// Record every handshake that the listener completes.
+	var (
+		handshakesLock sync.Mutex
+		handshakes     int
+		checkedIPs     []ips.IPPort
+	)
+	serverTLSConfig := peer.TLSConfig(*serverCert, nil)
+	serverTLSConfig.VerifyConnection = func(tls.ConnectionState) error {
+		handshakesLock.Lock()
+		defer handshakesLock.Unlock()
+
+		handshakes++
+		return nil
+	}
+	listener, err := Listen("127.0.0.1:0", serverTLSConfig, func(ip ips.IPPort) bool {
+		handshakesLock.Lock()
+		defer handshakesLock.Unlock()
+
+		checkedIPs = append(checkedIPs, ip)
+		return !bans.IsIPBanned(ip.IP)
+	})
+	require.NoError(err)
+	defer listener.Close()
+
+	ip, err := ips.ToIPPort(listener.Addr().String())
+	require.NoError(err)
+
+	// The dialer doesn't know about the ban.
+	clientBans, err := banlist.New(memdb.New())
+	require.NoError(err)
+	d := NewDialer(
+		peer.TLSConfig(*clientCert, nil),
+		dialer.Config{ConnectionTimeout: time.Second},
+		logging.NoLog{},
+		clientBans,
+	)
+	_, err = d.Dial(context.Background(), ip)
+	require.Error(err)
+
+	handshakesLock.Lock()
+	defer handshakesLock.Unlock()
+
+	require.NotEmpty(checkedIPs)
+	require.True(checkedIPs[0].IP.Equal(net.IPv4(127, 0, 0, 1)))
+	require.Zero(handshakes)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/dialer"
+	"github.com/VidarSolutions/avalanchego/network/throttling"
+	"github.com/VidarSolutions/avalanchego/utils/ips"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+)
+
+var (
+	errBannedIP = errors.New("IP is banned")
+
+	_ dialer.Dialer = (*quicDialer)(nil)
+)
+
+type quicDialer struct {
+	tlsConfig *tls.Config
+	timeout   time.Duration
+	log       logging.Logger
+	throttler throttling.DialThrottler
+	bans      banlist.Checker
+}
+
+// NewDialer returns a new Dialer that establishes QUIC connections
+// authenticated with [tlsConfig].
+// [dialerConfig] is interpreted as it is by [dialer.NewDialer].
+// IPs that [bans] reports as banned aren't dialed.
+//
+// The connections returned by the dialer must be upgraded with the [Upgrader]
+// returned by [NewUpgrader].
+func NewDialer(tlsConfig *tls.Config, dialerConfig dialer.Config, log logging.Logger, bans banlist.Checker) dialer.Dialer {
+	var throttler throttling.DialThrottler
+	if dialerConfig.ThrottleRps <= 0 {
+		throttler = throttling.NewNoDialThrottler()
+	} else {
+		throttler = throttling.NewDialThrottler(int(dialerConfig.ThrottleRps))
+	}
+	return &quicDialer{
+		tlsConfig: newTLSConfig(tlsConfig),
+		timeout:   dialerConfig.ConnectionTimeout,
+		log:       log,
+		throttler: throttler,
+		bans:      bans,
+	}
+}
+
+func (d *quicDialer) Dial(ctx context.Context, ip ips.IPPort) (net.Conn, error) {
+	if d.bans.IsIPBanned(ip.IP) {
+		return nil, fmt.Errorf("%w: %s", errBannedIP, ip)
+	}
+	if err := d.throttler.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	d.log.Verbo("dialing over QUIC",
+		zap.Stringer("ip", ip),
+	)
+
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
+	qc, err := quicgo.DialAddr(ctx, ip.String(), d.tlsConfig, newConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error while dialing %s: %w", ip, err)
+	}
+	// The control stream is announced to the listener once the first message
+	// is written to it.
+	control, err := qc.OpenStreamSync(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(errCodeProtocol, err.Error())
+		return nil, fmt.Errorf("error while opening stream to %s: %w", ip, err)
+	}
+	return newConn(qc, control), nil
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	"github.com/VidarSolutions/avalanchego/utils/ips"
+)
+
+var (
+	errConnRejected = errors.New("connection rejected")
+
+	_ net.Listener = (*listener)(nil)
+)
+
+type listener struct {
+	listener *quicgo.Listener
+
+	// Connections whose control stream was opened by the dialer
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Listen returns a listener that accepts QUIC connections on the UDP address
+// [addr]. Peers are authenticated with [tlsConfig], as with TCP connections.
+//
+// [shouldAccept] is called with the address of every new connection before
+// its handshake starts. If it returns false, the connection is dropped without
+// a response.
+//
+// The connections returned by the listener must be upgraded with the
+// [Upgrader] returned by [NewUpgrader].
+func Listen(addr string, tlsConfig *tls.Config, shouldAccept func(ips.IPPort) bool) (net.Listener, error) {
+	config := newConfig()
+	config.GetConfigForClient = func(info *quicgo.ClientHelloInfo) (*quicgo.Config, error) {
+		ip, err := ips.ToIPPort(info.RemoteAddr.String())
+		if err != nil {
+			return nil, err
+		}
+		if !shouldAccept(ip) {
+			return nil, errConnRejected
+		}
+		return config, nil
+	}
+
+	quicListener, err := quicgo.ListenAddr(addr, newTLSConfig(tlsConfig), config)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &listener{
+		listener: quicListener,
+		conns:    make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+	go l.acceptConns()
+	return l, nil
+}
+
+// acceptConns accepts new QUIC connections until the listener is closed.
+func (l *listener) acceptConns() {
+	for {
+		qc, err := l.listener.Accept(context.Background())
+		if err != nil {
+			return
+		}
+		go l.acceptControlStream(qc)
+	}
+}
+
+// acceptControlStream waits for the dialer of [qc] to open the control stream
+// before [qc] is returned from Accept. Gives up after [handshakeTimeout].
+func (l *listener) acceptControlStream(qc quicgo.Connection) {
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+
+	control, err := qc.AcceptStream(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(errCodeProtocol, err.Error())
+		return
+	}
+
+	c := newConn(qc, control)
+	select {
+	case l.conns <- c:
+	case <-l.closed:
+		_ = c.Close()
+	}
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *listener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		err = l.listener.Close()
+	})
+	return err
+}
+
+func (l *listener) Addr() net.Addr {
+	return l.listener.Addr()
+}
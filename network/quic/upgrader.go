@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package quic
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/network/peer"
+)
+
+var (
+	errNotQUICConn = errors.New("not a QUIC connection")
+	errNoCert      = errors.New("quic handshake finished with no peer certificate")
+
+	_ peer.Upgrader = upgrader{}
+)
+
+type upgrader struct{}
+
+// NewUpgrader returns an Upgrader of the connections returned by [Listen] and
+// [NewDialer]. The TLS handshake is performed while establishing a QUIC
+// connection, so upgrading only reports the authenticated peer.
+func NewUpgrader() peer.Upgrader {
+	return upgrader{}
+}
+
+func (upgrader) Upgrade(c net.Conn) (ids.NodeID, net.Conn, *x509.Certificate, error) {
+	qc, ok := c.(*conn)
+	if !ok {
+		return ids.NodeID{}, nil, nil, fmt.Errorf("%w: %T", errNotQUICConn, c)
+	}
+
+	state := qc.conn.ConnectionState().TLS
+	if len(state.PeerCertificates) == 0 {
+		return ids.NodeID{}, nil, nil, errNoCert
+	}
+	peerCert := state.PeerCertificates[0]
+	return ids.NodeIDFromCert(peerCert), c, peerCert, nil
+}
@@ -13,10 +13,13 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/network/dialer"
 	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/network/peerlist"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/snow/networking/router"
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
@@ -81,7 +84,7 @@ func NewTestNetwork(
 	msgCreator, err := message.NewCreator(
 		metrics,
 		"",
-		constants.DefaultNetworkCompressionEnabled,
+		constants.DefaultNetworkCompressionType,
 		constants.DefaultNetworkMaximumInboundTimeout,
 	)
 	if err != nil {
@@ -163,7 +166,7 @@ func NewTestNetwork(
 		},
 
 		MaxClockDifference:           constants.DefaultNetworkMaxClockDifference,
-		CompressionEnabled:           constants.DefaultNetworkCompressionEnabled,
+		CompressionType:              constants.DefaultNetworkCompressionType,
 		PingFrequency:                constants.DefaultPingFrequency,
 		AllowPrivateIPs:              constants.DefaultNetworkAllowPrivateIPs,
 		UptimeMetricFreq:             constants.DefaultUptimeMetricFreq,
@@ -231,6 +234,13 @@ func NewTestNetwork(
 		return nil, err
 	}
 
+	networkConfig.Banlist, err = banlist.New(memdb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig.PeerList = peerlist.New(memdb.New())
+
 	return NewNetwork(
 		&networkConfig,
 		msgCreator,
@@ -244,6 +254,7 @@ func NewTestNetwork(
 				ConnectionTimeout: constants.DefaultOutboundConnectionTimeout,
 			},
 			log,
+			networkConfig.Banlist,
 		),
 		router,
 	)
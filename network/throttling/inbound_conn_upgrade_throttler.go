@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/set"
@@ -34,7 +35,8 @@ type InboundConnUpgradeThrottler interface {
 	Stop()
 	// Returns whether we should upgrade an inbound connection from [ipStr].
 	// Must only be called after [Dispatch] has been called.
-	// If [ip] is a local IP, this method always returns true.
+	// If [ip] is banned, this method always returns false. Otherwise, if [ip]
+	// is a local IP, this method always returns true.
 	// Must not be called after [Stop] has been called.
 	ShouldUpgrade(ip ips.IPPort) bool
 }
@@ -53,28 +55,39 @@ type InboundConnUpgradeThrottlerConfig struct {
 }
 
 // Returns an InboundConnUpgradeThrottler that upgrades an inbound
-// connection from a given IP at most every [UpgradeCooldown].
-func NewInboundConnUpgradeThrottler(log logging.Logger, config InboundConnUpgradeThrottlerConfig) InboundConnUpgradeThrottler {
+// connection from a given IP at most every [UpgradeCooldown]. Connections from
+// IPs that [bans] reports as banned are never upgraded.
+func NewInboundConnUpgradeThrottler(
+	log logging.Logger,
+	config InboundConnUpgradeThrottlerConfig,
+	bans banlist.Checker,
+) InboundConnUpgradeThrottler {
 	if config.UpgradeCooldown <= 0 || config.MaxRecentConnsUpgraded <= 0 {
-		return &noInboundConnUpgradeThrottler{}
+		return &noInboundConnUpgradeThrottler{
+			bans: bans,
+		}
 	}
 	return &inboundConnUpgradeThrottler{
 		InboundConnUpgradeThrottlerConfig: config,
 		log:                               log,
+		bans:                              bans,
 		done:                              make(chan struct{}),
 		recentIPsAndTimes:                 make(chan ipAndTime, config.MaxRecentConnsUpgraded),
 	}
 }
 
-// noInboundConnUpgradeThrottler upgrades all inbound connections
-type noInboundConnUpgradeThrottler struct{}
+// noInboundConnUpgradeThrottler upgrades all inbound connections from IPs that
+// aren't banned
+type noInboundConnUpgradeThrottler struct {
+	bans banlist.Checker
+}
 
 func (*noInboundConnUpgradeThrottler) Dispatch() {}
 
 func (*noInboundConnUpgradeThrottler) Stop() {}
 
-func (*noInboundConnUpgradeThrottler) ShouldUpgrade(ips.IPPort) bool {
-	return true
+func (n *noInboundConnUpgradeThrottler) ShouldUpgrade(ip ips.IPPort) bool {
+	return !n.bans.IsIPBanned(ip.IP)
 }
 
 type ipAndTime struct {
@@ -85,6 +98,7 @@ type ipAndTime struct {
 type inboundConnUpgradeThrottler struct {
 	InboundConnUpgradeThrottlerConfig
 	log  logging.Logger
+	bans banlist.Checker
 	lock sync.Mutex
 	// Useful for faking time in tests
 	clock mockable.Clock
@@ -102,6 +116,9 @@ type inboundConnUpgradeThrottler struct {
 
 // Returns whether we should upgrade an inbound connection from [ipStr].
 func (n *inboundConnUpgradeThrottler) ShouldUpgrade(ip ips.IPPort) bool {
+	if n.bans.IsIPBanned(ip.IP) {
+		return false
+	}
 	if ip.IP.IsLoopback() {
 		// Don't rate-limit loopback IPs
 		return true
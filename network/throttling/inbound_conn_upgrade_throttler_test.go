@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
 	"github.com/VidarSolutions/avalanchego/utils/ips"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 )
@@ -22,6 +24,12 @@ var (
 	loopbackIP = ips.IPPort{IP: net.IPv4(127, 0, 0, 1), Port: 9699}
 )
 
+func newBanlist(t *testing.T) banlist.Banlist {
+	bans, err := banlist.New(memdb.New())
+	require.NoError(t, err)
+	return bans
+}
+
 func TestNoInboundConnUpgradeThrottler(t *testing.T) {
 	{
 		throttler := NewInboundConnUpgradeThrottler(
@@ -30,6 +38,7 @@ func TestNoInboundConnUpgradeThrottler(t *testing.T) {
 				UpgradeCooldown:        0,
 				MaxRecentConnsUpgraded: 5,
 			},
+			newBanlist(t),
 		)
 		// throttler should allow all
 		for i := 0; i < 10; i++ {
@@ -44,6 +53,7 @@ func TestNoInboundConnUpgradeThrottler(t *testing.T) {
 				UpgradeCooldown:        time.Second,
 				MaxRecentConnsUpgraded: 0,
 			},
+			newBanlist(t),
 		)
 		// throttler should allow all
 		for i := 0; i < 10; i++ {
@@ -63,6 +73,7 @@ func TestInboundConnUpgradeThrottler(t *testing.T) {
 			UpgradeCooldown:        cooldown,
 			MaxRecentConnsUpgraded: 3,
 		},
+		newBanlist(t),
 	)
 
 	// Allow should always return true
@@ -105,3 +116,34 @@ func TestInboundConnUpgradeThrottler(t *testing.T) {
 		t.Fatal("should be done")
 	}
 }
+
+func TestInboundConnUpgradeThrottlerBannedIPs(t *testing.T) {
+	require := require.New(t)
+
+	bans := newBanlist(t)
+	hostIPs, err := banlist.ParseIPs("1.2.3.0/24")
+	require.NoError(err)
+	require.NoError(bans.BanIPs(hostIPs, time.Time{}))
+	loopbackIPs, err := banlist.ParseIPs(loopbackIP.IP.String())
+	require.NoError(err)
+	require.NoError(bans.BanIPs(loopbackIPs, time.Time{}))
+
+	configs := []InboundConnUpgradeThrottlerConfig{
+		{},
+		{
+			UpgradeCooldown:        time.Second,
+			MaxRecentConnsUpgraded: 3,
+		},
+	}
+	for _, config := range configs {
+		throttler := NewInboundConnUpgradeThrottler(logging.NoLog{}, config, bans)
+
+		// Banned IPs are never upgraded, even local ones
+		require.False(throttler.ShouldUpgrade(host1))
+		require.False(throttler.ShouldUpgrade(host4))
+		require.False(throttler.ShouldUpgrade(loopbackIP))
+
+		// IPs outside of the banned ranges are upgraded
+		require.True(throttler.ShouldUpgrade(ips.IPPort{IP: net.IPv4(1, 2, 4, 1), Port: 9696}))
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/time/rate"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+)
+
+const (
+	validatorLabel    = "validator"
+	nonValidatorLabel = "non_validator"
+)
+
+var (
+	_ InboundOpThrottler = (*inboundOpThrottler)(nil)
+
+	errUnlimitableOp  = errors.New("op can't be rate-limited")
+	errNegativeRefill = errors.New("refill rate must not be negative")
+	errZeroBurstSize  = errors.New("max burst size must be positive")
+)
+
+// InboundOpThrottler rate-limits the number of inbound messages of each op
+// that a peer can send. Validators and non-validators can be given different
+// limits.
+type InboundOpThrottler interface {
+	// Returns true if a message with [op] from [nodeID] should be handled.
+	// Messages that aren't allowed should be dropped.
+	// AddNode([nodeID]) must have been called since the last time
+	// RemoveNode([nodeID]) was called, if any.
+	// It's safe for multiple goroutines to concurrently call Allow.
+	Allow(nodeID ids.NodeID, op message.Op) bool
+
+	// Add a new node to this throttler.
+	// Must be called before Allow([nodeID], ...) is called.
+	// RemoveNode([nodeID]) must have been called since the last time
+	// AddNode([nodeID]) was called, if any.
+	AddNode(nodeID ids.NodeID)
+
+	// Remove a node from this throttler.
+	// AddNode([nodeID]) must have been called since the last time
+	// RemoveNode([nodeID]) was called, if any.
+	// Must be called when we stop reading messages from [nodeID].
+	RemoveNode(nodeID ids.NodeID)
+}
+
+// OpRateLimit is a token bucket, where each token is 1 message.
+// See https://pkg.go.dev/golang.org/x/time/rate#Limiter
+type OpRateLimit struct {
+	// Number of messages per second that a peer can send on average
+	RefillRate float64 `json:"refillRate"`
+	// Max number of messages that a peer can send at once
+	MaxBurstSize uint64 `json:"maxBurstSize"`
+}
+
+func (l OpRateLimit) verify() error {
+	switch {
+	case l.RefillRate < 0:
+		return errNegativeRefill
+	case l.MaxBurstSize == 0:
+		return errZeroBurstSize
+	default:
+		return nil
+	}
+}
+
+type InboundOpThrottlerConfig struct {
+	// Op --> Limit that each primary network validator is subject to.
+	// Messages whose op isn't limited are never throttled. Chits from
+	// validators are never throttled, even if a limit is given for them.
+	ValidatorLimits map[message.Op]OpRateLimit `json:"validatorLimits"`
+	// Op --> Limit that each peer that isn't a primary network validator is
+	// subject to. Messages whose op isn't limited are never throttled.
+	NonValidatorLimits map[message.Op]OpRateLimit `json:"nonValidatorLimits"`
+}
+
+func (c *InboundOpThrottlerConfig) verify() error {
+	// Only the ops of consensus messages can be rate-limited. Rate-limiting
+	// handshake messages could prevent connections from being established or
+	// kept alive.
+	limitableOps := set.NewSet[message.Op](len(message.ConsensusExternalOps))
+	limitableOps.Add(message.ConsensusExternalOps...)
+
+	for _, limits := range []map[message.Op]OpRateLimit{c.ValidatorLimits, c.NonValidatorLimits} {
+		for op, limit := range limits {
+			if !limitableOps.Contains(op) {
+				return fmt.Errorf("%w: %s", errUnlimitableOp, op)
+			}
+			if err := limit.verify(); err != nil {
+				return fmt.Errorf("invalid rate limit for %s: %w", op, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Returns a new InboundOpThrottler that applies [config]. Peers are subject
+// to the validator limits while they are in [vdrs].
+func NewInboundOpThrottler(
+	log logging.Logger,
+	namespace string,
+	registerer prometheus.Registerer,
+	vdrs validators.Set,
+	config InboundOpThrottlerConfig,
+) (InboundOpThrottler, error) {
+	if err := config.verify(); err != nil {
+		return nil, err
+	}
+
+	t := &inboundOpThrottler{
+		InboundOpThrottlerConfig: config,
+		log:                      log,
+		vdrs:                     vdrs,
+		limiters:                 make(map[ids.NodeID]*opLimiters),
+		refused: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "op_throttler_inbound_refused",
+				Help:      "Number of inbound messages dropped because the peer exceeded the rate limit of the message's op",
+			},
+			[]string{"op", "peer"},
+		),
+	}
+	return t, registerer.Register(t.refused)
+}
+
+// The token buckets of a peer
+type opLimiters struct {
+	validator    map[message.Op]*rate.Limiter
+	nonValidator map[message.Op]*rate.Limiter
+}
+
+type inboundOpThrottler struct {
+	InboundOpThrottlerConfig
+	log     logging.Logger
+	vdrs    validators.Set
+	refused *prometheus.CounterVec
+
+	lock sync.RWMutex
+	// Node ID --> Token buckets of the node
+	limiters map[ids.NodeID]*opLimiters
+}
+
+func newLimiters(limits map[message.Op]OpRateLimit) map[message.Op]*rate.Limiter {
+	limiters := make(map[message.Op]*rate.Limiter, len(limits))
+	for op, limit := range limits {
+		limiters[op] = rate.NewLimiter(rate.Limit(limit.RefillRate), int(limit.MaxBurstSize))
+	}
+	return limiters
+}
+
+// See InboundOpThrottler.
+func (t *inboundOpThrottler) Allow(nodeID ids.NodeID, op message.Op) bool {
+	t.lock.RLock()
+	nodeLimiters, ok := t.limiters[nodeID]
+	t.lock.RUnlock()
+	if !ok {
+		// This should never happen. If it is, the caller is misusing this struct.
+		t.log.Debug("tried to throttle op but the node isn't registered",
+			zap.Stringer("messageOp", op),
+			zap.Stringer("nodeID", nodeID),
+		)
+		return true
+	}
+
+	// A peer's validator status can change while it's connected, so the
+	// limits are chosen for each message.
+	limiters, peerLabel := nodeLimiters.nonValidator, nonValidatorLabel
+	if t.vdrs.Contains(nodeID) {
+		// Dropping a validator's chits could stall consensus.
+		if op == message.ChitsOp {
+			return true
+		}
+		limiters, peerLabel = nodeLimiters.validator, validatorLabel
+	}
+
+	limiter, ok := limiters[op]
+	if !ok || limiter.Allow() {
+		return true
+	}
+	t.refused.WithLabelValues(op.String(), peerLabel).Inc()
+	return false
+}
+
+// See InboundOpThrottler.
+func (t *inboundOpThrottler) AddNode(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.limiters[nodeID]; ok {
+		t.log.Debug("tried to add peer but it's already registered",
+			zap.Stringer("nodeID", nodeID),
+		)
+		return
+	}
+	t.limiters[nodeID] = &opLimiters{
+		validator:    newLimiters(t.ValidatorLimits),
+		nonValidator: newLimiters(t.NonValidatorLimits),
+	}
+}
+
+// See InboundOpThrottler.
+func (t *inboundOpThrottler) RemoveNode(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.limiters[nodeID]; !ok {
+		t.log.Debug("tried to remove peer but it isn't registered",
+			zap.Stringer("nodeID", nodeID),
+		)
+		return
+	}
+	delete(t.limiters, nodeID)
+}
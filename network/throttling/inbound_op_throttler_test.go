@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
+	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+)
+
+func TestInboundOpThrottler(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewSet()
+	vdrID := ids.GenerateTestNodeID()
+	require.NoError(vdrs.Add(vdrID, nil, ids.Empty, 1))
+	nonVdrID := ids.GenerateTestNodeID()
+
+	// The buckets never refill, so each peer can send exactly the burst size.
+	throttlerIntf, err := NewInboundOpThrottler(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		vdrs,
+		InboundOpThrottlerConfig{
+			ValidatorLimits: map[message.Op]OpRateLimit{
+				message.GetAncestorsOp: {
+					MaxBurstSize: 2,
+				},
+				message.ChitsOp: {
+					MaxBurstSize: 1,
+				},
+			},
+			NonValidatorLimits: map[message.Op]OpRateLimit{
+				message.GetAncestorsOp: {
+					MaxBurstSize: 1,
+				},
+				message.ChitsOp: {
+					MaxBurstSize: 1,
+				},
+			},
+		},
+	)
+	require.NoError(err)
+	throttler := throttlerIntf.(*inboundOpThrottler)
+
+	throttler.AddNode(vdrID)
+	throttler.AddNode(nonVdrID)
+	require.Len(throttler.limiters, 2)
+
+	require.True(throttler.Allow(vdrID, message.GetAncestorsOp))
+	require.True(throttler.Allow(vdrID, message.GetAncestorsOp))
+	require.False(throttler.Allow(vdrID, message.GetAncestorsOp))
+
+	require.True(throttler.Allow(nonVdrID, message.GetAncestorsOp))
+	require.False(throttler.Allow(nonVdrID, message.GetAncestorsOp))
+	require.True(throttler.Allow(nonVdrID, message.ChitsOp))
+	require.False(throttler.Allow(nonVdrID, message.ChitsOp))
+
+	// Ops without a limit are never throttled, and neither are chits from
+	// validators
+	for i := 0; i < 10; i++ {
+		require.True(throttler.Allow(vdrID, message.ChitsOp))
+		require.True(throttler.Allow(nonVdrID, message.PullQueryOp))
+	}
+
+	require.Equal(1.0, testutil.ToFloat64(throttler.refused.WithLabelValues(message.GetAncestorsOp.String(), validatorLabel)))
+	require.Equal(1.0, testutil.ToFloat64(throttler.refused.WithLabelValues(message.GetAncestorsOp.String(), nonValidatorLabel)))
+	require.Equal(1.0, testutil.ToFloat64(throttler.refused.WithLabelValues(message.ChitsOp.String(), nonValidatorLabel)))
+	require.Zero(testutil.ToFloat64(throttler.refused.WithLabelValues(message.ChitsOp.String(), validatorLabel)))
+
+	// A peer that becomes a validator is subject to the validator limits
+	require.NoError(vdrs.Add(nonVdrID, nil, ids.Empty, 1))
+	for i := 0; i < 10; i++ {
+		require.True(throttler.Allow(nonVdrID, message.ChitsOp))
+	}
+	require.True(throttler.Allow(nonVdrID, message.GetAncestorsOp))
+
+	// Removing a node resets its limits
+	throttler.RemoveNode(vdrID)
+	require.Len(throttler.limiters, 1)
+	throttler.AddNode(vdrID)
+	require.True(throttler.Allow(vdrID, message.GetAncestorsOp))
+}
+
+func TestInboundOpThrottlerInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      InboundOpThrottlerConfig
+		expectedErr error
+	}{
+		{
+			name: "handshake op",
+			config: InboundOpThrottlerConfig{
+				NonValidatorLimits: map[message.Op]OpRateLimit{
+					message.PingOp: {
+						RefillRate:   1,
+						MaxBurstSize: 1,
+					},
+				},
+			},
+			expectedErr: errUnlimitableOp,
+		},
+		{
+			name: "negative refill rate",
+			config: InboundOpThrottlerConfig{
+				ValidatorLimits: map[message.Op]OpRateLimit{
+					message.AppRequestOp: {
+						RefillRate:   -1,
+						MaxBurstSize: 1,
+					},
+				},
+			},
+			expectedErr: errNegativeRefill,
+		},
+		{
+			name: "zero burst size",
+			config: InboundOpThrottlerConfig{
+				ValidatorLimits: map[message.Op]OpRateLimit{
+					message.AppRequestOp: {
+						RefillRate: 1,
+					},
+				},
+			},
+			expectedErr: errZeroBurstSize,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewInboundOpThrottler(
+				logging.NoLog{},
+				"",
+				prometheus.NewRegistry(),
+				validators.NewSet(),
+				test.config,
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -7,9 +7,13 @@ import (
 	"context"
 
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/message"
 )
 
-var _ InboundMsgThrottler = (*noInboundMsgThrottler)(nil)
+var (
+	_ InboundMsgThrottler = (*noInboundMsgThrottler)(nil)
+	_ InboundOpThrottler  = (*noInboundOpThrottler)(nil)
+)
 
 // Returns an InboundMsgThrottler where Acquire() always returns immediately.
 func NewNoInboundThrottler() InboundMsgThrottler {
@@ -26,3 +30,19 @@ func (*noInboundMsgThrottler) Acquire(context.Context, uint64, ids.NodeID) Relea
 func (*noInboundMsgThrottler) AddNode(ids.NodeID) {}
 
 func (*noInboundMsgThrottler) RemoveNode(ids.NodeID) {}
+
+// Returns an InboundOpThrottler where Allow() always returns true.
+func NewNoInboundOpThrottler() InboundOpThrottler {
+	return &noInboundOpThrottler{}
+}
+
+// [Allow] always returns true.
+type noInboundOpThrottler struct{}
+
+func (*noInboundOpThrottler) Allow(ids.NodeID, message.Op) bool {
+	return true
+}
+
+func (*noInboundOpThrottler) AddNode(ids.NodeID) {}
+
+func (*noInboundOpThrottler) RemoveNode(ids.NodeID) {}
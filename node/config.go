@@ -132,6 +132,14 @@ type DatabaseConfig struct {
 
 	// Path to config file
 	Config []byte `json:"-"`
+
+	// Path to a database backup to restore from before the database is
+	// opened. Empty if the database shouldn't be restored.
+	RestoreFrom string `json:"restoreFrom"`
+
+	// Frequency to estimate the storage used by each chain and subsystem. 0
+	// disables storage usage tracking.
+	StorageUsageFrequency time.Duration `json:"storageUsageFrequency"`
 }
 
 // Config contains all of the configurations of an Avalanche node.
@@ -228,4 +236,8 @@ type Config struct {
 	// ChainDataDir is the root path for per-chain directories where VMs can
 	// write arbitrary data.
 	ChainDataDir string `json:"chainDataDir"`
+
+	// CaptureDir is the directory that captures of network messages are
+	// written to.
+	CaptureDir string `json:"captureDir"`
 }
@@ -32,19 +32,27 @@ import (
 	"github.com/VidarSolutions/avalanchego/api/server"
 	"github.com/VidarSolutions/avalanchego/chains"
 	"github.com/VidarSolutions/avalanchego/chains/atomic"
+	"github.com/VidarSolutions/avalanchego/chains/verify"
 	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/leveldb"
 	"github.com/VidarSolutions/avalanchego/database/manager"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/migrate"
+	"github.com/VidarSolutions/avalanchego/database/pebble"
 	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+	"github.com/VidarSolutions/avalanchego/database/usage"
 	"github.com/VidarSolutions/avalanchego/genesis"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/indexer"
 	"github.com/VidarSolutions/avalanchego/ipcs"
 	"github.com/VidarSolutions/avalanchego/message"
 	"github.com/VidarSolutions/avalanchego/network"
+	"github.com/VidarSolutions/avalanchego/network/banlist"
+	"github.com/VidarSolutions/avalanchego/network/capture"
 	"github.com/VidarSolutions/avalanchego/network/dialer"
 	"github.com/VidarSolutions/avalanchego/network/peer"
+	"github.com/VidarSolutions/avalanchego/network/peerlist"
+	"github.com/VidarSolutions/avalanchego/network/quic"
 	"github.com/VidarSolutions/avalanchego/network/throttling"
 	"github.com/VidarSolutions/avalanchego/snow"
 	"github.com/VidarSolutions/avalanchego/snow/engine/common"
@@ -83,11 +91,16 @@ import (
 	ipcsapi "github.com/VidarSolutions/avalanchego/api/ipcs"
 	avmconfig "github.com/VidarSolutions/avalanchego/vms/avm/config"
 	platformconfig "github.com/VidarSolutions/avalanchego/vms/platformvm/config"
+	platformstate "github.com/VidarSolutions/avalanchego/vms/platformvm/state"
 )
 
 var (
-	genesisHashKey  = []byte("genesisID")
-	indexerDBPrefix = []byte{0x00}
+	genesisHashKey       = []byte("genesisID")
+	indexerDBPrefix      = []byte{0x00}
+	sharedMemoryDBPrefix = []byte("shared memory")
+	keystoreDBPrefix     = []byte("keystore")
+	banlistDBPrefix      = []byte("banlist")
+	peerListDBPrefix     = []byte("peerList")
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
@@ -107,6 +120,10 @@ type Node struct {
 	DBManager manager.Manager
 	DB        database.Database
 
+	// Estimates the storage used by each chain and subsystem. Nil if storage
+	// usage tracking is disabled.
+	storageUsage *usage.Tracker
+
 	// Profiles the process. Nil if continuous profiling is disabled.
 	profiler profiler.ContinuousProfiler
 
@@ -125,6 +142,12 @@ type Node struct {
 	// Build and parse messages, for both network layer and chain manager
 	msgCreator message.Creator
 
+	// Nodes and IPs that connections aren't allowed with
+	banlist banlist.Banlist
+
+	// Records network messages while a capture is in progress
+	recorder capture.Recorder
+
 	// Manages creation of blockchains and routing messages to them
 	chainManager chains.Manager
 
@@ -324,6 +347,11 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 		GossipTracker: gossipTracker,
 	})
 
+	n.banlist, err = banlist.New(prefixdb.New(banlistDBPrefix, n.DB))
+	if err != nil {
+		return err
+	}
+
 	// add node configs to network config
 	n.Config.NetworkConfig.Namespace = n.networkNamespace
 	n.Config.NetworkConfig.MyNodeID = n.ID
@@ -340,6 +368,14 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
 	n.Config.NetworkConfig.GossipTracker = gossipTracker
+	n.Config.NetworkConfig.Banlist = n.banlist
+	n.Config.NetworkConfig.PeerList = peerlist.New(prefixdb.New(peerListDBPrefix, n.DB))
+	n.recorder = capture.NewRecorder(n.Log, n.Config.CaptureDir)
+	n.Config.NetworkConfig.Recorder = n.recorder
+
+	if n.Config.NetworkConfig.QUICEnabled {
+		n.Config.NetworkConfig.QUICDialer = quic.NewDialer(tlsConfig, n.Config.NetworkConfig.DialerConfig, n.Log, n.banlist)
+	}
 
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
@@ -347,7 +383,7 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 		n.MetricsRegisterer,
 		n.Log,
 		listener,
-		dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log),
+		dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log, n.banlist),
 		consensusRouter,
 	)
 
@@ -419,6 +455,12 @@ func (n *Node) Dispatch() error {
  */
 
 func (n *Node) initDatabase() error {
+	if n.Config.DatabaseConfig.RestoreFrom != "" {
+		if err := n.restoreDatabase(); err != nil {
+			return fmt.Errorf("couldn't restore database from %s: %w", n.Config.DatabaseConfig.RestoreFrom, err)
+		}
+	}
+
 	// start the db manager
 	var (
 		dbManager manager.Manager
@@ -429,12 +471,18 @@ func (n *Node) initDatabase() error {
 		dbManager, err = manager.NewLevelDB(n.Config.DatabaseConfig.Path, n.Config.DatabaseConfig.Config, n.Log, version.CurrentDatabase, "db_internal", n.MetricsRegisterer)
 	case memdb.Name:
 		dbManager = manager.NewMemDB(version.CurrentDatabase)
+	case pebble.Name:
+		// Pebble databases are kept in their own directory so that they are
+		// never opened as, or confused with, leveldb databases.
+		dbPath := filepath.Join(n.Config.DatabaseConfig.Path, pebble.Name)
+		dbManager, err = manager.NewPebbleDB(dbPath, n.Config.DatabaseConfig.Config, n.Log, version.CurrentDatabase, "db_internal", n.MetricsRegisterer)
 	default:
 		err = fmt.Errorf(
-			"db-type was %q but should have been one of {%s, %s}",
+			"db-type was %q but should have been one of {%s, %s, %s}",
 			n.Config.DatabaseConfig.Name,
 			leveldb.Name,
 			memdb.Name,
+			pebble.Name,
 		)
 	}
 	if err != nil {
@@ -480,6 +528,117 @@ func (n *Node) initDatabase() error {
 	return nil
 }
 
+// restoreDatabase verifies the database backup at [RestoreFrom] and copies it
+// into the node's database directory, which must be empty. The backup is
+// verified before anything is written so that a corrupt backup is reported
+// here, rather than when the chains are created. If the backup has already
+// been restored by a previous run, the database is left untouched.
+func (n *Node) restoreDatabase() error {
+	dbConfig := n.Config.DatabaseConfig
+	if dbConfig.Name == memdb.Name {
+		return fmt.Errorf("db-type %q can't be restored from a backup", memdb.Name)
+	}
+
+	src := migrate.DBConfig{
+		Name:   dbConfig.Name,
+		Path:   dbConfig.RestoreFrom,
+		Config: dbConfig.Config,
+	}
+	dst := migrate.DBConfig{
+		Name:   dbConfig.Name,
+		Path:   dbConfig.Path,
+		Config: dbConfig.Config,
+	}
+
+	createAVMTx, err := genesis.VMGenesis(n.Config.GenesisBytes, constants.AVMID)
+	if err != nil {
+		return err
+	}
+
+	restored, err := migrate.Restore(
+		n.Log,
+		src,
+		dst,
+		version.CurrentDatabase,
+		migrate.DefaultBatchSize,
+		func(db database.Database) error {
+			return n.verifyDatabaseBackup(db, createAVMTx.ID())
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !restored {
+		n.Log.Info("skipping database restore because the backup was already restored",
+			zap.String("backup", src.Dir()),
+			zap.String("path", dst.Dir()),
+		)
+	}
+	return nil
+}
+
+// verifyDatabaseBackup returns an error if [db] wasn't created from this
+// network's genesis or if it doesn't contain a usable P-chain and X-chain.
+func (n *Node) verifyDatabaseBackup(db database.Database, xChainID ids.ID) error {
+	rawGenesisHash, err := db.Get(genesisHashKey)
+	if err != nil {
+		return fmt.Errorf("couldn't read genesis hash: %w", err)
+	}
+	genesisHash, err := ids.ToID(rawGenesisHash)
+	if err != nil {
+		return err
+	}
+	expectedGenesisHash := ids.ID(hashing.ComputeHash256Array(n.Config.GenesisBytes))
+	if genesisHash != expectedGenesisHash {
+		return fmt.Errorf("backup contains invalid genesis hash. DB Genesis: %s Generated Genesis: %s", genesisHash, expectedGenesisHash)
+	}
+	return verify.PrimaryNetworkState(n.Log, db, xChainID)
+}
+
+// initStorageUsage starts estimating the storage used by the node's subsystems
+// and by every chain that the chain manager creates.
+//
+// Assumes [n.DB] and [n.chainManager] have been initialized.
+func (n *Node) initStorageUsage() error {
+	frequency := n.Config.DatabaseConfig.StorageUsageFrequency
+	if frequency <= 0 {
+		n.Log.Info("skipping storage usage tracking because it has been disabled")
+		return nil
+	}
+
+	var err error
+	n.storageUsage, err = usage.NewTracker(n.Log, frequency, "db_usage", n.MetricsRegisterer)
+	if err != nil {
+		return err
+	}
+
+	pChainVMDB := prefixdb.New(chains.VMDBPrefix, prefixdb.New(constants.PlatformChainID[:], n.DB))
+	n.storageUsage.Track("indexer", prefixdb.New(indexerDBPrefix, n.DB))
+	n.storageUsage.Track("keystore", prefixdb.New(keystoreDBPrefix, n.DB))
+	n.storageUsage.Track("sharedMemory", prefixdb.New(sharedMemoryDBPrefix, n.DB))
+	n.storageUsage.Track("banlist", prefixdb.New(banlistDBPrefix, n.DB))
+	n.storageUsage.Track("peerList", prefixdb.New(peerListDBPrefix, n.DB))
+	n.storageUsage.Track("uptime", platformstate.NewUptimeDB(pChainVMDB))
+	n.chainManager.AddRegistrant(&chainStorageUsage{
+		tracker: n.storageUsage,
+		db:      n.DB,
+	})
+
+	go n.Log.RecoverAndPanic(n.storageUsage.Dispatch)
+	return nil
+}
+
+// chainStorageUsage tracks the storage used by every chain that is created.
+type chainStorageUsage struct {
+	tracker *usage.Tracker
+	db      database.Database
+}
+
+func (c *chainStorageUsage) RegisterChain(chainName string, ctx *snow.ConsensusContext, _ common.VM) {
+	name := fmt.Sprintf("chain/%s", chainName)
+	c.tracker.Track(name, prefixdb.New(ctx.ChainID[:], c.db))
+}
+
 // Set the node IDs of the peers this node should first connect to
 func (n *Node) initBeacons() error {
 	n.beacons = validators.NewSet()
@@ -726,7 +885,7 @@ func (n *Node) initChainManager(VidarAssetID ids.ID) error {
 		Server:                                  n.APIServer,
 		Keystore:                                n.keystore,
 		AtomicMemory:                            n.sharedMemory,
-		VidarAssetID:                             VidarAssetID,
+		VidarAssetID:                            VidarAssetID,
 		XChainID:                                xChainID,
 		CChainID:                                cChainID,
 		CriticalChains:                          criticalChains,
@@ -857,7 +1016,7 @@ func (n *Node) initVMs() error {
 // initSharedMemory initializes the shared memory for cross chain interation
 func (n *Node) initSharedMemory() {
 	n.Log.Info("initializing SharedMemory")
-	sharedMemoryDB := prefixdb.New([]byte("shared memory"), n.DB)
+	sharedMemoryDB := prefixdb.New(sharedMemoryDBPrefix, n.DB)
 	n.sharedMemory = atomic.NewMemory(sharedMemoryDB)
 }
 
@@ -865,7 +1024,7 @@ func (n *Node) initSharedMemory() {
 // Assumes n.APIServer is already set
 func (n *Node) initKeystoreAPI() error {
 	n.Log.Info("initializing keystore")
-	keystoreDB := n.DBManager.NewPrefixDBManager([]byte("keystore"))
+	keystoreDB := n.DBManager.NewPrefixDBManager(keystoreDBPrefix)
 	n.keystore = keystore.New(n.Log, keystoreDB)
 	keystoreHandler, err := n.keystore.CreateHandler()
 	if err != nil {
@@ -941,6 +1100,12 @@ func (n *Node) initAdminAPI() error {
 			NodeConfig:   n.Config,
 			VMManager:    n.VMManager,
 			VMRegistry:   n.VMRegistry,
+			DBManager:    n.DBManager,
+			DBType:       n.Config.DatabaseConfig.Name,
+			DBConfig:     n.Config.DatabaseConfig.Config,
+			StorageUsage: n.storageUsage,
+			Banlist:      n.banlist,
+			Recorder:     n.recorder,
 		},
 	)
 	if err != nil {
@@ -1304,7 +1469,7 @@ func (n *Node) Initialize(
 	n.msgCreator, err = message.NewCreator(
 		n.MetricsRegisterer,
 		n.networkNamespace,
-		n.Config.NetworkConfig.CompressionEnabled,
+		n.Config.NetworkConfig.CompressionType,
 		n.Config.NetworkConfig.MaximumInboundMessageTimeout,
 	)
 	if err != nil {
@@ -1335,6 +1500,9 @@ func (n *Node) Initialize(
 	if err := n.initChainManager(n.Config.VidarAssetID); err != nil { // Set up the chain manager
 		return fmt.Errorf("couldn't initialize chain manager: %w", err)
 	}
+	if err := n.initStorageUsage(); err != nil {
+		return fmt.Errorf("couldn't initialize storage usage tracking: %w", err)
+	}
 	if err := n.initVMs(); err != nil { // Initialize the VM registry.
 		return fmt.Errorf("couldn't initialize VM registry: %w", err)
 	}
@@ -1416,6 +1584,9 @@ func (n *Node) shutdown() {
 	if n.chainManager != nil {
 		n.chainManager.Shutdown()
 	}
+	if n.storageUsage != nil {
+		n.storageUsage.Stop()
+	}
 	if n.profiler != nil {
 		n.profiler.Shutdown()
 	}
@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: builder/builder.proto
+
+package builder
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BuildBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the chain the block is built for
+	ChainId []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// ID of the inner block that the block will be built on
+	ParentId []byte `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Height of the inner block that will be built
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Unix timestamp, in seconds, of the block that will be built
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// P-chain height that the block will be verified against
+	PChainHeight uint64 `protobuf:"varint,5,opt,name=p_chain_height,json=pChainHeight,proto3" json:"p_chain_height,omitempty"`
+}
+
+func (x *BuildBlockRequest) Reset() {
+	*x = BuildBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_builder_builder_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildBlockRequest) ProtoMessage() {}
+
+func (x *BuildBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_builder_builder_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildBlockRequest.ProtoReflect.Descriptor instead.
+func (*BuildBlockRequest) Descriptor() ([]byte, []int) {
+	return file_builder_builder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuildBlockRequest) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BuildBlockRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BuildBlockRequest) GetPChainHeight() uint64 {
+	if x != nil {
+		return x.PChainHeight
+	}
+	return 0
+}
+
+type BuildBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If neither is set, the inner VM builds the block itself.
+	//
+	// Types that are assignable to Candidate:
+	//
+	//	*BuildBlockResponse_Block
+	//	*BuildBlockResponse_Txs
+	Candidate isBuildBlockResponse_Candidate `protobuf_oneof:"candidate"`
+}
+
+func (x *BuildBlockResponse) Reset() {
+	*x = BuildBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_builder_builder_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildBlockResponse) ProtoMessage() {}
+
+func (x *BuildBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_builder_builder_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildBlockResponse.ProtoReflect.Descriptor instead.
+func (*BuildBlockResponse) Descriptor() ([]byte, []int) {
+	return file_builder_builder_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *BuildBlockResponse) GetCandidate() isBuildBlockResponse_Candidate {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (x *BuildBlockResponse) GetBlock() []byte {
+	if x, ok := x.GetCandidate().(*BuildBlockResponse_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BuildBlockResponse) GetTxs() *Txs {
+	if x, ok := x.GetCandidate().(*BuildBlockResponse_Txs); ok {
+		return x.Txs
+	}
+	return nil
+}
+
+type isBuildBlockResponse_Candidate interface {
+	isBuildBlockResponse_Candidate()
+}
+
+type BuildBlockResponse_Block struct {
+	// Bytes of the inner block to propose
+	Block []byte `protobuf:"bytes,1,opt,name=block,proto3,oneof"`
+}
+
+type BuildBlockResponse_Txs struct {
+	// Transactions, in the order they should be included in the block
+	Txs *Txs `protobuf:"bytes,2,opt,name=txs,proto3,oneof"`
+}
+
+func (*BuildBlockResponse_Block) isBuildBlockResponse_Candidate() {}
+
+func (*BuildBlockResponse_Txs) isBuildBlockResponse_Candidate() {}
+
+type Txs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *Txs) Reset() {
+	*x = Txs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_builder_builder_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Txs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Txs) ProtoMessage() {}
+
+func (x *Txs) ProtoReflect() protoreflect.Message {
+	mi := &file_builder_builder_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Txs.ProtoReflect.Descriptor instead.
+func (*Txs) Descriptor() ([]byte, []int) {
+	return file_builder_builder_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Txs) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+var File_builder_builder_proto protoreflect.FileDescriptor
+
+var file_builder_builder_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x22, 0xa7, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5b, 0x0a, 0x12, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x2e,
+	0x54, 0x78, 0x73, 0x48, 0x00, 0x52, 0x03, 0x74, 0x78, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x17, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x32, 0x50, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x56, 0x69, 0x64, 0x61, 0x72, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_builder_builder_proto_rawDescOnce sync.Once
+	file_builder_builder_proto_rawDescData = file_builder_builder_proto_rawDesc
+)
+
+func file_builder_builder_proto_rawDescGZIP() []byte {
+	file_builder_builder_proto_rawDescOnce.Do(func() {
+		file_builder_builder_proto_rawDescData = protoimpl.X.CompressGZIP(file_builder_builder_proto_rawDescData)
+	})
+	return file_builder_builder_proto_rawDescData
+}
+
+var file_builder_builder_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_builder_builder_proto_goTypes = []interface{}{
+	(*BuildBlockRequest)(nil),  // 0: builder.BuildBlockRequest
+	(*BuildBlockResponse)(nil), // 1: builder.BuildBlockResponse
+	(*Txs)(nil),                // 2: builder.Txs
+}
+var file_builder_builder_proto_depIdxs = []int32{
+	2, // 0: builder.BuildBlockResponse.txs:type_name -> builder.Txs
+	0, // 1: builder.Builder.BuildBlock:input_type -> builder.BuildBlockRequest
+	1, // 2: builder.Builder.BuildBlock:output_type -> builder.BuildBlockResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_builder_builder_proto_init() }
+func file_builder_builder_proto_init() {
+	if File_builder_builder_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_builder_builder_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_builder_builder_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_builder_builder_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Txs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_builder_builder_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*BuildBlockResponse_Block)(nil),
+		(*BuildBlockResponse_Txs)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_builder_builder_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_builder_builder_proto_goTypes,
+		DependencyIndexes: file_builder_builder_proto_depIdxs,
+		MessageInfos:      file_builder_builder_proto_msgTypes,
+	}.Build()
+	File_builder_builder_proto = out.File
+	file_builder_builder_proto_rawDesc = nil
+	file_builder_builder_proto_goTypes = nil
+	file_builder_builder_proto_depIdxs = nil
+}
@@ -94,6 +94,10 @@ type VMClient interface {
 	BlockReject(ctx context.Context, in *BlockRejectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(ctx context.Context, in *StateSummaryAcceptRequest, opts ...grpc.CallOption) (*StateSummaryAcceptResponse, error)
+	// BuildBlockWithTxsChainVM
+	//
+	// Attempt to create a new block that includes the given txs, in order.
+	BuildBlockWithTxs(ctx context.Context, in *BuildBlockWithTxsRequest, opts ...grpc.CallOption) (*BuildBlockWithTxsResponse, error)
 }
 
 type vMClient struct {
@@ -410,6 +414,15 @@ func (c *vMClient) StateSummaryAccept(ctx context.Context, in *StateSummaryAccep
 	return out, nil
 }
 
+func (c *vMClient) BuildBlockWithTxs(ctx context.Context, in *BuildBlockWithTxsRequest, opts ...grpc.CallOption) (*BuildBlockWithTxsResponse, error) {
+	out := new(BuildBlockWithTxsResponse)
+	err := c.cc.Invoke(ctx, "/vm.VM/BuildBlockWithTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VMServer is the server API for VM service.
 // All implementations must embed UnimplementedVMServer
 // for forward compatibility
@@ -485,6 +498,10 @@ type VMServer interface {
 	BlockReject(context.Context, *BlockRejectRequest) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error)
+	// BuildBlockWithTxsChainVM
+	//
+	// Attempt to create a new block that includes the given txs, in order.
+	BuildBlockWithTxs(context.Context, *BuildBlockWithTxsRequest) (*BuildBlockWithTxsResponse, error)
 	mustEmbedUnimplementedVMServer()
 }
 
@@ -594,6 +611,9 @@ func (UnimplementedVMServer) BlockReject(context.Context, *BlockRejectRequest) (
 func (UnimplementedVMServer) StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateSummaryAccept not implemented")
 }
+func (UnimplementedVMServer) BuildBlockWithTxs(context.Context, *BuildBlockWithTxsRequest) (*BuildBlockWithTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildBlockWithTxs not implemented")
+}
 func (UnimplementedVMServer) mustEmbedUnimplementedVMServer() {}
 
 // UnsafeVMServer may be embedded to opt out of forward compatibility for this service.
@@ -1219,6 +1239,24 @@ func _VM_StateSummaryAccept_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VM_BuildBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildBlockWithTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).BuildBlockWithTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vm.VM/BuildBlockWithTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).BuildBlockWithTxs(ctx, req.(*BuildBlockWithTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VM_ServiceDesc is the grpc.ServiceDesc for VM service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1362,6 +1400,10 @@ var VM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StateSummaryAccept",
 			Handler:    _VM_StateSummaryAccept_Handler,
 		},
+		{
+			MethodName: "BuildBlockWithTxs",
+			Handler:    _VM_BuildBlockWithTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vm/vm.proto",
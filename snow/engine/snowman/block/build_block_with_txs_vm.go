@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+
+	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
+)
+
+var ErrBuildBlockWithTxsNotImplemented = errors.New("vm does not implement BuildBlockWithTxsChainVM interface")
+
+// BuildBlockWithTxsChainVM defines the interface a ChainVM can optionally
+// implement to build blocks whose transactions are chosen and ordered by an
+// external block builder.
+type BuildBlockWithTxsChainVM interface {
+	// Attempt to build a new block that includes [txs], in the given order,
+	// given that the P-Chain height is [blockCtx.PChainHeight].
+	//
+	// Each element of [txs] is a transaction in the VM's own format. An error
+	// should be returned if any of [txs] is invalid.
+	//
+	// This method will be called only if the proposervm is activated and has
+	// been configured with an external block builder.
+	BuildBlockWithTxs(ctx context.Context, blockCtx *Context, txs [][]byte) (snowman.Block, error)
+}
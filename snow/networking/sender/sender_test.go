@@ -28,6 +28,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/networking/tracker"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
 	"github.com/VidarSolutions/avalanchego/subnets"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/math/meter"
 	"github.com/VidarSolutions/avalanchego/utils/resource"
@@ -72,7 +73,7 @@ func TestTimeout(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -343,7 +344,7 @@ func TestReliableMessages(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -490,7 +491,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 	mc, err := message.NewCreator(
 		metrics,
 		"dummyNamespace",
-		true,
+		compression.TypeGzip,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -13,7 +13,10 @@ import (
 	"github.com/VidarSolutions/avalanchego/utils/set"
 )
 
-var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+var (
+	errAllowedNodesWhenNotValidatorOnly   = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+	errInvalidProposerBlockBuilderTimeout = errors.New("proposerBlockBuilderTimeout must be positive when proposerBlockBuilderAddress is set")
+)
 
 type GossipConfig struct {
 	AcceptedFrontierValidatorSize    uint `json:"gossipAcceptedFrontierValidatorSize" yaml:"gossipAcceptedFrontierValidatorSize"`
@@ -45,6 +48,16 @@ type Config struct {
 	// TODO: Remove this flag once all VMs throttle their own block production.
 	ProposerMinBlockDelay time.Duration `json:"proposerMinBlockDelay" yaml:"proposerMinBlockDelay"`
 
+	// ProposerBlockBuilderAddress is the address of a local gRPC server that
+	// implements the Builder service of proto/builder. If set, the proposervm
+	// asks it for the content of each block this node builds. If empty, the
+	// VM builds blocks itself.
+	ProposerBlockBuilderAddress string `json:"proposerBlockBuilderAddress" yaml:"proposerBlockBuilderAddress"`
+	// ProposerBlockBuilderTimeout is how long the proposervm waits for the
+	// block builder at [ProposerBlockBuilderAddress] before the VM builds the
+	// block itself.
+	ProposerBlockBuilderTimeout time.Duration `json:"proposerBlockBuilderTimeout" yaml:"proposerBlockBuilderTimeout"`
+
 	// See comment on [MinPercentConnectedStakeHealthy] in platformvm.Config
 	MinPercentConnectedStakeHealthy float64 `json:"minPercentConnectedStakeHealthy" yaml:"minPercentConnectedStakeHealthy"`
 }
@@ -56,5 +69,8 @@ func (c *Config) Valid() error {
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	if c.ProposerBlockBuilderAddress != "" && c.ProposerBlockBuilderTimeout <= 0 {
+		return errInvalidProposerBlockBuilderTimeout
+	}
 	return nil
 }
@@ -56,6 +56,14 @@ func TestValid(t *testing.T) {
 			},
 			err: errAllowedNodesWhenNotValidatorOnly.Error(),
 		},
+		{
+			name: "block builder without timeout",
+			s: Config{
+				ConsensusParameters:         validParameters,
+				ProposerBlockBuilderAddress: "127.0.0.1:9655",
+			},
+			err: errInvalidProposerBlockBuilderTimeout.Error(),
+		},
 		{
 			name: "valid",
 			s: Config{
@@ -3,6 +3,8 @@
 
 package compression
 
+import "fmt"
+
 // Compressor compresss and decompresses messages.
 // Decompress is the inverse of Compress.
 // Decompress(Compress(msg)) == msg.
@@ -10,3 +12,17 @@ type Compressor interface {
 	Compress([]byte) ([]byte, error)
 	Decompress([]byte) ([]byte, error)
 }
+
+// NewCompressor returns a new Compressor of type [compressionType].
+func NewCompressor(compressionType Type, maxSize int64) (Compressor, error) {
+	switch compressionType {
+	case TypeNone:
+		return NewNoCompressor(), nil
+	case TypeGzip:
+		return NewGzipCompressor(maxSize)
+	case TypeZstd:
+		return NewZstdCompressor(maxSize)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownCompressionType, compressionType)
+	}
+}
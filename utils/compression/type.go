@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errUnknownCompressionType = errors.New("unknown compression type")
+
+// Type is the type of a compression algorithm.
+type Type byte
+
+const (
+	TypeNone Type = iota + 1
+	TypeGzip
+	TypeZstd
+)
+
+// Types are all the supported compression types.
+var Types = []Type{TypeNone, TypeGzip, TypeZstd}
+
+// TypeFromString returns the compression type with the name [s].
+func TypeFromString(s string) (Type, error) {
+	switch s {
+	case TypeNone.String():
+		return TypeNone, nil
+	case TypeGzip.String():
+		return TypeGzip, nil
+	case TypeZstd.String():
+		return TypeZstd, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errUnknownCompressionType, s)
+	}
+}
+
+func (t Type) String() string {
+	switch t {
+	case TypeNone:
+		return "none"
+	case TypeGzip:
+		return "gzip"
+	case TypeZstd:
+		return "zstd"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid returns true iff [t] is a supported compression type.
+func (t Type) Valid() bool {
+	return t >= TypeNone && t <= TypeZstd
+}
+
+func (t Type) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", t)), nil
+}
+
+func (t *Type) UnmarshalJSON(b []byte) error {
+	parsed, err := TypeFromString(strings.Trim(string(b), `"`))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/utils/units"
+)
+
+func TestZstdCompressDecompress(t *testing.T) {
+	require := require.New(t)
+
+	data := make([]byte, 4096)
+	for i := 0; i < len(data); i++ {
+		data[i] = byte(rand.Intn(256)) // #nosec G404
+	}
+
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	require.NoError(err)
+
+	dataCompressed, err := compressor.Compress(data)
+	require.NoError(err)
+
+	dataDecompressed, err := compressor.Decompress(dataCompressed)
+	require.NoError(err)
+	require.Equal(data, dataDecompressed)
+
+	// Decompressing the same bytes again gives the same result.
+	dataDecompressed, err = compressor.Decompress(dataCompressed)
+	require.NoError(err)
+	require.Equal(data, dataDecompressed)
+
+	nonZstdData := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	_, err = compressor.Decompress(nonZstdData)
+	require.Error(err)
+}
+
+func TestZstdSizeLimiting(t *testing.T) {
+	require := require.New(t)
+
+	data := make([]byte, 3*units.MiB)
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	require.NoError(err)
+
+	_, err = compressor.Compress(data) // should be too large
+	require.Error(err)
+
+	compressor2, err := NewZstdCompressor(4 * units.MiB)
+	require.NoError(err)
+
+	dataCompressed, err := compressor2.Compress(data)
+	require.NoError(err)
+
+	_, err = compressor.Decompress(dataCompressed) // should be too large
+	require.Error(err)
+}
+
+func TestNewZstdCompressorWithInvalidLimit(t *testing.T) {
+	require := require.New(t)
+	_, err := NewZstdCompressor(math.MaxInt64)
+	require.ErrorIs(err, ErrInvalidMaxSizeZstdCompressor)
+}
+
+func TestNewCompressor(t *testing.T) {
+	require := require.New(t)
+
+	data := []byte{1, 2, 3}
+	for _, compressionType := range Types {
+		compressor, err := NewCompressor(compressionType, units.MiB)
+		require.NoError(err)
+
+		compressed, err := compressor.Compress(data)
+		require.NoError(err)
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(err)
+		require.Equal(data, decompressed)
+	}
+
+	_, err := NewCompressor(0, units.MiB)
+	require.ErrorIs(err, errUnknownCompressionType)
+}
+
+func TestTypeFromString(t *testing.T) {
+	require := require.New(t)
+
+	for _, compressionType := range Types {
+		require.True(compressionType.Valid())
+
+		parsed, err := TypeFromString(compressionType.String())
+		require.NoError(err)
+		require.Equal(compressionType, parsed)
+
+		jsonBytes, err := compressionType.MarshalJSON()
+		require.NoError(err)
+		var unmarshalled Type
+		require.NoError(unmarshalled.UnmarshalJSON(jsonBytes))
+		require.Equal(compressionType, unmarshalled)
+	}
+
+	_, err := TypeFromString("snappy")
+	require.ErrorIs(err, errUnknownCompressionType)
+	require.False(Type(0).Valid())
+}
+
+func FuzzZstdCompressor(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		require := require.New(t)
+
+		if len(data) > 2*units.MiB {
+			t.SkipNow()
+		}
+
+		compressor, err := NewZstdCompressor(2 * units.MiB)
+		require.NoError(err)
+
+		compressed, err := compressor.Compress(data)
+		require.NoError(err)
+
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(err)
+
+		require.Equal(data, decompressed)
+	})
+}
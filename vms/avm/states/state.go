@@ -4,6 +4,7 @@
 package states
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -41,6 +42,12 @@ var (
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
 
+	// ErrNotLinearized is returned when the chain doesn't have a last
+	// accepted block because it hasn't been linearized yet.
+	ErrNotLinearized = errors.New("chain has not been linearized")
+
+	errUnexpectedBlockID = errors.New("unexpected block ID")
+
 	_ State = (*state)(nil)
 )
 
@@ -576,3 +583,31 @@ func (s *state) writeStatuses() error {
 	}
 	return nil
 }
+
+// GetLastAcceptedBlock reads the last accepted block from [db] without
+// initializing the rest of the state. [db] must be the database that the state
+// was created with. If the chain hasn't been linearized yet, ErrNotLinearized
+// is returned.
+func GetLastAcceptedBlock(db database.Database, parser blocks.Parser) (blocks.Block, error) {
+	lastAccepted, err := database.GetID(prefixdb.New(singletonPrefix, db), lastAcceptedKey)
+	if err == database.ErrNotFound {
+		return nil, ErrNotLinearized
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last accepted block ID: %w", err)
+	}
+
+	blkBytes, err := prefixdb.New(blockPrefix, db).Get(lastAccepted[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last accepted block %s: %w", lastAccepted, err)
+	}
+
+	blk, err := parser.ParseBlock(blkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse last accepted block %s: %w", lastAccepted, err)
+	}
+	if blkID := blk.ID(); blkID != lastAccepted {
+		return nil, fmt.Errorf("%w: expected last accepted block %s but got %s", errUnexpectedBlockID, lastAccepted, blkID)
+	}
+	return blk, nil
+}
@@ -312,3 +312,22 @@ func TestInitializeChainState(t *testing.T) {
 	require.NoError(err)
 	require.Equal(genesis.ID(), lastAccepted.Parent())
 }
+
+func TestGetLastAcceptedBlock(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry())
+	require.NoError(err)
+
+	_, err = GetLastAcceptedBlock(db, parser)
+	require.ErrorIs(err, ErrNotLinearized)
+
+	require.NoError(s.InitializeChainState(ids.GenerateTestID(), version.CortinaDefaultTime))
+	require.NoError(s.Commit())
+
+	blk, err := GetLastAcceptedBlock(db, parser)
+	require.NoError(err)
+	require.Equal(s.GetLastAccepted(), blk.ID())
+}
@@ -36,6 +36,10 @@ type State struct {
 	// If nil, [BuildBlockWithContext] returns [BuildBlock].
 	buildBlockWithContext func(context.Context, *block.Context) (snowman.Block, error)
 
+	// If nil, [BuildBlockWithTxs] returns
+	// [block.ErrBuildBlockWithTxsNotImplemented].
+	buildBlockWithTxs func(context.Context, *block.Context, [][]byte) (snowman.Block, error)
+
 	// getStatus returns the status of the block
 	getStatus func(context.Context, snowman.Block) (choices.Status, error)
 
@@ -65,6 +69,7 @@ type Config struct {
 	BatchedUnmarshalBlock func(context.Context, [][]byte) ([]snowman.Block, error)
 	BuildBlock            func(context.Context) (snowman.Block, error)
 	BuildBlockWithContext func(context.Context, *block.Context) (snowman.Block, error)
+	BuildBlockWithTxs     func(context.Context, *block.Context, [][]byte) (snowman.Block, error)
 	GetBlockIDAtHeight    func(context.Context, uint64) (ids.ID, error)
 }
 
@@ -119,6 +124,7 @@ func (s *State) initialize(config *Config) {
 	s.getBlock = config.GetBlock
 	s.buildBlock = config.BuildBlock
 	s.buildBlockWithContext = config.BuildBlockWithContext
+	s.buildBlockWithTxs = config.BuildBlockWithTxs
 	s.unmarshalBlock = config.UnmarshalBlock
 	s.batchedUnmarshalBlock = config.BatchedUnmarshalBlock
 	if config.GetBlockIDAtHeight == nil {
@@ -417,6 +423,23 @@ func (s *State) BuildBlockWithContext(ctx context.Context, blockCtx *block.Conte
 	return s.deduplicate(ctx, blk)
 }
 
+// BuildBlockWithTxs attempts to build a new internal Block that includes
+// [txs], wraps it, and adds it to the appropriate caching layer if successful.
+// If [s.buildBlockWithTxs] is nil, returns
+// [block.ErrBuildBlockWithTxsNotImplemented].
+func (s *State) BuildBlockWithTxs(ctx context.Context, blockCtx *block.Context, txs [][]byte) (snowman.Block, error) {
+	if s.buildBlockWithTxs == nil {
+		return nil, block.ErrBuildBlockWithTxsNotImplemented
+	}
+
+	blk, err := s.buildBlockWithTxs(ctx, blockCtx, txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deduplicate(ctx, blk)
+}
+
 // BuildBlock attempts to build a new internal Block, wraps it, and adds it
 // to the appropriate caching layer if successful.
 func (s *State) BuildBlock(ctx context.Context) (snowman.Block, error) {
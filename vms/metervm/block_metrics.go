@@ -30,6 +30,9 @@ type blockMetrics struct {
 	// Block building with context metrics
 	buildBlockWithContext,
 	buildBlockWithContextErr,
+	// Block building with txs metrics
+	buildBlockWithTxs,
+	buildBlockWithTxsErr,
 	// Batched metrics
 	getAncestors,
 	batchedParseBlock,
@@ -51,6 +54,7 @@ func (m *blockMetrics) Initialize(
 	supportsBatchedFetching bool,
 	supportsHeightIndexing bool,
 	supportsStateSync bool,
+	supportsBlockBuildingWithTxs bool,
 	namespace string,
 	reg prometheus.Registerer,
 ) error {
@@ -92,5 +96,9 @@ func (m *blockMetrics) Initialize(
 		m.getStateSummary = newAverager(namespace, "get_state_summary", reg, &errs)
 		m.getStateSummaryErr = newAverager(namespace, "get_state_summary_err", reg, &errs)
 	}
+	if supportsBlockBuildingWithTxs {
+		m.buildBlockWithTxs = newAverager(namespace, "build_block_with_txs", reg, &errs)
+		m.buildBlockWithTxsErr = newAverager(namespace, "build_block_with_txs_err", reg, &errs)
+	}
 	return errs.Err
 }
@@ -24,6 +24,7 @@ var (
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.HeightIndexedChainVM         = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.BuildBlockWithTxsChainVM     = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -32,6 +33,7 @@ type blockVM struct {
 	batchedVM    block.BatchedChainVM
 	hVM          block.HeightIndexedChainVM
 	ssVM         block.StateSyncableVM
+	txsVM        block.BuildBlockWithTxsChainVM
 
 	blockMetrics
 	clock mockable.Clock
@@ -42,12 +44,14 @@ func NewBlockVM(vm block.ChainVM) block.ChainVM {
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	hVM, _ := vm.(block.HeightIndexedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	txsVM, _ := vm.(block.BuildBlockWithTxsChainVM)
 	return &blockVM{
 		ChainVM:      vm,
 		buildBlockVM: buildBlockVM,
 		batchedVM:    batchedVM,
 		hVM:          hVM,
 		ssVM:         ssVM,
+		txsVM:        txsVM,
 	}
 }
 
@@ -68,6 +72,7 @@ func (vm *blockVM) Initialize(
 		vm.batchedVM != nil,
 		vm.hVM != nil,
 		vm.ssVM != nil,
+		vm.txsVM != nil,
 		"",
 		registerer,
 	)
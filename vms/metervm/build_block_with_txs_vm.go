@@ -0,0 +1,31 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metervm
+
+import (
+	"context"
+
+	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
+	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
+)
+
+func (vm *blockVM) BuildBlockWithTxs(ctx context.Context, blockCtx *block.Context, txs [][]byte) (snowman.Block, error) {
+	if vm.txsVM == nil {
+		return nil, block.ErrBuildBlockWithTxsNotImplemented
+	}
+
+	start := vm.clock.Time()
+	blk, err := vm.txsVM.BuildBlockWithTxs(ctx, blockCtx, txs)
+	end := vm.clock.Time()
+	duration := float64(end.Sub(start))
+	if err != nil {
+		vm.blockMetrics.buildBlockWithTxsErr.Observe(duration)
+		return nil, err
+	}
+	vm.blockMetrics.buildBlockWithTxs.Observe(duration)
+	return &meterBlock{
+		Block: blk,
+		vm:    vm,
+	}, nil
+}
@@ -55,6 +55,8 @@ var (
 	errMissingValidatorSet          = errors.New("missing validator set")
 	errValidatorSetAlreadyPopulated = errors.New("validator set already populated")
 	errDuplicateValidatorSet        = errors.New("duplicate validator set")
+	errUnexpectedBlockStatus        = errors.New("unexpected block status")
+	errUnexpectedBlockID            = errors.New("unexpected block ID")
 
 	blockPrefix                   = []byte("block")
 	validatorsPrefix              = []byte("validators")
@@ -1955,3 +1957,46 @@ func (s *state) writeMetadata() error {
 	}
 	return nil
 }
+
+// GetLastAcceptedBlock reads the last accepted block from [db] without
+// initializing the rest of the state. [db] must be the database that the state
+// was created with.
+func GetLastAcceptedBlock(db database.Database) (blocks.Block, error) {
+	lastAccepted, err := database.GetID(prefixdb.New(singletonPrefix, db), lastAcceptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last accepted block ID: %w", err)
+	}
+
+	blkBytes, err := prefixdb.New(blockPrefix, db).Get(lastAccepted[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last accepted block %s: %w", lastAccepted, err)
+	}
+
+	blkState := stateBlk{}
+	if _, err := blocks.GenesisCodec.Unmarshal(blkBytes, &blkState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal last accepted block %s: %w", lastAccepted, err)
+	}
+	if blkState.Status != choices.Accepted {
+		return nil, fmt.Errorf("%w: last accepted block %s has status %s", errUnexpectedBlockStatus, lastAccepted, blkState.Status)
+	}
+
+	blk, err := blocks.Parse(blocks.GenesisCodec, blkState.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse last accepted block %s: %w", lastAccepted, err)
+	}
+	if blkID := blk.ID(); blkID != lastAccepted {
+		return nil, fmt.Errorf("%w: expected last accepted block %s but got %s", errUnexpectedBlockID, lastAccepted, blkID)
+	}
+	return blk, nil
+}
+
+// NewUptimeDB returns the database that the uptimes of the primary network
+// validators are stored in. [db] must be the database that the state was
+// created with. Writing to the returned database corrupts the state.
+func NewUptimeDB(db database.Database) database.Database {
+	// The state wraps [db] with a versiondb, which prevents the prefixes from
+	// being compressed into the prefix of [db].
+	validatorsDB := prefixdb.NewNested(validatorsPrefix, db)
+	currentValidatorsDB := prefixdb.New(currentPrefix, validatorsDB)
+	return prefixdb.New(validatorPrefix, currentValidatorsDB)
+}
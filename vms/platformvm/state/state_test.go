@@ -14,6 +14,7 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/snow"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
@@ -834,3 +835,54 @@ func TestStateAddRemoveValidator(t *testing.T) {
 		require.Equal(diff.expectedPublicKeyDiff, gotPublicKeyDiffs)
 	}
 }
+
+func TestGetLastAcceptedBlock(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newUninitializedState(require)
+	_, err := GetLastAcceptedBlock(db)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s, db = newInitializedState(require)
+	require.NoError(s.Commit())
+
+	blk, err := GetLastAcceptedBlock(db)
+	require.NoError(err)
+	require.Equal(s.GetLastAccepted(), blk.ID())
+}
+
+func TestNewUptimeDB(t *testing.T) {
+	require := require.New(t)
+
+	// The state must not compress its prefixes into the prefix of [db].
+	db := prefixdb.New([]byte("vm"), memdb.New())
+	s := newStateFromDB(require, db)
+	require.Zero(countKeys(require, NewUptimeDB(db)))
+
+	staker, err := NewCurrentStaker(initialTxID, &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: initialNodeID,
+			Start:  uint64(initialTime.Unix()),
+			End:    uint64(initialValidatorEndTime.Unix()),
+			Wght:   units.Vidar,
+		},
+	}, 0)
+	require.NoError(err)
+	s.PutCurrentValidator(staker)
+	require.NoError(s.Commit())
+
+	// The validators are stored in a linked list, which also stores its head.
+	require.Equal(2, countKeys(require, NewUptimeDB(db)))
+}
+
+func countKeys(require *require.Assertions, db database.Iteratee) int {
+	it := db.NewIterator()
+	defer it.Release()
+
+	numKeys := 0
+	for it.Next() {
+		numKeys++
+	}
+	require.NoError(it.Error())
+	return numKeys
+}
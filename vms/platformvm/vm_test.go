@@ -40,6 +40,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/uptime"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
 	"github.com/VidarSolutions/avalanchego/subnets"
+	"github.com/VidarSolutions/avalanchego/utils/compression"
 	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/crypto/bls"
 	"github.com/VidarSolutions/avalanchego/utils/crypto/secp256k1"
@@ -1721,7 +1722,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 	chainRouter := &router.ChainRouter{}
 
 	metrics := prometheus.NewRegistry()
-	mc, err := message.NewCreator(metrics, "dummyNamespace", true, 10*time.Second)
+	mc, err := message.NewCreator(metrics, "dummyNamespace", compression.TypeGzip, 10*time.Second)
 	require.NoError(err)
 
 	err = chainRouter.Initialize(
@@ -986,6 +986,7 @@ func initTestRemoteProposerVM(
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	valState := &validators.TestState{
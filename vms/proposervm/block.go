@@ -16,6 +16,7 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/choices"
 	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/block"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/builder"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
 
 	smblock "github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
@@ -37,6 +38,10 @@ var (
 	errProposerWindowNotStarted = errors.New("proposer window hasn't started")
 	errProposersNotActivated    = errors.New("proposers haven't been activated yet")
 	errPChainHeightTooLow       = errors.New("block P-chain height is too low")
+
+	errTxOrderingNotSupported      = errors.New("inner VM doesn't support building blocks with given txs")
+	errExternalBlockParentMismatch = errors.New("external builder proposed a block with the wrong parent")
+	errExternalBlockHeightMismatch = errors.New("external builder proposed a block with the wrong height")
 )
 
 type Block interface {
@@ -221,14 +226,7 @@ func (p *postForkCommonComponents) buildChild(
 		}
 	}
 
-	var innerBlock snowman.Block
-	if p.vm.blockBuilderVM != nil {
-		innerBlock, err = p.vm.blockBuilderVM.BuildBlockWithContext(ctx, &smblock.Context{
-			PChainHeight: parentPChainHeight,
-		})
-	} else {
-		innerBlock, err = p.vm.ChainVM.BuildBlock(ctx)
-	}
+	innerBlock, err := p.buildInnerChild(ctx, newTimestamp, parentPChainHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +274,95 @@ func (p *postForkCommonComponents) buildChild(
 	return child, nil
 }
 
+// buildInnerChild builds the inner block of a child of this block. If an
+// external builder is configured, the inner block is built from its candidate.
+// The inner VM builds the block itself if the external builder fails, times
+// out, or doesn't propose a candidate.
+func (p *postForkCommonComponents) buildInnerChild(
+	ctx context.Context,
+	timestamp time.Time,
+	parentPChainHeight uint64,
+) (snowman.Block, error) {
+	blockCtx := &smblock.Context{
+		PChainHeight: parentPChainHeight,
+	}
+	if p.vm.externalBuilder != nil {
+		innerBlock, err := p.buildExternalInnerChild(ctx, timestamp, blockCtx)
+		if err != nil {
+			p.vm.ctx.Log.Warn("falling back to building block with the inner VM",
+				zap.Stringer("parentID", p.innerBlk.ID()),
+				zap.Error(err),
+			)
+		}
+		if innerBlock != nil {
+			return innerBlock, nil
+		}
+	}
+
+	if p.vm.blockBuilderVM != nil {
+		return p.vm.blockBuilderVM.BuildBlockWithContext(ctx, blockCtx)
+	}
+	return p.vm.ChainVM.BuildBlock(ctx)
+}
+
+// buildExternalInnerChild builds the inner block of a child of this block from
+// the candidate of the external builder. Returns nil if the external builder
+// doesn't propose a candidate.
+func (p *postForkCommonComponents) buildExternalInnerChild(
+	ctx context.Context,
+	timestamp time.Time,
+	blockCtx *smblock.Context,
+) (snowman.Block, error) {
+	parentID := p.innerBlk.ID()
+	height := p.innerBlk.Height() + 1
+	candidate, err := p.vm.externalBuilder.BuildBlock(ctx, &builder.Request{
+		ChainID:      p.vm.ctx.ChainID,
+		ParentID:     parentID,
+		Height:       height,
+		Timestamp:    timestamp,
+		PChainHeight: blockCtx.PChainHeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("external builder failed: %w", err)
+	}
+
+	var innerBlock snowman.Block
+	switch {
+	case candidate.Block != nil:
+		innerBlock, err = p.vm.ChainVM.ParseBlock(ctx, candidate.Block)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse block proposed by external builder: %w", err)
+		}
+	case candidate.Txs != nil:
+		if p.vm.txsBuilderVM == nil {
+			return nil, errTxOrderingNotSupported
+		}
+		innerBlock, err = p.vm.txsBuilderVM.BuildBlockWithTxs(ctx, blockCtx, candidate.Txs)
+		if errors.Is(err, smblock.ErrBuildBlockWithTxsNotImplemented) {
+			// The inner VM may be wrapped, for example by the rpcchainvm,
+			// in which case the wrapper implements the interface even if the
+			// underlying VM doesn't.
+			return nil, errTxOrderingNotSupported
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't build block with txs proposed by external builder: %w", err)
+		}
+	default:
+		return nil, nil
+	}
+
+	// The candidate is verified by consensus like any other block. However, a
+	// candidate that isn't a child of this block would never be accepted, so
+	// it's dropped now to fall back to the inner VM.
+	if innerParentID := innerBlock.Parent(); innerParentID != parentID {
+		return nil, fmt.Errorf("%w: expected %s but got %s", errExternalBlockParentMismatch, parentID, innerParentID)
+	}
+	if innerHeight := innerBlock.Height(); innerHeight != height {
+		return nil, fmt.Errorf("%w: expected %d but got %d", errExternalBlockHeightMismatch, height, innerHeight)
+	}
+	return innerBlock, nil
+}
+
 func (p *postForkCommonComponents) getInnerBlk() snowman.Block {
 	return p.innerBlk
 }
@@ -22,8 +22,11 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
 	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block/mocks"
 	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/trace"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/builder"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
+	"github.com/VidarSolutions/avalanchego/vms/tracedvm"
 )
 
 // Assert that when the underlying VM implements ChainVMWithBuildBlockContext
@@ -85,3 +88,277 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 	require.NoError(err)
 	require.Equal(builtBlk, gotChild.(*postForkBlock).innerBlk)
 }
+
+// Assert that the inner block is built from the candidate of the external
+// builder when it's a valid child of the parent, and by the inner VM
+// otherwise.
+func TestPostForkCommonComponents_buildChildWithExternalBuilder(t *testing.T) {
+	pChainHeight := uint64(1337)
+	parentID := ids.GenerateTestID()
+	parentTimestamp := time.Now()
+	innerParentID := ids.GenerateTestID()
+	innerParentHeight := uint64(5)
+	candidateBytes := []byte{4, 5, 6}
+	candidateTxs := [][]byte{{7}, {8}}
+
+	tests := []struct {
+		name         string
+		candidate    *builder.Candidate
+		builderErr   error
+		hasTxsVM     bool
+		parsedParent ids.ID
+		parsedHeight uint64
+		useCandidate bool
+	}{
+		{
+			name: "block",
+			candidate: &builder.Candidate{
+				Block: candidateBytes,
+			},
+			parsedParent: innerParentID,
+			parsedHeight: innerParentHeight + 1,
+			useCandidate: true,
+		},
+		{
+			name: "txs",
+			candidate: &builder.Candidate{
+				Txs: candidateTxs,
+			},
+			hasTxsVM:     true,
+			parsedParent: innerParentID,
+			parsedHeight: innerParentHeight + 1,
+			useCandidate: true,
+		},
+		{
+			name: "txs not supported by inner VM",
+			candidate: &builder.Candidate{
+				Txs: candidateTxs,
+			},
+		},
+		{
+			name:      "no candidate",
+			candidate: &builder.Candidate{},
+		},
+		{
+			name:       "builder failed",
+			builderErr: context.DeadlineExceeded,
+		},
+		{
+			name: "block with wrong parent",
+			candidate: &builder.Candidate{
+				Block: candidateBytes,
+			},
+			parsedParent: ids.GenerateTestID(),
+			parsedHeight: innerParentHeight + 1,
+		},
+		{
+			name: "block with wrong height",
+			candidate: &builder.Candidate{
+				Block: candidateBytes,
+			},
+			parsedParent: innerParentID,
+			parsedHeight: innerParentHeight + 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			innerBlk := snowman.NewMockBlock(ctrl)
+			innerBlk.EXPECT().ID().Return(innerParentID).AnyTimes()
+			innerBlk.EXPECT().Height().Return(innerParentHeight).AnyTimes()
+			candidateBlk := snowman.NewMockBlock(ctrl)
+			candidateBlk.EXPECT().Bytes().Return(candidateBytes).AnyTimes()
+			candidateBlk.EXPECT().ID().Return(ids.GenerateTestID()).AnyTimes()
+			candidateBlk.EXPECT().Parent().Return(test.parsedParent).AnyTimes()
+			candidateBlk.EXPECT().Height().Return(test.parsedHeight).AnyTimes()
+			builtBlk := snowman.NewMockBlock(ctrl)
+			builtBlk.EXPECT().Bytes().Return([]byte{1, 2, 3}).AnyTimes()
+			builtBlk.EXPECT().ID().Return(ids.GenerateTestID()).AnyTimes()
+			builtBlk.EXPECT().Height().Return(innerParentHeight + 1).AnyTimes()
+
+			blockCtx := &block.Context{
+				PChainHeight: pChainHeight - 1,
+			}
+			innerVM := mocks.NewMockChainVM(ctrl)
+			innerVM.EXPECT().ParseBlock(gomock.Any(), candidateBytes).Return(candidateBlk, nil).AnyTimes()
+			innerBlockBuilderVM := mocks.NewMockBuildBlockWithContextChainVM(ctrl)
+			innerBlockBuilderVM.EXPECT().BuildBlockWithContext(gomock.Any(), blockCtx).Return(builtBlk, nil).AnyTimes()
+			var innerTxsBuilderVM block.BuildBlockWithTxsChainVM
+			if test.hasTxsVM {
+				txsBuilderVM := mocks.NewMockBuildBlockWithTxsChainVM(ctrl)
+				txsBuilderVM.EXPECT().BuildBlockWithTxs(gomock.Any(), blockCtx, candidateTxs).Return(candidateBlk, nil)
+				innerTxsBuilderVM = txsBuilderVM
+			}
+			vdrState := validators.NewMockState(ctrl)
+			vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
+			windower := proposer.NewMockWindower(ctrl)
+			windower.EXPECT().Delay(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+
+			chainID := ids.GenerateTestID()
+			externalBuilder := builder.NewMockBuilder(ctrl)
+			externalBuilder.EXPECT().BuildBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *builder.Request) (*builder.Candidate, error) {
+					require.Equal(chainID, req.ChainID)
+					require.Equal(innerParentID, req.ParentID)
+					require.Equal(innerParentHeight+1, req.Height)
+					require.Equal(pChainHeight-1, req.PChainHeight)
+					return test.candidate, test.builderErr
+				},
+			)
+
+			pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			require.NoError(err)
+			vm := &VM{
+				ChainVM:         innerVM,
+				blockBuilderVM:  innerBlockBuilderVM,
+				txsBuilderVM:    innerTxsBuilderVM,
+				externalBuilder: externalBuilder,
+				ctx: &snow.Context{
+					ChainID:        chainID,
+					ValidatorState: vdrState,
+					Log:            logging.NoLog{},
+				},
+				Windower:          windower,
+				stakingCertLeaf:   &x509.Certificate{},
+				stakingLeafSigner: pk,
+			}
+
+			blk := &postForkCommonComponents{
+				innerBlk: innerBlk,
+				vm:       vm,
+			}
+
+			gotChild, err := blk.buildChild(
+				context.Background(),
+				parentID,
+				parentTimestamp,
+				pChainHeight-1,
+			)
+			require.NoError(err)
+			if test.useCandidate {
+				require.Equal(candidateBlk, gotChild.(*postForkBlock).innerBlk)
+			} else {
+				require.Equal(builtBlk, gotChild.(*postForkBlock).innerBlk)
+			}
+		})
+	}
+}
+
+type txsBuilderVMMock struct {
+	*mocks.MockChainVM
+	*mocks.MockBuildBlockWithTxsChainVM
+}
+
+// Assert that the txs proposed by the external builder reach the inner VM when
+// it's wrapped, and that the inner VM builds the block otherwise.
+func TestPostForkCommonComponents_buildChildWithTxsThroughWrappedVM(t *testing.T) {
+	pChainHeight := uint64(1337)
+	parentID := ids.GenerateTestID()
+	parentTimestamp := time.Now()
+	innerParentID := ids.GenerateTestID()
+	innerParentHeight := uint64(5)
+	candidateTxs := [][]byte{{7}, {8}}
+
+	tests := []struct {
+		name         string
+		supportsTxs  bool
+		useCandidate bool
+	}{
+		{
+			name:         "inner VM supports txs",
+			supportsTxs:  true,
+			useCandidate: true,
+		},
+		{
+			name: "inner VM doesn't support txs",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			innerBlk := snowman.NewMockBlock(ctrl)
+			innerBlk.EXPECT().ID().Return(innerParentID).AnyTimes()
+			innerBlk.EXPECT().Height().Return(innerParentHeight).AnyTimes()
+			candidateBlk := snowman.NewMockBlock(ctrl)
+			candidateBlk.EXPECT().Bytes().Return([]byte{4, 5, 6}).AnyTimes()
+			candidateBlk.EXPECT().ID().Return(ids.GenerateTestID()).AnyTimes()
+			candidateBlk.EXPECT().Parent().Return(innerParentID).AnyTimes()
+			candidateBlk.EXPECT().Height().Return(innerParentHeight + 1).AnyTimes()
+			builtBlk := snowman.NewMockBlock(ctrl)
+			builtBlk.EXPECT().Bytes().Return([]byte{1, 2, 3}).AnyTimes()
+			builtBlk.EXPECT().ID().Return(ids.GenerateTestID()).AnyTimes()
+			builtBlk.EXPECT().Height().Return(innerParentHeight + 1).AnyTimes()
+
+			var innerVM block.ChainVM
+			if test.supportsTxs {
+				txsVM := txsBuilderVMMock{
+					MockChainVM:                  mocks.NewMockChainVM(ctrl),
+					MockBuildBlockWithTxsChainVM: mocks.NewMockBuildBlockWithTxsChainVM(ctrl),
+				}
+				txsVM.MockBuildBlockWithTxsChainVM.EXPECT().BuildBlockWithTxs(
+					gomock.Any(),
+					&block.Context{PChainHeight: pChainHeight - 1},
+					candidateTxs,
+				).Return(candidateBlk, nil)
+				innerVM = txsVM
+			} else {
+				chainVM := mocks.NewMockChainVM(ctrl)
+				chainVM.EXPECT().BuildBlock(gomock.Any()).Return(builtBlk, nil)
+				innerVM = chainVM
+			}
+			vdrState := validators.NewMockState(ctrl)
+			vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
+			windower := proposer.NewMockWindower(ctrl)
+			windower.EXPECT().Delay(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+
+			externalBuilder := builder.NewMockBuilder(ctrl)
+			externalBuilder.EXPECT().BuildBlock(gomock.Any(), gomock.Any()).Return(&builder.Candidate{
+				Txs: candidateTxs,
+			}, nil)
+
+			tracer, err := trace.New(trace.Config{})
+			require.NoError(err)
+			pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			require.NoError(err)
+			vm := New(
+				tracedvm.NewBlockVM(innerVM, "test", tracer),
+				time.Time{},
+				0,
+				0,
+				pk,
+				&x509.Certificate{},
+				externalBuilder,
+			)
+			vm.ctx = &snow.Context{
+				ChainID:        ids.GenerateTestID(),
+				ValidatorState: vdrState,
+				Log:            logging.NoLog{},
+			}
+			vm.Windower = windower
+
+			blk := &postForkCommonComponents{
+				innerBlk: innerBlk,
+				vm:       vm,
+			}
+
+			gotChild, err := blk.buildChild(
+				context.Background(),
+				parentID,
+				parentTimestamp,
+				pChainHeight-1,
+			)
+			require.NoError(err)
+			if test.useCandidate {
+				require.Equal(candidateBlk.ID(), gotChild.(*postForkBlock).innerBlk.ID())
+			} else {
+				require.Equal(builtBlk.ID(), gotChild.(*postForkBlock).innerBlk.ID())
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"context"
+	"time"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+// DefaultTimeout is the default amount of time the proposervm waits for an
+// external block builder before building the block with the inner VM.
+const DefaultTimeout = 100 * time.Millisecond
+
+// Request describes the block that the proposervm is about to build.
+type Request struct {
+	ChainID ids.ID
+	// ID of the inner block that the block will be built on
+	ParentID ids.ID
+	// Height of the inner block that will be built
+	Height    uint64
+	Timestamp time.Time
+	// P-chain height that the inner block will be verified against
+	PChainHeight uint64
+}
+
+// Candidate is the content that an external block builder proposes for a
+// block. At most one of [Block] and [Txs] is set. If neither is set, the inner
+// VM builds the block itself.
+type Candidate struct {
+	// Bytes of the inner block to propose
+	Block []byte
+	// Transactions, in the order they should be included in the inner block
+	Txs [][]byte
+}
+
+// Builder proposes the content of the blocks that this node builds during its
+// proposer window.
+type Builder interface {
+	// BuildBlock returns the content to propose for the block described by
+	// [req]. The returned candidate isn't trusted; the block built from it is
+	// verified like any other block.
+	BuildBlock(ctx context.Context, req *Request) (*Candidate, error)
+
+	// Close releases the resources held by the builder.
+	Close() error
+}
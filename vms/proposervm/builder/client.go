@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/VidarSolutions/avalanchego/proto/pb/builder"
+)
+
+var _ Builder = (*Client)(nil)
+
+// Client is a Builder that asks a local process for candidates over gRPC.
+type Client struct {
+	conn    *grpc.ClientConn
+	client  pb.BuilderClient
+	timeout time.Duration
+}
+
+// NewClient returns a Builder that calls the Builder service served on [conn].
+// Requests that take longer than [timeout] fail. Closing the returned client
+// closes [conn].
+func NewClient(conn *grpc.ClientConn, timeout time.Duration) *Client {
+	return &Client{
+		conn:    conn,
+		client:  pb.NewBuilderClient(conn),
+		timeout: timeout,
+	}
+}
+
+func (c *Client) BuildBlock(ctx context.Context, req *Request) (*Candidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.BuildBlock(ctx, &pb.BuildBlockRequest{
+		ChainId:      req.ChainID[:],
+		ParentId:     req.ParentID[:],
+		Height:       req.Height,
+		Timestamp:    req.Timestamp.Unix(),
+		PChainHeight: req.PChainHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Candidate{
+		Block: resp.GetBlock(),
+		Txs:   resp.GetTxs().GetTxs(),
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/vms/rpcchainvm/grpcutils"
+
+	pb "github.com/VidarSolutions/avalanchego/proto/pb/builder"
+)
+
+var _ Builder = (*testBuilder)(nil)
+
+type testBuilder struct {
+	buildBlockF func(context.Context, *Request) (*Candidate, error)
+}
+
+func (b *testBuilder) BuildBlock(ctx context.Context, req *Request) (*Candidate, error) {
+	return b.buildBlockF(ctx, req)
+}
+
+func (*testBuilder) Close() error {
+	return nil
+}
+
+func setupClient(t *testing.T, server Builder, timeout time.Duration) *Client {
+	require := require.New(t)
+
+	listener, err := grpcutils.NewListener()
+	require.NoError(err)
+	serverCloser := grpcutils.ServerCloser{}
+
+	grpcServer := grpcutils.NewServer()
+	pb.RegisterBuilderServer(grpcServer, NewServer(server))
+	serverCloser.Add(grpcServer)
+
+	go grpcutils.Serve(listener, grpcServer)
+
+	conn, err := grpcutils.Dial(listener.Addr().String())
+	require.NoError(err)
+
+	client := NewClient(conn, timeout)
+	t.Cleanup(func() {
+		_ = client.Close()
+		serverCloser.Stop()
+		_ = listener.Close()
+	})
+	return client
+}
+
+func TestClient(t *testing.T) {
+	req := &Request{
+		ChainID:      ids.GenerateTestID(),
+		ParentID:     ids.GenerateTestID(),
+		Height:       2,
+		Timestamp:    time.Unix(3, 0),
+		PChainHeight: 4,
+	}
+	tests := []struct {
+		name      string
+		candidate *Candidate
+	}{
+		{
+			name: "block",
+			candidate: &Candidate{
+				Block: []byte{1, 2, 3},
+			},
+		},
+		{
+			name: "txs",
+			candidate: &Candidate{
+				Txs: [][]byte{{1}, {2, 3}},
+			},
+		},
+		{
+			name:      "no candidate",
+			candidate: &Candidate{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var gotReq *Request
+			client := setupClient(t, &testBuilder{
+				buildBlockF: func(_ context.Context, req *Request) (*Candidate, error) {
+					gotReq = req
+					return test.candidate, nil
+				},
+			}, time.Minute)
+
+			candidate, err := client.BuildBlock(context.Background(), req)
+			require.NoError(err)
+			require.Equal(req, gotReq)
+			require.Equal(test.candidate, candidate)
+		})
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	require := require.New(t)
+
+	client := setupClient(t, &testBuilder{
+		buildBlockF: func(ctx context.Context, _ *Request) (*Candidate, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}, time.Millisecond)
+
+	_, err := client.BuildBlock(context.Background(), &Request{})
+	require.Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestServerRejectsBlockAndTxs(t *testing.T) {
+	require := require.New(t)
+
+	server := NewServer(&testBuilder{
+		buildBlockF: func(context.Context, *Request) (*Candidate, error) {
+			return &Candidate{
+				Block: []byte{1},
+				Txs:   [][]byte{{2}},
+			}, nil
+		},
+	})
+	_, err := server.BuildBlock(context.Background(), &pb.BuildBlockRequest{
+		ChainId:  ids.Empty[:],
+		ParentId: ids.Empty[:],
+	})
+	require.ErrorIs(err, errBlockAndTxs)
+}
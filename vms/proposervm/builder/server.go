@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+
+	pb "github.com/VidarSolutions/avalanchego/proto/pb/builder"
+)
+
+var (
+	_ pb.BuilderServer = (*Server)(nil)
+
+	errBlockAndTxs = errors.New("candidate can't contain both a block and txs")
+)
+
+// Server serves a Builder over gRPC. It can be used to implement an external
+// block builder in Go.
+type Server struct {
+	pb.UnsafeBuilderServer
+	builder Builder
+}
+
+func NewServer(builder Builder) *Server {
+	return &Server{builder: builder}
+}
+
+func (s *Server) BuildBlock(ctx context.Context, req *pb.BuildBlockRequest) (*pb.BuildBlockResponse, error) {
+	chainID, err := ids.ToID(req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := ids.ToID(req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := s.builder.BuildBlock(ctx, &Request{
+		ChainID:      chainID,
+		ParentID:     parentID,
+		Height:       req.Height,
+		Timestamp:    time.Unix(req.Timestamp, 0),
+		PChainHeight: req.PChainHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case candidate.Block != nil && candidate.Txs != nil:
+		return nil, errBlockAndTxs
+	case candidate.Block != nil:
+		return &pb.BuildBlockResponse{
+			Candidate: &pb.BuildBlockResponse_Block{
+				Block: candidate.Block,
+			},
+		}, nil
+	case candidate.Txs != nil:
+		return &pb.BuildBlockResponse{
+			Candidate: &pb.BuildBlockResponse_Txs{
+				Txs: &pb.Txs{
+					Txs: candidate.Txs,
+				},
+			},
+		}, nil
+	default:
+		return &pb.BuildBlockResponse{}, nil
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
+	"github.com/VidarSolutions/avalanchego/utils/json"
+	"github.com/VidarSolutions/avalanchego/utils/rpc"
+)
+
+var _ Client = (*client)(nil)
+
+// Client interface for interacting with the proposervm API of a chain
+type Client interface {
+	// GetProposers returns the ordered proposers of the block at [height] when
+	// the validator set is defined at [pChainHeight]
+	GetProposers(ctx context.Context, height, pChainHeight uint64, options ...rpc.Option) (*GetProposersReply, error)
+	// GetProposerSchedule returns the windows in which the node can propose
+	// each of the [numHeights] blocks after its preferred block
+	GetProposerSchedule(ctx context.Context, numHeights uint64, options ...rpc.Option) (*GetProposerScheduleReply, error)
+	// GetProductionStats returns the stats of the proposer windows of
+	// [nodeIDs], or of every validator that has had a window if [nodeIDs] is
+	// empty
+	GetProductionStats(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ProductionStats, error)
+}
+
+// Client implementation for interacting with the proposervm API of a chain
+type client struct {
+	requester rpc.EndpointRequester
+}
+
+// NewClient returns a Client for interacting with the proposervm API of
+// [chain]
+func NewClient(uri, chain string) Client {
+	path := fmt.Sprintf(
+		"%s/ext/%s/%s%s",
+		uri,
+		constants.ChainAliasPrefix,
+		chain,
+		apiEndpoint,
+	)
+	return &client{
+		requester: rpc.NewEndpointRequester(path),
+	}
+}
+
+func (c *client) GetProposers(ctx context.Context, height, pChainHeight uint64, options ...rpc.Option) (*GetProposersReply, error) {
+	res := &GetProposersReply{}
+	err := c.requester.SendRequest(ctx, "proposervm.getProposers", &GetProposersArgs{
+		Height:       json.Uint64(height),
+		PChainHeight: json.Uint64(pChainHeight),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetProposerSchedule(ctx context.Context, numHeights uint64, options ...rpc.Option) (*GetProposerScheduleReply, error) {
+	res := &GetProposerScheduleReply{}
+	err := c.requester.SendRequest(ctx, "proposervm.getProposerSchedule", &GetProposerScheduleArgs{
+		NumHeights: json.Uint64(numHeights),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetProductionStats(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ProductionStats, error) {
+	res := &GetProductionStatsReply{}
+	err := c.requester.SendRequest(ctx, "proposervm.getProductionStats", &GetProductionStatsArgs{
+		NodeIDs: nodeIDs,
+	}, res, options...)
+	return res.Stats, err
+}
@@ -24,6 +24,10 @@ type postForkBlock struct {
 // 2) Persists this block in storage
 // 3) Calls Reject() on siblings of this block and their descendants.
 func (b *postForkBlock) Accept(ctx context.Context) error {
+	// The stats are committed along with the block in acceptOuterBlk
+	if err := b.vm.recordProduction(ctx, b); err != nil {
+		return err
+	}
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
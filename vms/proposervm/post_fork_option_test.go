@@ -748,6 +748,7 @@ func TestOptionTimestampValidity(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	coreVM.InitializeF = func(
@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package production
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
+)
+
+const statsLen = 3 * wrappers.LongLen
+
+var (
+	_ Tracker = (*tracker)(nil)
+
+	errInvalidStats = errors.New("invalid stats")
+)
+
+// Stats describe how a validator used its proposer windows.
+type Stats struct {
+	// Number of accepted blocks that the validator proposed after its window
+	// started
+	Proposed uint64
+	// Number of accepted blocks that another node built after the validator's
+	// window started
+	Missed uint64
+	// Sum, over the blocks that the validator proposed, of the time between
+	// the start of its window and the timestamp of the block
+	TotalDelay time.Duration
+}
+
+// AverageDelay returns the average time between the start of the validator's
+// window and the timestamp of the blocks it proposed.
+func (s Stats) AverageDelay() time.Duration {
+	if s.Proposed == 0 {
+		return 0
+	}
+	return s.TotalDelay / time.Duration(s.Proposed)
+}
+
+func (s Stats) marshal() []byte {
+	p := wrappers.Packer{Bytes: make([]byte, statsLen)}
+	p.PackLong(s.Proposed)
+	p.PackLong(s.Missed)
+	p.PackLong(uint64(s.TotalDelay))
+	return p.Bytes
+}
+
+func parseStats(b []byte) (Stats, error) {
+	if len(b) != statsLen {
+		return Stats{}, errInvalidStats
+	}
+	p := wrappers.Packer{Bytes: b}
+	return Stats{
+		Proposed:   p.UnpackLong(),
+		Missed:     p.UnpackLong(),
+		TotalDelay: time.Duration(p.UnpackLong()),
+	}, nil
+}
+
+// Tracker persists the Stats of each validator of a chain.
+type Tracker interface {
+	// Record updates the stats with an accepted block that [builder] built
+	// [delay] after the timestamp of its parent, where [proposers] are the
+	// ordered proposers of the block, possibly with repetitions.
+	Record(proposers []ids.NodeID, builder ids.NodeID, delay time.Duration) error
+
+	// Get returns the stats of [nodeID]. Validators that never had a window
+	// have empty stats.
+	Get(nodeID ids.NodeID) (Stats, error)
+
+	// GetAll returns the stats of every validator that has had a window.
+	GetAll() (map[ids.NodeID]Stats, error)
+}
+
+type tracker struct {
+	db database.Database
+	// Only the stats of [nodeID] are reported as metrics, so that the number
+	// of series doesn't grow with the number of validators.
+	nodeID     ids.NodeID
+	proposed   prometheus.Gauge
+	missed     prometheus.Gauge
+	totalDelay prometheus.Gauge
+}
+
+// NewTracker returns a Tracker that persists the stats in [db] and reports the
+// stats of [nodeID], which should be this node's ID, as metrics.
+func NewTracker(db database.Database, nodeID ids.NodeID, registerer prometheus.Registerer) (Tracker, error) {
+	t := &tracker{
+		db:     db,
+		nodeID: nodeID,
+		proposed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "validator_blocks_proposed",
+			Help: "Number of accepted blocks that this node proposed after its window started",
+		}),
+		missed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "validator_windows_missed",
+			Help: "Number of accepted blocks that another node built after this node's window started",
+		}),
+		totalDelay: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "validator_proposal_delay",
+			Help: "Sum of the seconds between the start of this node's window and the timestamps of the blocks it proposed",
+		}),
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(t.proposed),
+		registerer.Register(t.missed),
+		registerer.Register(t.totalDelay),
+	)
+	if errs.Errored() {
+		return nil, errs.Err
+	}
+
+	stats, err := t.Get(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	t.report(nodeID, stats)
+	return t, nil
+}
+
+func (t *tracker) Record(proposers []ids.NodeID, builder ids.NodeID, delay time.Duration) error {
+	// Index of the window that the block was built in
+	window := int(delay / proposer.WindowDuration)
+	recorded := set.NewSet[ids.NodeID](len(proposers))
+	for i, nodeID := range proposers {
+		if i > window {
+			// The window of [nodeID] hadn't started yet
+			break
+		}
+		if recorded.Contains(nodeID) {
+			// A validator can be sampled multiple times, in which case its
+			// window starts at the first time it was sampled
+			continue
+		}
+		recorded.Add(nodeID)
+
+		stats, err := t.Get(nodeID)
+		if err != nil {
+			return err
+		}
+		if nodeID == builder {
+			stats.Proposed++
+			stats.TotalDelay += delay - time.Duration(i)*proposer.WindowDuration
+		} else {
+			stats.Missed++
+		}
+		if err := t.db.Put(nodeID[:], stats.marshal()); err != nil {
+			return err
+		}
+		t.report(nodeID, stats)
+	}
+	return nil
+}
+
+func (t *tracker) Get(nodeID ids.NodeID) (Stats, error) {
+	statsBytes, err := t.db.Get(nodeID[:])
+	if err == database.ErrNotFound {
+		return Stats{}, nil
+	}
+	if err != nil {
+		return Stats{}, err
+	}
+	return parseStats(statsBytes)
+}
+
+func (t *tracker) GetAll() (map[ids.NodeID]Stats, error) {
+	it := t.db.NewIterator()
+	defer it.Release()
+
+	allStats := make(map[ids.NodeID]Stats)
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		stats, err := parseStats(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		allStats[nodeID] = stats
+	}
+	return allStats, it.Error()
+}
+
+func (t *tracker) report(nodeID ids.NodeID, stats Stats) {
+	if nodeID != t.nodeID {
+		return
+	}
+	t.proposed.Set(float64(stats.Proposed))
+	t.missed.Set(float64(stats.Missed))
+	t.totalDelay.Set(stats.TotalDelay.Seconds())
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package production
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+func TestTracker(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	nodeID0 := ids.GenerateTestNodeID()
+	trackerIntf, err := NewTracker(db, nodeID0, prometheus.NewRegistry())
+	require.NoError(err)
+	tr := trackerIntf.(*tracker)
+
+	nodeID1 := ids.GenerateTestNodeID()
+	nodeID2 := ids.GenerateTestNodeID()
+	proposers := []ids.NodeID{nodeID0, nodeID1, nodeID0, nodeID2}
+
+	// Built in its own window by the first proposer
+	require.NoError(tr.Record(proposers, nodeID0, 2500*time.Millisecond))
+	// Built by the second proposer 1 second into its window
+	require.NoError(tr.Record(proposers, nodeID1, 6*time.Second))
+	// Built by the first proposer after the second proposer's window started
+	require.NoError(tr.Record(proposers, nodeID0, 7*time.Second))
+	// Built after every window by a node that isn't a proposer
+	require.NoError(tr.Record(proposers, ids.EmptyNodeID, time.Minute))
+
+	stats0, err := tr.Get(nodeID0)
+	require.NoError(err)
+	require.Equal(Stats{
+		Proposed:   2,
+		Missed:     2,
+		TotalDelay: 9500 * time.Millisecond,
+	}, stats0)
+	require.Equal(4750*time.Millisecond, stats0.AverageDelay())
+
+	stats1, err := tr.Get(nodeID1)
+	require.NoError(err)
+	require.Equal(Stats{
+		Proposed:   1,
+		Missed:     2,
+		TotalDelay: time.Second,
+	}, stats1)
+
+	stats2, err := tr.Get(nodeID2)
+	require.NoError(err)
+	require.Equal(Stats{
+		Missed: 1,
+	}, stats2)
+	require.Zero(stats2.AverageDelay())
+
+	// Only the stats of this node are reported
+	require.Equal(2.0, testutil.ToFloat64(tr.proposed))
+	require.Equal(2.0, testutil.ToFloat64(tr.missed))
+	require.Equal(9.5, testutil.ToFloat64(tr.totalDelay))
+
+	// The stats are persisted without truncating the delay
+	trackerIntf, err = NewTracker(db, nodeID0, prometheus.NewRegistry())
+	require.NoError(err)
+	tr = trackerIntf.(*tracker)
+
+	allStats, err := tr.GetAll()
+	require.NoError(err)
+	require.Equal(map[ids.NodeID]Stats{
+		nodeID0: stats0,
+		nodeID1: stats1,
+		nodeID2: stats2,
+	}, allStats)
+	require.Equal(2.0, testutil.ToFloat64(tr.proposed))
+	require.Equal(9.5, testutil.ToFloat64(tr.totalDelay))
+
+	stats, err := tr.Get(ids.GenerateTestNodeID())
+	require.NoError(err)
+	require.Zero(stats)
+}
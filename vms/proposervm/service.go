@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/json"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/production"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
+)
+
+// maxScheduleHeights is the maximum number of heights that a proposer schedule
+// can be requested for.
+const maxScheduleHeights = 1024
+
+var (
+	errNoHeights      = errors.New("numHeights must be positive")
+	errTooManyHeights = fmt.Errorf("numHeights can't be larger than %d", maxScheduleHeights)
+)
+
+// Service is the API service of the proposervm.
+type Service struct {
+	vm *VM
+}
+
+type GetProposersArgs struct {
+	// Height of the block to be proposed
+	Height json.Uint64 `json:"height"`
+	// P-chain height that the validator set is defined at. This is the P-chain
+	// height of the parent of the block to be proposed.
+	PChainHeight json.Uint64 `json:"pChainHeight"`
+}
+
+// Proposer is a validator that may propose a block before any validator can.
+type Proposer struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Number of seconds after the parent's timestamp from which the node can
+	// propose the block
+	Delay json.Uint64 `json:"delay"`
+}
+
+type GetProposersReply struct {
+	// Ordered by delay. Each validator is listed once, with its earliest
+	// window.
+	Proposers []Proposer `json:"proposers"`
+	// Number of seconds after the parent's timestamp from which any node can
+	// propose the block
+	MaxDelay json.Uint64 `json:"maxDelay"`
+}
+
+// GetProposers returns the ordered list of proposers of the block at
+// [args.Height] when the validator set is defined at [args.PChainHeight].
+func (s *Service) GetProposers(r *http.Request, args *GetProposersArgs, reply *GetProposersReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getProposers"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Uint64("pChainHeight", uint64(args.PChainHeight)),
+	)
+
+	proposers, err := s.vm.Windower.Proposers(r.Context(), uint64(args.Height), uint64(args.PChainHeight))
+	if err != nil {
+		return fmt.Errorf("couldn't get proposers: %w", err)
+	}
+
+	// A validator can be sampled multiple times, in which case its window
+	// starts at the first time it was sampled.
+	listed := set.NewSet[ids.NodeID](len(proposers))
+	reply.Proposers = make([]Proposer, 0, len(proposers))
+	for i, nodeID := range proposers {
+		if listed.Contains(nodeID) {
+			continue
+		}
+		listed.Add(nodeID)
+		reply.Proposers = append(reply.Proposers, Proposer{
+			NodeID: nodeID,
+			Delay:  json.Uint64(time.Duration(i) * proposer.WindowDuration / time.Second),
+		})
+	}
+	reply.MaxDelay = json.Uint64(proposer.MaxDelay / time.Second)
+	return nil
+}
+
+type GetProposerScheduleArgs struct {
+	// Number of heights after the preferred block to return the windows of
+	// this node for
+	NumHeights json.Uint64 `json:"numHeights"`
+}
+
+// ProposerWindow is the window in which this node can propose a block.
+type ProposerWindow struct {
+	// Height of the block to be proposed
+	Height json.Uint64 `json:"height"`
+	// P-chain height that the validator set of the window is defined at
+	PChainHeight json.Uint64 `json:"pChainHeight"`
+	// Number of seconds after the parent's timestamp from which this node can
+	// propose the block
+	Delay json.Uint64 `json:"delay"`
+}
+
+type GetProposerScheduleReply struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Ordered by height
+	Windows []ProposerWindow `json:"windows"`
+}
+
+// GetProposerSchedule returns the windows in which this node can propose each
+// of the [args.NumHeights] blocks after the preferred block.
+//
+// The window of the child of the preferred block is exact. The windows of the
+// following blocks assume that their parents will be built on the P-chain
+// height that this node would currently propose.
+func (s *Service) GetProposerSchedule(r *http.Request, args *GetProposerScheduleArgs, reply *GetProposerScheduleReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getProposerSchedule"),
+		zap.Uint64("numHeights", uint64(args.NumHeights)),
+	)
+
+	switch {
+	case args.NumHeights == 0:
+		return errNoHeights
+	case args.NumHeights > maxScheduleHeights:
+		return errTooManyHeights
+	}
+
+	ctx := r.Context()
+	preferred, err := s.vm.getBlock(ctx, s.vm.preferred)
+	if err != nil {
+		return fmt.Errorf("couldn't get preferred block %s: %w", s.vm.preferred, err)
+	}
+	if _, ok := preferred.(*preForkBlock); ok {
+		return errProposersNotActivated
+	}
+
+	pChainHeight, err := preferred.pChainHeight(ctx)
+	if err != nil {
+		return err
+	}
+	// The children of the child of the preferred block are assumed to be
+	// built on the P-chain height that this node would propose now.
+	nextPChainHeight, err := s.vm.optimalPChainHeight(ctx, pChainHeight)
+	if err != nil {
+		return err
+	}
+
+	nodeID := s.vm.ctx.NodeID
+	reply.NodeID = nodeID
+	reply.Windows = make([]ProposerWindow, args.NumHeights)
+	height := preferred.Height()
+	for i := range reply.Windows {
+		height++
+		delay, err := s.vm.Windower.Delay(ctx, height, pChainHeight, nodeID)
+		if err != nil {
+			return fmt.Errorf("couldn't get delay at height %d: %w", height, err)
+		}
+		reply.Windows[i] = ProposerWindow{
+			Height:       json.Uint64(height),
+			PChainHeight: json.Uint64(pChainHeight),
+			Delay:        json.Uint64(delay / time.Second),
+		}
+		pChainHeight = nextPChainHeight
+	}
+	return nil
+}
+
+type GetProductionStatsArgs struct {
+	// Validators to return the stats of. If empty, the stats of every
+	// validator that has had a window are returned.
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// ProductionStats describe how a validator used its proposer windows.
+type ProductionStats struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Number of accepted blocks that the validator proposed after its window
+	// started
+	Proposed json.Uint64 `json:"proposed"`
+	// Number of accepted blocks that another node built after the
+	// validator's window started
+	Missed json.Uint64 `json:"missed"`
+	// Average number of seconds between the start of the validator's window
+	// and the timestamps of the blocks it proposed
+	AverageDelay json.Float64 `json:"averageDelay"`
+}
+
+type GetProductionStatsReply struct {
+	Stats []ProductionStats `json:"stats"`
+}
+
+// GetProductionStats returns the stats of the proposer windows of validators.
+// Only blocks that this node accepted after it finished bootstrapping are
+// counted.
+func (s *Service) GetProductionStats(_ *http.Request, args *GetProductionStatsArgs, reply *GetProductionStatsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getProductionStats"),
+		zap.Int("numNodeIDs", len(args.NodeIDs)),
+	)
+
+	allStats := make(map[ids.NodeID]production.Stats, len(args.NodeIDs))
+	if len(args.NodeIDs) == 0 {
+		var err error
+		allStats, err = s.vm.production.GetAll()
+		if err != nil {
+			return fmt.Errorf("couldn't get stats: %w", err)
+		}
+	}
+	for _, nodeID := range args.NodeIDs {
+		stats, err := s.vm.production.Get(nodeID)
+		if err != nil {
+			return fmt.Errorf("couldn't get stats of %s: %w", nodeID, err)
+		}
+		allStats[nodeID] = stats
+	}
+
+	reply.Stats = make([]ProductionStats, 0, len(allStats))
+	for nodeID, stats := range allStats {
+		reply.Stats = append(reply.Stats, ProductionStats{
+			NodeID:       nodeID,
+			Proposed:     json.Uint64(stats.Proposed),
+			Missed:       json.Uint64(stats.Missed),
+			AverageDelay: json.Float64(stats.AverageDelay().Seconds()),
+		})
+	}
+	slices.SortFunc(reply.Stats, func(i, j ProductionStats) bool {
+		return i.NodeID.Less(j.NodeID)
+	})
+	return nil
+}
@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/snow/choices"
+	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
+	"github.com/VidarSolutions/avalanchego/snow/engine/common"
+	"github.com/VidarSolutions/avalanchego/snow/validators"
+	"github.com/VidarSolutions/avalanchego/utils/json"
+	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
+)
+
+func TestServiceGetProposers(t *testing.T) {
+	require := require.New(t)
+
+	_, _, proVM, _, _ := initTestProposerVM(t, time.Time{}, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	s := &Service{vm: proVM}
+
+	reply := GetProposersReply{}
+	require.NoError(s.GetProposers(&http.Request{}, &GetProposersArgs{
+		Height:       1,
+		PChainHeight: json.Uint64(defaultPChainHeight),
+	}, &reply))
+
+	// Each proposer is listed once, with the delay of its earliest window
+	proposers, err := proVM.Windower.Proposers(context.Background(), 1, defaultPChainHeight)
+	require.NoError(err)
+	listed := set.Set[ids.NodeID]{}
+	for i, p := range reply.Proposers {
+		require.False(listed.Contains(p.NodeID))
+		listed.Add(p.NodeID)
+		if i > 0 {
+			require.Greater(p.Delay, reply.Proposers[i-1].Delay)
+		}
+
+		delay, err := proVM.Windower.Delay(context.Background(), 1, defaultPChainHeight, p.NodeID)
+		require.NoError(err)
+		require.Equal(json.Uint64(delay/time.Second), p.Delay)
+	}
+	expectedListed := set.NewSet[ids.NodeID](len(proposers))
+	expectedListed.Add(proposers...)
+	require.Equal(expectedListed, listed)
+	require.Equal(json.Uint64(proposer.MaxDelay/time.Second), reply.MaxDelay)
+}
+
+func TestServiceGetProposerSchedule(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, valState, proVM, coreGenBlk, _ := initTestProposerVM(t, time.Time{}, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	s := &Service{vm: proVM}
+
+	reply := GetProposerScheduleReply{}
+	err := s.GetProposerSchedule(&http.Request{}, &GetProposerScheduleArgs{}, &reply)
+	require.ErrorIs(err, errNoHeights)
+	err = s.GetProposerSchedule(&http.Request{}, &GetProposerScheduleArgs{
+		NumHeights: maxScheduleHeights + 1,
+	}, &reply)
+	require.ErrorIs(err, errTooManyHeights)
+
+	// The genesis block doesn't have proposers
+	err = s.GetProposerSchedule(&http.Request{}, &GetProposerScheduleArgs{
+		NumHeights: 1,
+	}, &reply)
+	require.ErrorIs(err, errProposersNotActivated)
+
+	coreBlk := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		BytesV:     []byte{1},
+		ParentV:    coreGenBlk.ID(),
+		HeightV:    coreGenBlk.Height() + 1,
+		TimestampV: coreGenBlk.Timestamp(),
+	}
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreBlk, nil
+	}
+	blk, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(proVM.SetPreference(context.Background(), blk.ID()))
+	blkPChainHeight, err := blk.(*postForkBlock).pChainHeight(context.Background())
+	require.NoError(err)
+
+	// This node would now propose blocks on a higher P-chain height
+	nextPChainHeight := blkPChainHeight + 1
+	valState.GetMinimumHeightF = func(context.Context) (uint64, error) {
+		return nextPChainHeight, nil
+	}
+
+	require.NoError(s.GetProposerSchedule(&http.Request{}, &GetProposerScheduleArgs{
+		NumHeights: 3,
+	}, &reply))
+	require.Equal(proVM.ctx.NodeID, reply.NodeID)
+	require.Len(reply.Windows, 3)
+	for i, window := range reply.Windows {
+		height := blk.Height() + uint64(i) + 1
+		require.Equal(json.Uint64(height), window.Height)
+
+		// Only the child of the preferred block is built on the P-chain height
+		// of the preferred block.
+		pChainHeight := nextPChainHeight
+		if i == 0 {
+			pChainHeight = blkPChainHeight
+		}
+		require.Equal(json.Uint64(pChainHeight), window.PChainHeight)
+
+		delay, err := proVM.Windower.Delay(context.Background(), height, pChainHeight, proVM.ctx.NodeID)
+		require.NoError(err)
+		require.Equal(json.Uint64(delay/time.Second), window.Delay)
+	}
+}
+
+func TestCreateHandlersAddsService(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, _, _ := initTestProposerVM(t, time.Time{}, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	innerHandler := &common.HTTPHandler{}
+	coreVM.CreateHandlersF = func(context.Context) (map[string]*common.HTTPHandler, error) {
+		return map[string]*common.HTTPHandler{
+			"/rpc": innerHandler,
+		}, nil
+	}
+
+	handlers, err := proVM.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.Len(handlers, 2)
+	require.Equal(innerHandler, handlers["/rpc"])
+	require.Contains(handlers, apiEndpoint)
+}
+
+func TestServiceGetProductionStats(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, valState, proVM, coreGenBlk, _ := initTestProposerVM(t, time.Time{}, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	s := &Service{vm: proVM}
+
+	// Each validator is sampled exactly once, so this node always has a window
+	nodeID := proVM.ctx.NodeID
+	otherNodeID := ids.GenerateTestNodeID()
+	valState.GetValidatorSetF = func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+		return map[ids.NodeID]*validators.GetValidatorOutput{
+			nodeID: {
+				NodeID: nodeID,
+				Weight: 1,
+			},
+			otherNodeID: {
+				NodeID: otherNodeID,
+				Weight: 1,
+			},
+		}, nil
+	}
+
+	coreBlk1 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		BytesV:     []byte{1},
+		ParentV:    coreGenBlk.ID(),
+		HeightV:    coreGenBlk.Height() + 1,
+		TimestampV: coreGenBlk.Timestamp(),
+	}
+	coreBlk2 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		BytesV:     []byte{2},
+		ParentV:    coreBlk1.ID(),
+		HeightV:    coreBlk1.Height() + 1,
+		TimestampV: coreBlk1.Timestamp(),
+	}
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case coreGenBlk.ID():
+			return coreGenBlk, nil
+		case coreBlk1.ID():
+			return coreBlk1, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		switch {
+		case bytes.Equal(b, coreGenBlk.Bytes()):
+			return coreGenBlk, nil
+		case bytes.Equal(b, coreBlk1.Bytes()):
+			return coreBlk1, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	// The first block after the fork doesn't have proposers
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreBlk1, nil
+	}
+	blk1, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk1.Verify(context.Background()))
+	require.NoError(proVM.SetPreference(context.Background(), blk1.ID()))
+	require.NoError(blk1.Accept(context.Background()))
+
+	reply := GetProductionStatsReply{}
+	require.NoError(s.GetProductionStats(&http.Request{}, &GetProductionStatsArgs{}, &reply))
+	require.Empty(reply.Stats)
+
+	// This node builds the next block 1 second into its window
+	pChainHeight, err := blk1.(*postForkBlock).pChainHeight(context.Background())
+	require.NoError(err)
+	delay, err := proVM.Windower.Delay(context.Background(), blk1.Height()+1, pChainHeight, nodeID)
+	require.NoError(err)
+	proVM.Set(blk1.Timestamp().Add(delay + time.Second))
+
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreBlk2, nil
+	}
+	blk2, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk2.Verify(context.Background()))
+	require.NoError(proVM.SetPreference(context.Background(), blk2.ID()))
+	require.NoError(blk2.Accept(context.Background()))
+
+	// If the other validator's window came first, it missed it
+	expectedStats := []ProductionStats{
+		{
+			NodeID:       nodeID,
+			Proposed:     1,
+			AverageDelay: 1,
+		},
+	}
+	if delay > 0 {
+		expectedStats = append(expectedStats, ProductionStats{
+			NodeID: otherNodeID,
+			Missed: 1,
+		})
+	}
+	slices.SortFunc(expectedStats, func(i, j ProductionStats) bool {
+		return i.NodeID.Less(j.NodeID)
+	})
+
+	require.NoError(s.GetProductionStats(&http.Request{}, &GetProductionStatsArgs{}, &reply))
+	require.Equal(expectedStats, reply.Stats)
+
+	unknownNodeID := ids.GenerateTestNodeID()
+	require.NoError(s.GetProductionStats(&http.Request{}, &GetProductionStatsArgs{
+		NodeIDs: []ids.NodeID{nodeID, unknownNodeID},
+	}, &reply))
+	require.Len(reply.Stats, 2)
+	for _, stats := range reply.Stats {
+		switch stats.NodeID {
+		case nodeID:
+			require.Equal(json.Uint64(1), stats.Proposed)
+		case unknownNodeID:
+			require.Zero(stats.Proposed)
+			require.Zero(stats.Missed)
+		}
+	}
+}
@@ -83,6 +83,7 @@ func helperBuildStateSyncTestObjects(t *testing.T) (*fullVM, *VM) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	ctx := snow.DefaultContextTest()
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gorilla/rpc/v2"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"go.uber.org/zap"
@@ -28,9 +30,12 @@ import (
 	"github.com/VidarSolutions/avalanchego/snow/engine/common"
 	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
 	"github.com/VidarSolutions/avalanchego/utils"
+	"github.com/VidarSolutions/avalanchego/utils/json"
 	"github.com/VidarSolutions/avalanchego/utils/math"
 	"github.com/VidarSolutions/avalanchego/utils/timer/mockable"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/builder"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/indexer"
+	"github.com/VidarSolutions/avalanchego/vms/proposervm/production"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/proposer"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/scheduler"
 	"github.com/VidarSolutions/avalanchego/vms/proposervm/state"
@@ -46,6 +51,10 @@ const (
 
 	checkIndexedFrequency = 10 * time.Second
 	innerBlkCacheSize     = 512
+
+	// apiEndpoint is the extension of the chain's endpoint that the proposervm
+	// API is served on.
+	apiEndpoint = "/proposervm"
 )
 
 var (
@@ -54,12 +63,14 @@ var (
 	_ block.HeightIndexedChainVM = (*VM)(nil)
 	_ block.StateSyncableVM      = (*VM)(nil)
 
-	dbPrefix = []byte("proposervm")
+	dbPrefix         = []byte("proposervm")
+	productionPrefix = []byte("production")
 )
 
 type VM struct {
 	block.ChainVM
 	blockBuilderVM block.BuildBlockWithContextChainVM
+	txsBuilderVM   block.BuildBlockWithTxsChainVM
 	batchedVM      block.BatchedChainVM
 	hVM            block.HeightIndexedChainVM
 	ssVM           block.StateSyncableVM
@@ -71,9 +82,13 @@ type VM struct {
 	stakingLeafSigner crypto.Signer
 	// block certificate
 	stakingCertLeaf *x509.Certificate
+	// proposes the content of the blocks built by this node, if not nil
+	externalBuilder builder.Builder
 
 	state.State
 	hIndexer indexer.HeightIndexer
+	// tracks how validators use their proposer windows
+	production production.Tracker
 
 	proposer.Windower
 	tree.Tree
@@ -109,6 +124,10 @@ type VM struct {
 
 // New performs best when [minBlkDelay] is whole seconds. This is because block
 // timestamps are only specific to the second.
+//
+// If [externalBuilder] isn't nil, it's asked for the content of each block
+// this node builds after the fork. The inner VM builds the block itself if
+// [externalBuilder] fails. [externalBuilder] is closed on shutdown.
 func New(
 	vm block.ChainVM,
 	activationTime time.Time,
@@ -116,14 +135,17 @@ func New(
 	minBlkDelay time.Duration,
 	stakingLeafSigner crypto.Signer,
 	stakingCertLeaf *x509.Certificate,
+	externalBuilder builder.Builder,
 ) *VM {
 	blockBuilderVM, _ := vm.(block.BuildBlockWithContextChainVM)
+	txsBuilderVM, _ := vm.(block.BuildBlockWithTxsChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	hVM, _ := vm.(block.HeightIndexedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
 	return &VM{
 		ChainVM:        vm,
 		blockBuilderVM: blockBuilderVM,
+		txsBuilderVM:   txsBuilderVM,
 		batchedVM:      batchedVM,
 		hVM:            hVM,
 		ssVM:           ssVM,
@@ -133,6 +155,7 @@ func New(
 		minBlkDelay:         minBlkDelay,
 		stakingLeafSigner:   stakingLeafSigner,
 		stakingCertLeaf:     stakingCertLeaf,
+		externalBuilder:     externalBuilder,
 	}
 }
 
@@ -181,6 +204,12 @@ func (vm *VM) Initialize(
 	}
 	vm.innerBlkCache = innerBlkCache
 
+	productionDB := prefixdb.New(productionPrefix, vm.db)
+	vm.production, err = production.NewTracker(productionDB, chainCtx.NodeID, registerer)
+	if err != nil {
+		return err
+	}
+
 	indexerDB := versiondb.New(vm.db)
 	// TODO: Use [state.NewMetered] here to populate additional metrics.
 	indexerState := state.New(indexerDB)
@@ -226,6 +255,14 @@ func (vm *VM) Initialize(
 func (vm *VM) Shutdown(ctx context.Context) error {
 	vm.onShutdown()
 
+	if vm.externalBuilder != nil {
+		if err := vm.externalBuilder.Close(); err != nil {
+			vm.ctx.Log.Warn("failed to close external block builder",
+				zap.Error(err),
+			)
+		}
+	}
+
 	if err := vm.db.Commit(); err != nil {
 		return err
 	}
@@ -253,6 +290,35 @@ func (vm *VM) SetState(ctx context.Context, newState snow.State) error {
 	return vm.setLastAcceptedMetadata(ctx)
 }
 
+// CreateHandlers adds the proposervm API to the handlers of the inner VM.
+func (vm *VM) CreateHandlers(ctx context.Context) (map[string]*common.HTTPHandler, error) {
+	handlers, err := vm.ChainVM.CreateHandlers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if handlers == nil {
+		handlers = make(map[string]*common.HTTPHandler, 1)
+	}
+	if _, ok := handlers[apiEndpoint]; ok {
+		vm.ctx.Log.Warn("not serving proposervm API",
+			zap.String("reason", "inner VM serves the same endpoint"),
+			zap.String("endpoint", apiEndpoint),
+		)
+		return handlers, nil
+	}
+
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	server.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	if err := server.RegisterService(&Service{vm: vm}, "proposervm"); err != nil {
+		return nil, err
+	}
+	handlers[apiEndpoint] = &common.HTTPHandler{
+		Handler: server,
+	}
+	return handlers, nil
+}
+
 func (vm *VM) BuildBlock(ctx context.Context) (snowman.Block, error) {
 	preferredBlock, err := vm.getBlock(ctx, vm.preferred)
 	if err != nil {
@@ -713,6 +779,49 @@ func (vm *VM) storePostForkBlock(blk PostForkBlock) error {
 	return vm.db.Commit()
 }
 
+// recordProduction updates the stats of the proposers of [blk], which is being
+// accepted. Blocks accepted while bootstrapping aren't recorded, because
+// fetching the historical validator sets would slow down bootstrapping.
+func (vm *VM) recordProduction(ctx context.Context, blk *postForkBlock) error {
+	if vm.consensusState != snow.NormalOp {
+		return nil
+	}
+
+	parentID := blk.Parent()
+	parent, err := vm.getBlock(ctx, parentID)
+	if err != nil {
+		vm.ctx.Log.Warn("not recording block production",
+			zap.String("reason", "couldn't get parent"),
+			zap.Stringer("blkID", blk.ID()),
+			zap.Stringer("parentID", parentID),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if _, ok := parent.(*preForkBlock); ok {
+		// The first block after the fork doesn't have proposers
+		return nil
+	}
+
+	parentPChainHeight, err := parent.pChainHeight(ctx)
+	if err != nil {
+		return err
+	}
+	proposers, err := vm.Windower.Proposers(ctx, blk.Height(), parentPChainHeight)
+	if err != nil {
+		vm.ctx.Log.Warn("not recording block production",
+			zap.String("reason", "couldn't get proposers"),
+			zap.Stringer("blkID", blk.ID()),
+			zap.Uint64("pChainHeight", parentPChainHeight),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	delay := blk.Timestamp().Sub(parent.Timestamp())
+	return vm.production.Record(proposers, blk.Proposer(), delay)
+}
+
 func (vm *VM) verifyAndRecordInnerBlk(ctx context.Context, blockCtx *block.Context, postFork PostForkBlock) error {
 	innerBlk := postFork.getInnerBlk()
 	postForkID := postFork.ID()
@@ -137,6 +137,7 @@ func initTestProposerVM(
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	valState := &validators.TestState{
@@ -938,6 +939,7 @@ func TestExpiredBuildBlock(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	valState := &validators.TestState{
@@ -1298,6 +1300,7 @@ func TestInnerVMRollback(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	err := proVM.Initialize(
@@ -1407,6 +1410,7 @@ func TestInnerVMRollback(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	err = proVM.Initialize(
@@ -1968,6 +1972,7 @@ func TestRejectedHeightNotIndexed(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	valState := &validators.TestState{
@@ -2183,6 +2188,7 @@ func TestRejectedOptionHeightNotIndexed(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	valState := &validators.TestState{
@@ -2349,6 +2355,7 @@ func TestVMInnerBlkCache(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	dummyDBManager := manager.NewMemDB(version.Semantic1_0_0)
@@ -2532,6 +2539,7 @@ func TestVM_VerifyBlockWithContext(t *testing.T) {
 		DefaultMinBlockDelay,
 		pTestCert.PrivateKey.(crypto.Signer),
 		pTestCert.Leaf,
+		nil,
 	)
 
 	dummyDBManager := manager.NewMemDB(version.Semantic1_0_0)
@@ -12,18 +12,20 @@ import (
 
 var (
 	errEnumToError = map[vmpb.Error]error{
-		vmpb.Error_ERROR_CLOSED:                       database.ErrClosed,
-		vmpb.Error_ERROR_NOT_FOUND:                    database.ErrNotFound,
-		vmpb.Error_ERROR_HEIGHT_INDEX_NOT_IMPLEMENTED: block.ErrHeightIndexedVMNotImplemented,
-		vmpb.Error_ERROR_HEIGHT_INDEX_INCOMPLETE:      block.ErrIndexIncomplete,
-		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED:   block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_CLOSED:                               database.ErrClosed,
+		vmpb.Error_ERROR_NOT_FOUND:                            database.ErrNotFound,
+		vmpb.Error_ERROR_HEIGHT_INDEX_NOT_IMPLEMENTED:         block.ErrHeightIndexedVMNotImplemented,
+		vmpb.Error_ERROR_HEIGHT_INDEX_INCOMPLETE:              block.ErrIndexIncomplete,
+		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED:           block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_BUILD_BLOCK_WITH_TXS_NOT_IMPLEMENTED: block.ErrBuildBlockWithTxsNotImplemented,
 	}
 	errorToErrEnum = map[error]vmpb.Error{
-		database.ErrClosed:                     vmpb.Error_ERROR_CLOSED,
-		database.ErrNotFound:                   vmpb.Error_ERROR_NOT_FOUND,
-		block.ErrHeightIndexedVMNotImplemented: vmpb.Error_ERROR_HEIGHT_INDEX_NOT_IMPLEMENTED,
-		block.ErrIndexIncomplete:               vmpb.Error_ERROR_HEIGHT_INDEX_INCOMPLETE,
-		block.ErrStateSyncableVMNotImplemented: vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		database.ErrClosed:                       vmpb.Error_ERROR_CLOSED,
+		database.ErrNotFound:                     vmpb.Error_ERROR_NOT_FOUND,
+		block.ErrHeightIndexedVMNotImplemented:   vmpb.Error_ERROR_HEIGHT_INDEX_NOT_IMPLEMENTED,
+		block.ErrIndexIncomplete:                 vmpb.Error_ERROR_HEIGHT_INDEX_INCOMPLETE,
+		block.ErrStateSyncableVMNotImplemented:   vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		block.ErrBuildBlockWithTxsNotImplemented: vmpb.Error_ERROR_BUILD_BLOCK_WITH_TXS_NOT_IMPLEMENTED,
 	}
 )
 
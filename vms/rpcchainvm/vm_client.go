@@ -19,7 +19,9 @@ import (
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -78,6 +80,7 @@ var (
 	_ block.BatchedChainVM               = (*VMClient)(nil)
 	_ block.HeightIndexedChainVM         = (*VMClient)(nil)
 	_ block.StateSyncableVM              = (*VMClient)(nil)
+	_ block.BuildBlockWithTxsChainVM     = (*VMClient)(nil)
 	_ prometheus.Gatherer                = (*VMClient)(nil)
 
 	_ snowman.Block           = (*blockClient)(nil)
@@ -254,6 +257,7 @@ func (vm *VMClient) Initialize(
 			BatchedUnmarshalBlock: vm.batchedParseBlock,
 			BuildBlock:            vm.buildBlock,
 			BuildBlockWithContext: vm.buildBlockWithContext,
+			BuildBlockWithTxs:     vm.buildBlockWithTxs,
 		},
 	)
 	if err != nil {
@@ -437,6 +441,25 @@ func (vm *VMClient) buildBlockWithContext(ctx context.Context, blockCtx *block.C
 	return vm.newBlockFromBuildBlock(resp)
 }
 
+func (vm *VMClient) buildBlockWithTxs(ctx context.Context, blockCtx *block.Context, txs [][]byte) (snowman.Block, error) {
+	resp, err := vm.client.BuildBlockWithTxs(ctx, &vmpb.BuildBlockWithTxsRequest{
+		PChainHeight: blockCtx.PChainHeight,
+		Txs:          txs,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		// The plugin was built before BuildBlockWithTxs was added to the
+		// protocol.
+		return nil, block.ErrBuildBlockWithTxsNotImplemented
+	}
+	if err != nil {
+		return nil, err
+	}
+	if errEnum := resp.Err; errEnum != vmpb.Error_ERROR_UNSPECIFIED {
+		return nil, errEnumToError[errEnum]
+	}
+	return vm.newBlockFromBuildBlock(resp.Block)
+}
+
 func (vm *VMClient) buildBlock(ctx context.Context) (snowman.Block, error) {
 	resp, err := vm.client.BuildBlock(ctx, &vmpb.BuildBlockRequest{})
 	if err != nil {
@@ -72,6 +72,8 @@ type VMServer struct {
 	hVM block.HeightIndexedChainVM
 	// If nil, the underlying VM doesn't implement the interface.
 	ssVM block.StateSyncableVM
+	// If nil, the underlying VM doesn't implement the interface.
+	tVM block.BuildBlockWithTxsChainVM
 
 	processMetrics prometheus.Gatherer
 	dbManager      manager.Manager
@@ -88,11 +90,13 @@ func NewServer(vm block.ChainVM) *VMServer {
 	bVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	hVM, _ := vm.(block.HeightIndexedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	tVM, _ := vm.(block.BuildBlockWithTxsChainVM)
 	return &VMServer{
 		vm:   vm,
 		bVM:  bVM,
 		hVM:  hVM,
 		ssVM: ssVM,
+		tVM:  tVM,
 	}
 }
 
@@ -425,9 +429,41 @@ func (vm *VMServer) BuildBlock(ctx context.Context, req *vmpb.BuildBlockRequest)
 	if err != nil {
 		return nil, err
 	}
+	return newBuildBlockResponse(ctx, blk)
+}
+
+func (vm *VMServer) BuildBlockWithTxs(ctx context.Context, req *vmpb.BuildBlockWithTxsRequest) (*vmpb.BuildBlockWithTxsResponse, error) {
+	var (
+		blk snowman.Block
+		err error
+	)
+	if vm.tVM != nil {
+		blk, err = vm.tVM.BuildBlockWithTxs(ctx, &block.Context{
+			PChainHeight: req.PChainHeight,
+		}, req.Txs)
+	} else {
+		err = block.ErrBuildBlockWithTxsNotImplemented
+	}
+
+	if err != nil {
+		return &vmpb.BuildBlockWithTxsResponse{
+			Err: errorToErrEnum[err],
+		}, errorToRPCError(err)
+	}
+
+	resp, err := newBuildBlockResponse(ctx, blk)
+	if err != nil {
+		return nil, err
+	}
+	return &vmpb.BuildBlockWithTxsResponse{
+		Block: resp,
+	}, nil
+}
 
+func newBuildBlockResponse(ctx context.Context, blk snowman.Block) (*vmpb.BuildBlockResponse, error) {
 	blkWithCtx, verifyWithCtx := blk.(block.WithVerifyContext)
 	if verifyWithCtx {
+		var err error
 		verifyWithCtx, err = blkWithCtx.ShouldVerifyWithContext(ctx)
 		if err != nil {
 			return nil, err
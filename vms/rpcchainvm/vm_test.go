@@ -39,6 +39,8 @@ const (
 	lastAcceptedBlockPostStateSummaryAcceptTestKey = "lastAcceptedBlockPostStateSummaryAcceptTest"
 	contextTestKey                                 = "contextTest"
 	batchedParseBlockCachingTestKey                = "batchedParseBlockCachingTest"
+	txsTestKey                                     = "txsTest"
+	txsDisabledTestKey                             = "txsDisabledTest"
 )
 
 var TestServerPluginMap = map[string]func(*testing.T, bool) (block.ChainVM, *gomock.Controller){
@@ -51,6 +53,8 @@ var TestServerPluginMap = map[string]func(*testing.T, bool) (block.ChainVM, *gom
 	lastAcceptedBlockPostStateSummaryAcceptTestKey: lastAcceptedBlockPostStateSummaryAcceptTestPlugin,
 	contextTestKey:                                 contextEnabledTestPlugin,
 	batchedParseBlockCachingTestKey:                batchedParseBlockCachingTestPlugin,
+	txsTestKey:                                     txsEnabledTestPlugin,
+	txsDisabledTestKey:                             txsDisabledTestPlugin,
 }
 
 // helperProcess helps with creating the subnet binary for testing.
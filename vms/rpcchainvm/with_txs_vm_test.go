@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/manager"
+	"github.com/VidarSolutions/avalanchego/snow"
+	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
+	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
+	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block/mocks"
+	"github.com/VidarSolutions/avalanchego/version"
+)
+
+var (
+	_ block.ChainVM                  = TxsEnabledVMMock{}
+	_ block.BuildBlockWithTxsChainVM = TxsEnabledVMMock{}
+
+	blockTxs = [][]byte{{1}, {2}}
+)
+
+type TxsEnabledVMMock struct {
+	*mocks.MockChainVM
+	*mocks.MockBuildBlockWithTxsChainVM
+}
+
+func txsEnabledTestPlugin(t *testing.T, loadExpectations bool) (block.ChainVM, *gomock.Controller) {
+	// test key is "txsTestKey"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	txsVM := TxsEnabledVMMock{
+		MockChainVM:                  mocks.NewMockChainVM(ctrl),
+		MockBuildBlockWithTxsChainVM: mocks.NewMockBuildBlockWithTxsChainVM(ctrl),
+	}
+
+	if loadExpectations {
+		txsBlock := snowman.NewMockBlock(ctrl)
+		gomock.InOrder(
+			// Initialize
+			txsVM.MockChainVM.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			txsVM.MockChainVM.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			txsVM.MockChainVM.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+
+			// BuildBlockWithTxs
+			txsVM.MockBuildBlockWithTxsChainVM.EXPECT().BuildBlockWithTxs(gomock.Any(), blockContext, blockTxs).Return(txsBlock, nil).Times(1),
+			txsBlock.EXPECT().ID().Return(blkID).Times(1),
+			txsBlock.EXPECT().Parent().Return(parentID).Times(1),
+			txsBlock.EXPECT().Bytes().Return(blkBytes).Times(1),
+			txsBlock.EXPECT().Height().Return(uint64(1)).Times(1),
+			txsBlock.EXPECT().Timestamp().Return(time.Now()).Times(1),
+		)
+	}
+
+	return txsVM, ctrl
+}
+
+func txsDisabledTestPlugin(t *testing.T, loadExpectations bool) (block.ChainVM, *gomock.Controller) {
+	// test key is "txsDisabledTestKey"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	chainVM := mocks.NewMockChainVM(ctrl)
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			chainVM.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			chainVM.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			chainVM.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return chainVM, ctrl
+}
+
+func TestTxsVMBuildBlock(t *testing.T) {
+	require := require.New(t)
+	testKey := txsTestKey
+
+	// Create and start the plugin
+	vm, stopper := buildClientHelper(require, testKey)
+	defer stopper.Stop(context.Background())
+
+	ctx := snow.DefaultContextTest()
+	dbManager := manager.NewMemDB(version.Semantic1_0_0)
+
+	err := vm.Initialize(context.Background(), ctx, dbManager, nil, nil, nil, nil, nil, nil)
+	require.NoError(err)
+
+	blk, err := vm.BuildBlockWithTxs(context.Background(), blockContext, blockTxs)
+	require.NoError(err)
+	require.Equal(blkID, blk.ID())
+	require.Equal(parentID, blk.Parent())
+	require.Equal(blkBytes, blk.Bytes())
+}
+
+func TestTxsVMNotImplemented(t *testing.T) {
+	require := require.New(t)
+	testKey := txsDisabledTestKey
+
+	// Create and start the plugin
+	vm, stopper := buildClientHelper(require, testKey)
+	defer stopper.Stop(context.Background())
+
+	ctx := snow.DefaultContextTest()
+	dbManager := manager.NewMemDB(version.Semantic1_0_0)
+
+	err := vm.Initialize(context.Background(), ctx, dbManager, nil, nil, nil, nil, nil, nil)
+	require.NoError(err)
+
+	_, err = vm.BuildBlockWithTxs(context.Background(), blockContext, blockTxs)
+	require.ErrorIs(err, block.ErrBuildBlockWithTxsNotImplemented)
+}
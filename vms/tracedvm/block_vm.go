@@ -26,6 +26,7 @@ var (
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.HeightIndexedChainVM         = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.BuildBlockWithTxsChainVM     = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -34,6 +35,7 @@ type blockVM struct {
 	batchedVM    block.BatchedChainVM
 	hVM          block.HeightIndexedChainVM
 	ssVM         block.StateSyncableVM
+	txsVM        block.BuildBlockWithTxsChainVM
 	// ChainVM tags
 	initializeTag              string
 	buildBlockTag              string
@@ -61,7 +63,9 @@ type blockVM struct {
 	getLastStateSummaryTag        string
 	parseStateSummaryTag          string
 	getStateSummaryTag            string
-	tracer                        trace.Tracer
+	// BuildBlockWithTxsChainVM tags
+	buildBlockWithTxsTag string
+	tracer               trace.Tracer
 }
 
 func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainVM {
@@ -69,12 +73,14 @@ func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainV
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	hVM, _ := vm.(block.HeightIndexedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	txsVM, _ := vm.(block.BuildBlockWithTxsChainVM)
 	return &blockVM{
 		ChainVM:                       vm,
 		buildBlockVM:                  buildBlockVM,
 		batchedVM:                     batchedVM,
 		hVM:                           hVM,
 		ssVM:                          ssVM,
+		txsVM:                         txsVM,
 		initializeTag:                 fmt.Sprintf("%s.initialize", name),
 		buildBlockTag:                 fmt.Sprintf("%s.buildBlock", name),
 		parseBlockTag:                 fmt.Sprintf("%s.parseBlock", name),
@@ -97,6 +103,7 @@ func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainV
 		getLastStateSummaryTag:        fmt.Sprintf("%s.getLastStateSummary", name),
 		parseStateSummaryTag:          fmt.Sprintf("%s.parseStateSummary", name),
 		getStateSummaryTag:            fmt.Sprintf("%s.getStateSummary", name),
+		buildBlockWithTxsTag:          fmt.Sprintf("%s.buildBlockWithTxs", name),
 		tracer:                        tracer,
 	}
 }
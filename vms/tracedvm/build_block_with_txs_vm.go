@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracedvm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/VidarSolutions/avalanchego/snow/consensus/snowman"
+	"github.com/VidarSolutions/avalanchego/snow/engine/snowman/block"
+)
+
+func (vm *blockVM) BuildBlockWithTxs(ctx context.Context, blockCtx *block.Context, txs [][]byte) (snowman.Block, error) {
+	if vm.txsVM == nil {
+		return nil, block.ErrBuildBlockWithTxsNotImplemented
+	}
+
+	ctx, span := vm.tracer.Start(ctx, vm.buildBlockWithTxsTag, oteltrace.WithAttributes(
+		attribute.Int64("pChainHeight", int64(blockCtx.PChainHeight)),
+		attribute.Int("numTxs", len(txs)),
+	))
+	defer span.End()
+
+	blk, err := vm.txsVM.BuildBlockWithTxs(ctx, blockCtx, txs)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedBlock{
+		Block: blk,
+		vm:    vm,
+	}, nil
+}
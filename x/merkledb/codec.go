@@ -7,11 +7,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
-	"fmt"
 	"io"
 	"math"
 	"sync"
 
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/utils/hashing"
 )
@@ -37,6 +39,10 @@ const (
 	minHashValuesLen     = minVarIntLen + minMaybeByteSliceLen + minSerializedPathLen
 	minProofNodeChildLen = minVarIntLen + idLen
 	minChildLen          = minVarIntLen + minSerializedPathLen + idLen
+	minMultiProofLen     = minProofPathLen + minVarIntLen
+	minMultiProofKeyLen  = minByteSliceLen + minMaybeByteSliceLen
+	minChangeSummaryLen  = idLen + 2*minVarIntLen
+	minChangeLen         = minSerializedPathLen + 2*minMaybeByteSliceLen
 )
 
 var (
@@ -50,16 +56,19 @@ var (
 	errDecodeNil              = errors.New("can't decode nil")
 	errNegativeProofPathNodes = errors.New("negative proof path length")
 	errNegativeNumChildren    = errors.New("number of children is negative")
-	errTooManyChildren        = fmt.Errorf("length of children list is larger than branching factor of %d", NodeBranchFactor)
-	errChildIndexTooLarge     = fmt.Errorf("invalid child index. Must be less than branching factor of %d", NodeBranchFactor)
-	errNegativeNibbleLength   = errors.New("nibble length is negative")
+	errTooManyChildren        = errors.New("length of children list is larger than branching factor")
+	errChildIndexTooLarge     = errors.New("invalid child index. Must be less than branching factor")
+	errNegativeTokenLength    = errors.New("token length is negative")
 	errNegativeNumKeyValues   = errors.New("negative number of key values")
+	errKeysValuesMismatch     = errors.New("number of keys and values differ")
 	errIntTooLarge            = errors.New("integer too large to be decoded")
 	errLeadingZeroes          = errors.New("varint has leading zeroes")
 	errInvalidBool            = errors.New("decoded bool is neither true nor false")
-	errNonZeroNibblePadding   = errors.New("nibbles should be padded with 0s")
+	errNonZeroTokenPadding    = errors.New("tokens should be padded with 0s")
 	errExtraSpace             = errors.New("trailing buffer space")
 	errNegativeSliceLength    = errors.New("negative slice length")
+	errNegativeNumChanges     = errors.New("negative number of changes")
+	errDuplicateChange        = errors.New("duplicate change")
 )
 
 // EncoderDecoder defines the interface needed by merkleDB to marshal
@@ -74,21 +83,36 @@ type Encoder interface {
 	EncodeProof(version uint16, p *Proof) ([]byte, error)
 	EncodeChangeProof(version uint16, p *ChangeProof) ([]byte, error)
 	EncodeRangeProof(version uint16, p *RangeProof) ([]byte, error)
+	EncodeMultiProof(version uint16, p *MultiProof) ([]byte, error)
 
 	encodeDBNode(version uint16, n *dbNode) ([]byte, error)
 	encodeHashValues(version uint16, hv *hashValues) ([]byte, error)
+	encodeChangeSummary(version uint16, cs *changeSummary) ([]byte, error)
 }
 
 type Decoder interface {
 	DecodeProof(bytes []byte, p *Proof) (uint16, error)
 	DecodeChangeProof(bytes []byte, p *ChangeProof) (uint16, error)
 	DecodeRangeProof(bytes []byte, p *RangeProof) (uint16, error)
+	DecodeMultiProof(bytes []byte, p *MultiProof) (uint16, error)
 
 	decodeDBNode(bytes []byte, n *dbNode) (uint16, error)
+	decodeChangeSummary(bytes []byte, cs *changeSummary) (uint16, error)
+}
+
+// NewCodec returns a codec for the proofs of a trie with [branchFactor].
+func NewCodec(branchFactor BranchFactor) (EncoderDecoder, error) {
+	if err := branchFactor.Valid(); err != nil {
+		return nil, err
+	}
+	codec, _ := newCodec(branchFactor)
+	return codec, nil
 }
 
-func newCodec() (EncoderDecoder, uint16) {
+// Assumes [branchFactor] is valid.
+func newCodec(branchFactor BranchFactor) (EncoderDecoder, uint16) {
 	return &codecImpl{
+		branchFactor: branchFactor,
 		varIntPool: sync.Pool{
 			New: func() interface{} {
 				return make([]byte, binary.MaxVarintLen64)
@@ -98,7 +122,10 @@ func newCodec() (EncoderDecoder, uint16) {
 }
 
 type codecImpl struct {
-	varIntPool sync.Pool
+	// The paths of encoded nodes and proofs are in a trie with this branch
+	// factor.
+	branchFactor BranchFactor
+	varIntPool   sync.Pool
 }
 
 func (c *codecImpl) EncodeProof(version uint16, proof *Proof) ([]byte, error) {
@@ -191,6 +218,37 @@ func (c *codecImpl) EncodeRangeProof(version uint16, proof *RangeProof) ([]byte,
 	return buf.Bytes(), nil
 }
 
+func (c *codecImpl) EncodeMultiProof(version uint16, proof *MultiProof) ([]byte, error) {
+	if proof == nil {
+		return nil, errEncodeNil
+	}
+
+	if version != codecVersion {
+		return nil, errUnknownVersion
+	}
+
+	if len(proof.Keys) != len(proof.Values) {
+		return nil, errKeysValuesMismatch
+	}
+
+	buf := &bytes.Buffer{}
+	if err := c.encodeProofPath(buf, proof.Nodes); err != nil {
+		return nil, err
+	}
+	if err := c.encodeInt(buf, len(proof.Keys)); err != nil {
+		return nil, err
+	}
+	for i, key := range proof.Keys {
+		if err := c.encodeByteSlice(buf, key); err != nil {
+			return nil, err
+		}
+		if err := c.encodeMaybeByteSlice(buf, proof.Values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
 func (c *codecImpl) encodeDBNode(version uint16, n *dbNode) ([]byte, error) {
 	if n == nil {
 		return nil, errEncodeNil
@@ -208,12 +266,12 @@ func (c *codecImpl) encodeDBNode(version uint16, n *dbNode) ([]byte, error) {
 	if err := c.encodeInt(buf, childrenLength); err != nil {
 		return nil, err
 	}
-	for index := byte(0); index < NodeBranchFactor; index++ {
-		if entry, ok := n.children[index]; ok {
-			if err := c.encodeInt(buf, int(index)); err != nil {
+	for index := 0; index < int(c.branchFactor); index++ {
+		if entry, ok := n.children[byte(index)]; ok {
+			if err := c.encodeInt(buf, index); err != nil {
 				return nil, err
 			}
-			path := entry.compressedPath.Serialize()
+			path := entry.compressedPath.Serialize(c.branchFactor)
 			if err := c.encodeSerializedPath(path, buf); err != nil {
 				return nil, err
 			}
@@ -241,9 +299,9 @@ func (c *codecImpl) encodeHashValues(version uint16, hv *hashValues) ([]byte, er
 	}
 
 	// ensure that the order of entries is consistent
-	for index := byte(0); index < NodeBranchFactor; index++ {
-		if entry, ok := hv.Children[index]; ok {
-			if err := c.encodeInt(buf, int(index)); err != nil {
+	for index := 0; index < int(c.branchFactor); index++ {
+		if entry, ok := hv.Children[byte(index)]; ok {
+			if err := c.encodeInt(buf, index); err != nil {
 				return nil, err
 			}
 			if _, err := buf.Write(entry.id[:]); err != nil {
@@ -254,10 +312,68 @@ func (c *codecImpl) encodeHashValues(version uint16, hv *hashValues) ([]byte, er
 	if err := c.encodeMaybeByteSlice(buf, hv.Value); err != nil {
 		return nil, err
 	}
-	if err := c.encodeSerializedPath(hv.Key, buf); err != nil {
+	if err := c.encodeSerializedPath(hv.Key.Serialize(c.branchFactor), buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Node and value changes are encoded in increasing order of their keys so that
+// the encoding is deterministic.
+func (c *codecImpl) encodeChangeSummary(version uint16, cs *changeSummary) ([]byte, error) {
+	if cs == nil {
+		return nil, errEncodeNil
+	}
+
+	if version != codecVersion {
+		return nil, errUnknownVersion
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := buf.Write(cs.rootID[:]); err != nil {
+		return nil, err
+	}
+
+	nodeKeys := maps.Keys(cs.nodes)
+	slices.SortFunc(nodeKeys, func(i, j path) bool {
+		return i.Compare(j) < 0
+	})
+	if err := c.encodeInt(buf, len(nodeKeys)); err != nil {
 		return nil, err
 	}
+	for _, key := range nodeKeys {
+		nodeChange := cs.nodes[key]
+		if err := c.encodeSerializedPath(key.Serialize(c.branchFactor), buf); err != nil {
+			return nil, err
+		}
+		if err := c.encodeMaybeNode(buf, nodeChange.before); err != nil {
+			return nil, err
+		}
+		if err := c.encodeMaybeNode(buf, nodeChange.after); err != nil {
+			return nil, err
+		}
+	}
 
+	valueKeys := maps.Keys(cs.values)
+	slices.SortFunc(valueKeys, func(i, j path) bool {
+		return i.Compare(j) < 0
+	})
+	if err := c.encodeInt(buf, len(valueKeys)); err != nil {
+		return nil, err
+	}
+	for _, key := range valueKeys {
+		valueChange := cs.values[key]
+		if err := c.encodeSerializedPath(key.Serialize(c.branchFactor), buf); err != nil {
+			return nil, err
+		}
+		if err := c.encodeMaybeByteSlice(buf, valueChange.before); err != nil {
+			return nil, err
+		}
+		if err := c.encodeMaybeByteSlice(buf, valueChange.after); err != nil {
+			return nil, err
+		}
+	}
 	return buf.Bytes(), nil
 }
 
@@ -393,6 +509,49 @@ func (c *codecImpl) DecodeRangeProof(b []byte, proof *RangeProof) (uint16, error
 	return codecVersion, nil
 }
 
+func (c *codecImpl) DecodeMultiProof(b []byte, proof *MultiProof) (uint16, error) {
+	if proof == nil {
+		return 0, errDecodeNil
+	}
+	if minMultiProofLen > len(b) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	var (
+		src = bytes.NewReader(b)
+		err error
+	)
+
+	if proof.Nodes, err = c.decodeProofPath(src); err != nil {
+		return 0, err
+	}
+
+	numKeys, err := c.decodeInt(src)
+	if err != nil {
+		return 0, err
+	}
+	if numKeys < 0 {
+		return 0, errNegativeNumKeyValues
+	}
+	if numKeys > src.Len()/minMultiProofKeyLen {
+		return 0, io.ErrUnexpectedEOF
+	}
+	proof.Keys = make([][]byte, numKeys)
+	proof.Values = make([]Maybe[[]byte], numKeys)
+	for i := range proof.Keys {
+		if proof.Keys[i], err = c.decodeByteSlice(src); err != nil {
+			return 0, err
+		}
+		if proof.Values[i], err = c.decodeMaybeByteSlice(src); err != nil {
+			return 0, err
+		}
+	}
+	if src.Len() != 0 {
+		return 0, errExtraSpace
+	}
+	return codecVersion, nil
+}
+
 func (c *codecImpl) decodeDBNode(b []byte, n *dbNode) (uint16, error) {
 	if n == nil {
 		return 0, errDecodeNil
@@ -417,20 +576,20 @@ func (c *codecImpl) decodeDBNode(b []byte, n *dbNode) (uint16, error) {
 	switch {
 	case numChildren < 0:
 		return 0, errNegativeNumChildren
-	case numChildren > NodeBranchFactor:
+	case numChildren > int(c.branchFactor):
 		return 0, errTooManyChildren
 	case numChildren > src.Len()/minChildLen:
 		return 0, io.ErrUnexpectedEOF
 	}
 
-	n.children = make(map[byte]child, NodeBranchFactor)
+	n.children = make(map[byte]child, numChildren)
 	previousChild := -1
 	for i := 0; i < numChildren; i++ {
 		var index int
 		if index, err = c.decodeInt(src); err != nil {
 			return 0, err
 		}
-		if index <= previousChild || index > NodeBranchFactor-1 {
+		if index <= previousChild || index >= int(c.branchFactor) {
 			return 0, errChildIndexTooLarge
 		}
 		previousChild = index
@@ -444,7 +603,7 @@ func (c *codecImpl) decodeDBNode(b []byte, n *dbNode) (uint16, error) {
 			return 0, err
 		}
 		n.children[byte(index)] = child{
-			compressedPath: compressedPath.deserialize(),
+			compressedPath: compressedPath.deserialize(c.branchFactor),
 			id:             childID,
 		}
 	}
@@ -454,6 +613,117 @@ func (c *codecImpl) decodeDBNode(b []byte, n *dbNode) (uint16, error) {
 	return codecVersion, err
 }
 
+// The decoded nodes don't have their IDs or value digests calculated.
+func (c *codecImpl) decodeChangeSummary(b []byte, cs *changeSummary) (uint16, error) {
+	if cs == nil {
+		return 0, errDecodeNil
+	}
+	if minChangeSummaryLen > len(b) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	var (
+		src = bytes.NewReader(b)
+		err error
+	)
+
+	if cs.rootID, err = c.decodeID(src); err != nil {
+		return 0, err
+	}
+
+	numNodes, err := c.decodeInt(src)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case numNodes < 0:
+		return 0, errNegativeNumChanges
+	case numNodes > src.Len()/minChangeLen:
+		return 0, io.ErrUnexpectedEOF
+	}
+	cs.nodes = make(map[path]*change[*node], numNodes)
+	for i := 0; i < numNodes; i++ {
+		serializedKey, err := c.decodeSerializedPath(src)
+		if err != nil {
+			return 0, err
+		}
+		key := serializedKey.deserialize(c.branchFactor)
+		if _, ok := cs.nodes[key]; ok {
+			return 0, errDuplicateChange
+		}
+		nodeChange := &change[*node]{}
+		if nodeChange.before, err = c.decodeMaybeNode(src, key); err != nil {
+			return 0, err
+		}
+		if nodeChange.after, err = c.decodeMaybeNode(src, key); err != nil {
+			return 0, err
+		}
+		cs.nodes[key] = nodeChange
+	}
+
+	numValues, err := c.decodeInt(src)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case numValues < 0:
+		return 0, errNegativeNumChanges
+	case numValues > src.Len()/minChangeLen:
+		return 0, io.ErrUnexpectedEOF
+	}
+	cs.values = make(map[path]*change[Maybe[[]byte]], numValues)
+	for i := 0; i < numValues; i++ {
+		serializedKey, err := c.decodeSerializedPath(src)
+		if err != nil {
+			return 0, err
+		}
+		key := serializedKey.deserialize(c.branchFactor)
+		if _, ok := cs.values[key]; ok {
+			return 0, errDuplicateChange
+		}
+		valueChange := &change[Maybe[[]byte]]{}
+		if valueChange.before, err = c.decodeMaybeByteSlice(src); err != nil {
+			return 0, err
+		}
+		if valueChange.after, err = c.decodeMaybeByteSlice(src); err != nil {
+			return 0, err
+		}
+		cs.values[key] = valueChange
+	}
+	if src.Len() != 0 {
+		return 0, errExtraSpace
+	}
+	return codecVersion, nil
+}
+
+// A nil node is encoded as Nothing.
+func (c *codecImpl) encodeMaybeNode(dst io.Writer, n *node) error {
+	if n == nil {
+		return c.encodeMaybeByteSlice(dst, Nothing[[]byte]())
+	}
+	nodeBytes, err := n.marshal(c)
+	if err != nil {
+		return err
+	}
+	return c.encodeMaybeByteSlice(dst, Some(nodeBytes))
+}
+
+// The decoded node doesn't have its ID or value digest calculated.
+func (c *codecImpl) decodeMaybeNode(src *bytes.Reader, key path) (*node, error) {
+	nodeBytes, err := c.decodeMaybeByteSlice(src)
+	if err != nil || nodeBytes.IsNothing() {
+		return nil, err
+	}
+	n := &node{
+		key:       key,
+		nodeBytes: nodeBytes.Value(),
+	}
+	if _, err := c.decodeDBNode(n.nodeBytes, &n.dbNode); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 func (c *codecImpl) decodeKeyValue(src *bytes.Reader) (KeyValue, error) {
 	if minKeyValueLen > src.Len() {
 		return KeyValue{}, io.ErrUnexpectedEOF
@@ -706,7 +976,7 @@ func (c *codecImpl) decodeProofNode(src *bytes.Reader) (ProofNode, error) {
 	switch {
 	case numChildren < 0:
 		return result, errNegativeNumChildren
-	case numChildren > NodeBranchFactor:
+	case numChildren > int(c.branchFactor):
 		return result, errTooManyChildren
 	case numChildren > src.Len()/minProofNodeChildLen:
 		return result, io.ErrUnexpectedEOF
@@ -719,7 +989,7 @@ func (c *codecImpl) decodeProofNode(src *bytes.Reader) (ProofNode, error) {
 		if err != nil {
 			return result, err
 		}
-		if index <= previousChild || index >= NodeBranchFactor {
+		if index <= previousChild || index >= int(c.branchFactor) {
 			return result, errChildIndexTooLarge
 		}
 		previousChild = index
@@ -745,20 +1015,20 @@ func (c *codecImpl) encodeProofNode(pn ProofNode, dst io.Writer) error {
 	}
 	// ensure this is in order
 	childrenCount := 0
-	for index := byte(0); index < NodeBranchFactor; index++ {
-		childID, ok := pn.Children[index]
+	for index := 0; index < int(c.branchFactor); index++ {
+		childID, ok := pn.Children[byte(index)]
 		if !ok {
 			continue
 		}
 		childrenCount++
-		if err := c.encodeInt(dst, int(index)); err != nil {
+		if err := c.encodeInt(dst, index); err != nil {
 			return err
 		}
 		if _, err := dst.Write(childID[:]); err != nil {
 			return err
 		}
 	}
-	// there are children present with index >= NodeBranchFactor
+	// there are children present with index >= [c.branchFactor]
 	if childrenCount != len(pn.Children) {
 		return errChildIndexTooLarge
 	}
@@ -766,7 +1036,7 @@ func (c *codecImpl) encodeProofNode(pn ProofNode, dst io.Writer) error {
 }
 
 func (c *codecImpl) encodeSerializedPath(s SerializedPath, dst io.Writer) error {
-	if err := c.encodeInt(dst, s.NibbleLength); err != nil {
+	if err := c.encodeInt(dst, s.TokenLength); err != nil {
 		return err
 	}
 	_, err := dst.Write(s.Value)
@@ -782,17 +1052,20 @@ func (c *codecImpl) decodeSerializedPath(src *bytes.Reader) (SerializedPath, err
 		result SerializedPath
 		err    error
 	)
-	if result.NibbleLength, err = c.decodeInt(src); err != nil {
+	if result.TokenLength, err = c.decodeInt(src); err != nil {
 		return result, err
 	}
-	if result.NibbleLength < 0 {
-		return result, errNegativeNibbleLength
+	if result.TokenLength < 0 {
+		return result, errNegativeTokenLength
 	}
-	pathBytesLen := result.NibbleLength >> 1
-	hasOddLen := result.hasOddLength()
-	if hasOddLen {
-		pathBytesLen++
+	// checked before calculating the byte length so it can't overflow
+	if result.TokenLength > src.Len()*c.branchFactor.tokensPerByte() {
+		return result, io.ErrUnexpectedEOF
 	}
+	// round up so there is a byte for the trailing tokens if they don't fill
+	// a whole byte
+	pathBitsLen := result.TokenLength * c.branchFactor.tokenBits()
+	pathBytesLen := (pathBitsLen + 7) / 8
 	if pathBytesLen > src.Len() {
 		return result, io.ErrUnexpectedEOF
 	}
@@ -803,10 +1076,10 @@ func (c *codecImpl) decodeSerializedPath(src *bytes.Reader) (SerializedPath, err
 		}
 		return result, err
 	}
-	if hasOddLen {
-		paddedNibble := result.Value[pathBytesLen-1] & 0x0F
-		if paddedNibble != 0 {
-			return result, errNonZeroNibblePadding
+	if remainderBits := pathBitsLen % 8; remainderBits != 0 {
+		paddedBits := result.Value[pathBytesLen-1] & (0xFF >> remainderBits)
+		if paddedBits != 0 {
+			return result, errNonZeroTokenPadding
 		}
 	}
 	return result, nil
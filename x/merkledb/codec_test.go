@@ -5,6 +5,7 @@ package merkledb
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"math/rand"
 	"reflect"
@@ -25,7 +26,7 @@ func newRandomProofNode(r *rand.Rand) ProofNode {
 	_, _ = r.Read(val)              // #nosec G404
 
 	children := map[byte]ids.ID{}
-	for j := 0; j < NodeBranchFactor; j++ {
+	for j := 0; j < int(BranchFactor16); j++ {
 		if r.Float64() < 0.5 {
 			var childID ids.ID
 			_, _ = r.Read(childID[:]) // #nosec G404
@@ -46,7 +47,7 @@ func newRandomProofNode(r *rand.Rand) ProofNode {
 	}
 
 	return ProofNode{
-		KeyPath:     newPath(key).Serialize(),
+		KeyPath:     newPath(key, BranchFactor16).Serialize(BranchFactor16),
 		ValueOrHash: Some(val),
 		Children:    children,
 	}
@@ -199,7 +200,7 @@ func FuzzCodecSerializedPath(f *testing.F) {
 			require.Len(bufBytes, numRead)
 			require.Equal(b[:numRead], bufBytes)
 
-			clonedGot := got.deserialize().Serialize()
+			clonedGot := got.deserialize(BranchFactor16).Serialize(BranchFactor16)
 			require.Equal(got, clonedGot)
 		},
 	)
@@ -313,6 +314,29 @@ func FuzzCodecRangeProofCanonical(f *testing.F) {
 	)
 }
 
+func FuzzCodecMultiProofCanonical(f *testing.F) {
+	f.Fuzz(
+		func(
+			t *testing.T,
+			b []byte,
+		) {
+			require := require.New(t)
+
+			codec := Codec.(*codecImpl)
+			proof := &MultiProof{}
+			got, err := codec.DecodeMultiProof(b, proof)
+			if err != nil {
+				return
+			}
+
+			// Encoding [proof] should be the same as [b].
+			buf, err := codec.EncodeMultiProof(got, proof)
+			require.NoError(err)
+			require.Equal(b, buf)
+		},
+	)
+}
+
 func FuzzCodecDBNodeCanonical(f *testing.F) {
 	f.Fuzz(
 		func(
@@ -490,6 +514,59 @@ func FuzzCodecRangeProofDeterministic(f *testing.F) {
 	)
 }
 
+func FuzzCodecMultiProofDeterministic(f *testing.F) {
+	f.Fuzz(
+		func(
+			t *testing.T,
+			randSeed int,
+			numProofNodes uint,
+			numKeys uint,
+		) {
+			r := rand.New(rand.NewSource(int64(randSeed))) // #nosec G404
+
+			proofNodes := make([]ProofNode, numProofNodes)
+			for i := range proofNodes {
+				proofNodes[i] = newRandomProofNode(r)
+			}
+
+			keys := make([][]byte, numKeys)
+			values := make([]Maybe[[]byte], numKeys)
+			for i := range keys {
+				keys[i] = make([]byte, r.Intn(32)) // #nosec G404
+				_, _ = r.Read(keys[i])             // #nosec G404
+				if r.Intn(2) == 0 {                // #nosec G404
+					continue
+				}
+				// Empty values are decoded as nil, so they are never generated.
+				val := make([]byte, r.Intn(32)+1) // #nosec G404
+				_, _ = r.Read(val)                // #nosec G404
+				values[i] = Some(val)
+			}
+
+			proof := MultiProof{
+				Nodes:  proofNodes,
+				Keys:   keys,
+				Values: values,
+			}
+
+			proofBytes, err := Codec.EncodeMultiProof(Version, &proof)
+			require.NoError(t, err)
+
+			var gotProof MultiProof
+			_, err = Codec.DecodeMultiProof(proofBytes, &gotProof)
+			require.NoError(t, err)
+
+			nilEmptySlices(&proof)
+			nilEmptySlices(&gotProof)
+			require.Equal(t, proof, gotProof)
+
+			proofBytes2, err := Codec.EncodeMultiProof(Version, &gotProof)
+			require.NoError(t, err)
+			require.Equal(t, proofBytes, proofBytes2)
+		},
+	)
+}
+
 func FuzzCodecDBNodeDeterministic(f *testing.F) {
 	f.Fuzz(
 		func(
@@ -516,7 +593,7 @@ func FuzzCodecDBNodeDeterministic(f *testing.F) {
 				value = Some(valueBytes)
 			}
 
-			numChildren := r.Intn(NodeBranchFactor) // #nosec G404
+			numChildren := r.Intn(int(BranchFactor16)) // #nosec G404
 
 			children := map[byte]child{}
 			for i := 0; i < numChildren; i++ {
@@ -527,7 +604,7 @@ func FuzzCodecDBNodeDeterministic(f *testing.F) {
 				_, _ = r.Read(childPathBytes)              // #nosec G404
 
 				children[byte(i)] = child{
-					compressedPath: newPath(childPathBytes),
+					compressedPath: newPath(childPathBytes, BranchFactor16),
 					id:             childID,
 				}
 			}
@@ -689,10 +766,54 @@ func TestCodec_DecodeDBNode(t *testing.T) {
 	nodeBytes = proofBytesBuf.Bytes()
 	nodeBytes = nodeBytes[:len(nodeBytes)-minVarIntLen]
 	proofBytesBuf = bytes.NewBuffer(nodeBytes)
-	// Put num children NodeBranchFactor+1 at end
-	err = Codec.(*codecImpl).encodeInt(proofBytesBuf, NodeBranchFactor+1)
+	// Put num children BranchFactor16+1 at end
+	err = Codec.(*codecImpl).encodeInt(proofBytesBuf, int(BranchFactor16)+1)
 	require.NoError(err)
 
 	_, err = Codec.decodeDBNode(proofBytesBuf.Bytes(), &parsedDBNode)
 	require.ErrorIs(err, errTooManyChildren)
 }
+
+func TestCodec_ChangeSummary(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	view, err := db.NewView()
+	require.NoError(err)
+	require.NoError(view.Insert(context.Background(), []byte{1}, []byte{1}))
+	require.NoError(view.Insert(context.Background(), []byte{1, 2}, nil))
+	require.NoError(view.Remove(context.Background(), []byte{3}))
+	_, err = view.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	changes := view.(*trieView).changes
+	changesBytes, err := Codec.encodeChangeSummary(Version, changes)
+	require.NoError(err)
+
+	parsedChanges := &changeSummary{}
+	_, err = Codec.decodeChangeSummary(changesBytes, parsedChanges)
+	require.NoError(err)
+	require.Equal(changes.rootID, parsedChanges.rootID)
+	require.Equal(changes.values, parsedChanges.values)
+	require.Len(parsedChanges.nodes, len(changes.nodes))
+	for key, nodeChange := range changes.nodes {
+		parsedNodeChange := parsedChanges.nodes[key]
+		require.NotNil(parsedNodeChange)
+		require.Equal(nodeChange.before == nil, parsedNodeChange.before == nil)
+		// The codec doesn't know the hasher, so the value digest is set here.
+		parsedNodeChange.after.setValueDigest(DefaultHasher)
+		require.NoError(parsedNodeChange.after.calculateID(Codec, DefaultHasher, &mockMetrics{}))
+		require.Equal(nodeChange.after.id, parsedNodeChange.after.id)
+	}
+
+	_, err = Codec.decodeChangeSummary(changesBytes, nil)
+	require.ErrorIs(err, errDecodeNil)
+
+	_, err = Codec.decodeChangeSummary(changesBytes[:minChangeSummaryLen-1], parsedChanges)
+	require.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	_, err = Codec.decodeChangeSummary(append(changesBytes, 0), parsedChanges)
+	require.ErrorIs(err, errExtraSpace)
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -41,15 +42,23 @@ var (
 	_ Trie              = &Database{}
 	_ database.Database = &Database{}
 
-	Codec, Version = newCodec()
+	// Codec is the codec for the proofs of tries with the default branch
+	// factor.
+	Codec, Version = newCodec(DefaultBranchFactor)
 
 	rootKey                 = []byte{}
 	nodePrefix              = []byte("node")
 	metadataPrefix          = []byte("metadata")
+	historyPrefix           = []byte("history")
 	cleanShutdownKey        = []byte("cleanShutdown")
+	branchFactorKey         = []byte("branchFactor")
+	hasherKey               = []byte("hasher")
 	hadCleanShutdown        = []byte{1}
 	didNotHaveCleanShutdown = []byte{0}
 
+	ErrBranchFactorMismatch = errors.New("branch factor differs from the branch factor of the stored trie")
+	ErrHasherMismatch       = errors.New("hasher differs from the hasher of the stored trie")
+
 	errSameRoot = errors.New("start and end root are the same")
 )
 
@@ -57,7 +66,30 @@ type Config struct {
 	// The number of changes to the database that we store in memory in order to
 	// serve change proofs.
 	HistoryLength int
-	NodeCacheSize int
+	// If true, the changes made to the database are also written to disk so
+	// that proofs can be served for roots that are no longer in the in-memory
+	// history, including roots from before a restart.
+	PersistHistory bool
+	// The maximum number of changes to keep on disk if [PersistHistory] is
+	// true. If 0, the number of changes isn't limited.
+	PersistedHistoryLength int
+	// The maximum age of the changes kept on disk if [PersistHistory] is
+	// true. If 0, changes aren't removed based on their age.
+	PersistedHistoryMaxAge time.Duration
+	// If non-zero, the nodes that aren't reachable from the root are deleted
+	// every [GarbageCollectionFrequency]. See [Database.CollectGarbage].
+	GarbageCollectionFrequency time.Duration
+	// The number of nodes checked per batch during garbage collection. If 0,
+	// a default is used.
+	GarbageCollectionBatchSize int
+	NodeCacheSize              int
+	// The number of children each node can have. Lower branch factors result
+	// in smaller proofs but deeper tries. If 0, [DefaultBranchFactor] is
+	// used. It can't be changed once the database has been created.
+	BranchFactor BranchFactor
+	// The hasher used to calculate node IDs. If nil, [DefaultHasher] is
+	// used. The same hasher must be used every time the database is opened.
+	Hasher Hasher
 	// If [Reg] is nil, metrics are collected locally but not exported through
 	// Prometheus.
 	// This may be useful for testing.
@@ -91,6 +123,17 @@ type Database struct {
 	// historical views of the trie.
 	history *trieHistory
 
+	// Stores change lists on disk. Used to serve change proofs and construct
+	// historical views of the trie for roots that are no longer in [history].
+	// Nil if history isn't persisted.
+	persistedHistory *persistedHistory
+
+	// Closed when the db is closed to stop periodic garbage collection.
+	stopGarbageCollection chan struct{}
+
+	// The open subscriptions to committed changes.
+	subscriptions set.Set[*Subscription]
+
 	// True iff the db has been closed.
 	closed bool
 
@@ -98,6 +141,13 @@ type Database struct {
 
 	tracer trace.Tracer
 
+	// The number of children each node can have.
+	branchFactor BranchFactor
+	// Calculates the IDs of nodes.
+	hasher Hasher
+	// Encodes and decodes nodes for [branchFactor].
+	codec EncoderDecoder
+
 	// The root of this trie.
 	root *node
 
@@ -111,13 +161,38 @@ func newDatabase(
 	config Config,
 	metrics merkleMetrics,
 ) (*Database, error) {
+	branchFactor := config.BranchFactor
+	if branchFactor == 0 {
+		branchFactor = DefaultBranchFactor
+	}
+	if err := branchFactor.Valid(); err != nil {
+		return nil, err
+	}
+	hasher := config.Hasher
+	if hasher == nil {
+		hasher = DefaultHasher
+	}
+	codec, _ := newCodec(branchFactor)
+
 	trieDB := &Database{
-		metrics:    metrics,
-		nodeDB:     versiondb.New(prefixdb.New(nodePrefix, db)),
-		metadataDB: prefixdb.New(metadataPrefix, db),
-		history:    newTrieHistory(config.HistoryLength),
-		tracer:     config.Tracer,
-		childViews: make([]*trieView, 0, defaultPreallocationSize),
+		metrics:      metrics,
+		nodeDB:       versiondb.New(prefixdb.New(nodePrefix, db)),
+		metadataDB:   prefixdb.New(metadataPrefix, db),
+		history:      newTrieHistory(config.HistoryLength),
+		tracer:       config.Tracer,
+		branchFactor: branchFactor,
+		hasher:       hasher,
+		codec:        codec,
+		childViews:   make([]*trieView, 0, defaultPreallocationSize),
+
+		stopGarbageCollection: make(chan struct{}),
+	}
+
+	if err := trieDB.verifyBranchFactor(); err != nil {
+		return nil, err
+	}
+	if err := trieDB.verifyHasher(); err != nil {
+		return nil, err
 	}
 
 	// Note: trieDB.OnEviction is responsible for writing intermediary nodes to
@@ -151,9 +226,34 @@ func newDatabase(
 		return nil, err
 	}
 
+	// The persisted history is opened after the trie has been rebuilt so that
+	// the rebuild isn't recorded as changes to the trie.
+	if config.PersistHistory {
+		trieDB.persistedHistory, err = newPersistedHistory(
+			prefixdb.New(historyPrefix, db),
+			codec,
+			hasher,
+			config.PersistedHistoryLength,
+			config.PersistedHistoryMaxAge,
+			trieDB.getMerkleRoot(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// mark that the db has not yet been cleanly closed
-	err = trieDB.metadataDB.Put(cleanShutdownKey, didNotHaveCleanShutdown)
-	return trieDB, err
+	if err := trieDB.metadataDB.Put(cleanShutdownKey, didNotHaveCleanShutdown); err != nil {
+		return nil, err
+	}
+
+	if config.GarbageCollectionFrequency > 0 {
+		go trieDB.collectGarbagePeriodically(
+			config.GarbageCollectionFrequency,
+			config.GarbageCollectionBatchSize,
+		)
+	}
+	return trieDB, nil
 }
 
 // Deletes every intermediate node and rebuilds them by re-adding every key/value.
@@ -192,12 +292,12 @@ func (db *Database) rebuild(ctx context.Context) error {
 		key := it.Key()
 		path := path(key)
 		value := it.Value()
-		n, err := parseNode(path, value)
+		n, err := parseNode(db.codec, db.hasher, path, value)
 		if err != nil {
 			return err
 		}
 		if n.hasValue() {
-			serializedPath := path.Serialize()
+			serializedPath := path.Serialize(db.branchFactor)
 			if err := currentView.Insert(ctx, serializedPath.Value, n.value.value); err != nil {
 				return err
 			}
@@ -216,6 +316,65 @@ func (db *Database) rebuild(ctx context.Context) error {
 	return db.nodeDB.Compact(nil, nil)
 }
 
+// Returns an error if the trie stored in [db.nodeDB] was built with a
+// different branch factor than [db.branchFactor]. Records the branch factor
+// if it hasn't been recorded yet.
+// Tries created before the branch factor was recorded have a branch factor
+// of 16.
+func (db *Database) verifyBranchFactor() error {
+	branchFactorBytes, err := db.metadataDB.Get(branchFactorKey)
+	switch err {
+	case nil:
+		storedBranchFactor, err := database.ParseUInt64(branchFactorBytes)
+		if err != nil {
+			return err
+		}
+		if BranchFactor(storedBranchFactor) != db.branchFactor {
+			return fmt.Errorf("%w: stored %d but given %d", ErrBranchFactorMismatch, storedBranchFactor, db.branchFactor)
+		}
+		return nil
+	case database.ErrNotFound:
+		hasRoot, err := db.nodeDB.Has(rootKey)
+		if err != nil {
+			return err
+		}
+		if hasRoot && db.branchFactor != BranchFactor16 {
+			return fmt.Errorf("%w: stored %d but given %d", ErrBranchFactorMismatch, BranchFactor16, db.branchFactor)
+		}
+		return db.metadataDB.Put(branchFactorKey, database.PackUInt64(uint64(db.branchFactor)))
+	default:
+		return err
+	}
+}
+
+// Returns an error if the trie stored in [db.nodeDB] was built with a
+// different hasher than [db.hasher]. Records the hasher if it hasn't been
+// recorded yet.
+// Tries created before the hasher was recorded were built with
+// [SHA256Hasher].
+func (db *Database) verifyHasher() error {
+	id := hasherID(db.hasher)
+	storedID, err := db.metadataDB.Get(hasherKey)
+	switch err {
+	case nil:
+		if !bytes.Equal(storedID, id[:]) {
+			return fmt.Errorf("%w: stored %x but given %s", ErrHasherMismatch, storedID, id)
+		}
+		return nil
+	case database.ErrNotFound:
+		hasRoot, err := db.nodeDB.Has(rootKey)
+		if err != nil {
+			return err
+		}
+		if sha256ID := hasherID(SHA256Hasher); hasRoot && id != sha256ID {
+			return fmt.Errorf("%w: stored %s but given %s", ErrHasherMismatch, sha256ID, id)
+		}
+		return db.metadataDB.Put(hasherKey, id[:])
+	default:
+		return err
+	}
+}
+
 // New returns a new merkle database.
 func New(ctx context.Context, db database.Database, config Config) (*Database, error) {
 	metrics, err := newMetrics("merkleDB", config.Reg)
@@ -225,6 +384,21 @@ func New(ctx context.Context, db database.Database, config Config) (*Database, e
 	return newDatabase(ctx, db, config, metrics)
 }
 
+// BranchFactor returns the number of children each node in the trie can have.
+func (db *Database) BranchFactor() BranchFactor {
+	return db.branchFactor
+}
+
+// Hasher returns the hasher used to calculate the IDs of the trie's nodes.
+func (db *Database) Hasher() Hasher {
+	return db.hasher
+}
+
+// Codec returns the codec for the trie's proofs.
+func (db *Database) Codec() EncoderDecoder {
+	return db.codec
+}
+
 // Commits the key/value pairs within the [proof] to the db.
 func (db *Database) CommitChangeProof(ctx context.Context, proof *ChangeProof) error {
 	db.commitLock.Lock()
@@ -266,6 +440,10 @@ func (db *Database) Close() error {
 	}
 
 	db.closed = true
+	close(db.stopGarbageCollection)
+	for s := range db.subscriptions {
+		s.close(database.ErrClosed)
+	}
 
 	defer func() {
 		_ = db.metadataDB.Close()
@@ -318,7 +496,7 @@ func (db *Database) GetValues(ctx context.Context, keys [][]byte) ([][]byte, []e
 	values := make([][]byte, len(keys))
 	errors := make([]error, len(keys))
 	for i, key := range keys {
-		values[i], errors[i] = db.getValueCopy(newPath(key), false)
+		values[i], errors[i] = db.getValueCopy(newPath(key, db.branchFactor), false)
 	}
 	return values, errors
 }
@@ -329,7 +507,7 @@ func (db *Database) GetValue(ctx context.Context, key []byte) ([]byte, error) {
 	_, span := db.tracer.Start(ctx, "MerkleDB.GetValue")
 	defer span.End()
 
-	return db.getValueCopy(newPath(key), true)
+	return db.getValueCopy(newPath(key, db.branchFactor), true)
 }
 
 // getValueCopy returns a copy of the value for the given [key].
@@ -399,6 +577,20 @@ func (db *Database) getProof(ctx context.Context, key []byte) (*Proof, error) {
 	return view.getProof(ctx, key)
 }
 
+// Returns a proof of the existence/non-existence of each key in [keys] in this
+// trie.
+func (db *Database) GetMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error) {
+	db.commitLock.RLock()
+	defer db.commitLock.RUnlock()
+
+	view, err := db.newUntrackedView(defaultPreallocationSize)
+	if err != nil {
+		return nil, err
+	}
+	// Don't need to lock [view] because nobody else has a reference to it.
+	return view.getMultiProof(ctx, keys)
+}
+
 // Returns a proof for the key/value pairs in this trie within the range
 // [start, end].
 func (db *Database) GetRangeProof(
@@ -471,7 +663,7 @@ func (db *Database) GetChangeProof(
 	result := &ChangeProof{
 		HadRootsInHistory: true,
 	}
-	changes, err := db.history.getValueChanges(startRootID, endRootID, start, end, maxLength)
+	changes, err := db.getValueChanges(startRootID, endRootID, start, end, maxLength)
 	if err == ErrRootIDNotPresent {
 		result.HadRootsInHistory = false
 		return result, nil
@@ -494,7 +686,7 @@ func (db *Database) GetChangeProof(
 
 	for _, key := range changedKeys {
 		change := changes.values[key]
-		serializedKey := key.Serialize().Value
+		serializedKey := key.Serialize(db.branchFactor).Value
 
 		if change.after.IsNothing() {
 			result.DeletedKeys = append(result.DeletedKeys, serializedKey)
@@ -589,7 +781,7 @@ func (db *Database) Has(k []byte) (bool, error) {
 		return false, database.ErrClosed
 	}
 
-	_, err := db.getValue(newPath(k), true)
+	_, err := db.getValue(newPath(k, db.branchFactor), true)
 	if err == database.ErrNotFound {
 		return false, nil
 	}
@@ -633,21 +825,21 @@ func (db *Database) NewIterator() database.Iterator {
 
 func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
 	return &iterator{
-		nodeIter: db.nodeDB.NewIteratorWithStart(newPath(start).Bytes()),
+		nodeIter: db.nodeDB.NewIteratorWithStart(newPath(start, db.branchFactor).Bytes()),
 		db:       db,
 	}
 }
 
 func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
 	return &iterator{
-		nodeIter: db.nodeDB.NewIteratorWithPrefix(newPath(prefix).Bytes()),
+		nodeIter: db.nodeDB.NewIteratorWithPrefix(newPath(prefix, db.branchFactor).Bytes()),
 		db:       db,
 	}
 }
 
 func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
-	startBytes := newPath(start).Bytes()
-	prefixBytes := newPath(prefix).Bytes()
+	startBytes := newPath(start, db.branchFactor).Bytes()
+	prefixBytes := newPath(prefix, db.branchFactor).Bytes()
 	return &iterator{
 		nodeIter: db.nodeDB.NewIteratorWithStartAndPrefix(startBytes, prefixBytes),
 		db:       db,
@@ -665,7 +857,7 @@ func (db *Database) onEviction(node *node) error {
 		return nil
 	}
 
-	nodeBytes, err := node.marshal()
+	nodeBytes, err := node.marshal(db.codec)
 	if err != nil {
 		db.onEvictionErr.Set(err)
 		// Prevent reads/writes from/to [db.nodeDB] to avoid inconsistent state.
@@ -788,7 +980,7 @@ func (db *Database) commitChanges(ctx context.Context, trieToCommit *trieView) e
 			// Otherwise, intermediary nodes are persisted on cache eviction or
 			// shutdown.
 			db.metrics.IOKeyWrite()
-			nodeBytes, err := nodeChange.after.marshal()
+			nodeBytes, err := nodeChange.after.marshal(db.codec)
 			if err != nil {
 				db.nodeDB.Abort()
 				nodesSpan.End()
@@ -805,7 +997,7 @@ func (db *Database) commitChanges(ctx context.Context, trieToCommit *trieView) e
 	nodesSpan.End()
 
 	_, commitSpan := db.tracer.Start(ctx, "MerkleDB.commitChanges.dbCommit")
-	err := db.nodeDB.Commit()
+	err := db.commitNodesAndHistory(changes)
 	commitSpan.End()
 	if err != nil {
 		db.nodeDB.Abort()
@@ -823,6 +1015,39 @@ func (db *Database) commitChanges(ctx context.Context, trieToCommit *trieView) e
 	}
 
 	db.history.record(changes)
+	db.notifySubscriptions(changes)
+	return nil
+}
+
+// Commits the pending writes in [db.nodeDB] to disk. If history is persisted,
+// [changes] are atomically written along with them.
+// Assumes [db.lock] is held.
+func (db *Database) commitNodesAndHistory(changes *changeSummary) error {
+	if db.persistedHistory == nil {
+		return db.nodeDB.Commit()
+	}
+
+	nodeBatch, err := db.nodeDB.CommitBatch()
+	if err != nil {
+		return err
+	}
+	historyBatch := db.persistedHistory.db.NewBatch()
+	oldestIndex, err := db.persistedHistory.record(historyBatch, changes)
+	if err != nil {
+		return err
+	}
+	// [nodeBatch] and [historyBatch] share the same base database, so the
+	// history is replayed onto the base batch of [nodeBatch] to write both of
+	// them in one atomic batch.
+	baseBatch := nodeBatch.Inner()
+	if err := historyBatch.Inner().Replay(baseBatch); err != nil {
+		return err
+	}
+	if err := baseBatch.Write(); err != nil {
+		return err
+	}
+	db.nodeDB.Abort()
+	db.persistedHistory.recorded(oldestIndex)
 	return nil
 }
 
@@ -868,11 +1093,11 @@ func (db *Database) initializeRootIfNeeded() (ids.ID, error) {
 	nodeBytes, err := db.nodeDB.Get(rootKey)
 	if err == nil {
 		// Root already exists, so parse it and set the in-mem copy
-		db.root, err = parseNode(RootPath, nodeBytes)
+		db.root, err = parseNode(db.codec, db.hasher, RootPath, nodeBytes)
 		if err != nil {
 			return ids.Empty, err
 		}
-		if err := db.root.calculateID(db.metrics); err != nil {
+		if err := db.root.calculateID(db.codec, db.hasher, db.metrics); err != nil {
 			return ids.Empty, err
 		}
 		return db.root.id, nil
@@ -885,12 +1110,12 @@ func (db *Database) initializeRootIfNeeded() (ids.ID, error) {
 	db.root = newNode(nil, RootPath)
 
 	// update its ID
-	if err := db.root.calculateID(db.metrics); err != nil {
+	if err := db.root.calculateID(db.codec, db.hasher, db.metrics); err != nil {
 		return ids.Empty, err
 	}
 
 	// write the newly constructed root to the DB
-	rootBytes, err := db.root.marshal()
+	rootBytes, err := db.root.marshal(db.codec)
 	if err != nil {
 		return ids.Empty, err
 	}
@@ -915,13 +1140,33 @@ func (db *Database) getHistoricalViewForRange(
 		return newTrieView(db, db, db.root.clone(), 100)
 	}
 
-	changeHistory, err := db.history.getChangesToGetToRoot(rootID, start, end)
+	startPath := newPath(start, db.branchFactor)
+	endPath := newPath(end, db.branchFactor)
+	changeHistory, err := db.history.getChangesToGetToRoot(rootID, startPath, endPath)
+	if err == ErrRootIDNotPresent && db.persistedHistory != nil {
+		changeHistory, err = db.persistedHistory.getChangesToGetToRoot(rootID, startPath, endPath, db.metrics)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return newTrieViewWithChanges(db, db, changeHistory, len(changeHistory.nodes))
 }
 
+// Returns up to [maxLength] key-value pair changes with keys in [start, end]
+// that occurred between [startRoot] and [endRoot].
+// Falls back to the persisted history, if any, when the roots aren't in the
+// in-memory history.
+// Assumes [db.commitLock] is read locked.
+func (db *Database) getValueChanges(startRoot, endRoot ids.ID, start, end []byte, maxLength int) (*changeSummary, error) {
+	startPath := newPath(start, db.branchFactor)
+	endPath := newPath(end, db.branchFactor)
+	changes, err := db.history.getValueChanges(startRoot, endRoot, startPath, endPath, maxLength)
+	if (err == ErrRootIDNotPresent || err == ErrStartRootNotFound) && db.persistedHistory != nil {
+		return db.persistedHistory.getValueChanges(startRoot, endRoot, startPath, endPath, maxLength)
+	}
+	return changes, err
+}
+
 // Returns all of the keys in range [start, end] that aren't in [keySet].
 // If [start] is nil, then the range has no lower bound.
 // If [end] is nil, then the range has no upper bound.
@@ -990,7 +1235,7 @@ func (db *Database) getNode(key path) (*node, error) {
 		return nil, err
 	}
 
-	node, err := parseNode(key, rawBytes)
+	node, err := parseNode(db.codec, db.hasher, key, rawBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ package merkledb
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/trace"
 	"github.com/VidarSolutions/avalanchego/utils/hashing"
@@ -33,7 +35,7 @@ func Test_MerkleDB_Get_Safety(t *testing.T) {
 
 	val, err := db.Get([]byte{0})
 	require.NoError(t, err)
-	n, err := db.getNode(newPath([]byte{0}))
+	n, err := db.getNode(newPath([]byte{0}, BranchFactor16))
 	require.NoError(t, err)
 	val[0] = 1
 
@@ -78,6 +80,83 @@ func Benchmark_MerkleDB_DBInterface(b *testing.B) {
 	}
 }
 
+// Benchmark_MerkleDB_ProofSize reports the size of the proofs of a trie
+// with each branch factor and hasher.
+func Benchmark_MerkleDB_ProofSize(b *testing.B) {
+	for _, bf := range branchFactors {
+		for hasherName, hasher := range hashers {
+			b.Run(fmt.Sprintf("branch factor %d %s", bf, hasherName), func(b *testing.B) {
+				require := require.New(b)
+				ctx := context.Background()
+
+				db, err := getDBWithBranchFactorAndHasher(bf, hasher)
+				require.NoError(err)
+				codec, err := NewCodec(bf)
+				require.NoError(err)
+
+				r := rand.New(rand.NewSource(0)) // #nosec G404
+				keyValues := newKeyValues(r, 10_000)
+				batch := db.NewBatch()
+				for _, kv := range keyValues {
+					require.NoError(batch.Put(kv.Key, kv.Value))
+				}
+				require.NoError(batch.Write())
+
+				b.ResetTimer()
+				proofBytesLen, rangeProofBytesLen := 0, 0
+				for i := 0; i < b.N; i++ {
+					key := keyValues[i%len(keyValues)].Key
+					proof, err := db.GetProof(ctx, key)
+					require.NoError(err)
+					proofBytes, err := codec.EncodeProof(Version, proof)
+					require.NoError(err)
+					proofBytesLen += len(proofBytes)
+
+					rangeProof, err := db.GetRangeProof(ctx, key, nil, 100)
+					require.NoError(err)
+					rangeProofBytes, err := codec.EncodeRangeProof(Version, rangeProof)
+					require.NoError(err)
+					rangeProofBytesLen += len(rangeProofBytes)
+				}
+				b.ReportMetric(float64(proofBytesLen)/float64(b.N), "bytes/proof")
+				b.ReportMetric(float64(rangeProofBytesLen)/float64(b.N), "bytes/rangeproof")
+			})
+		}
+	}
+}
+
+// Benchmark_MerkleDB_Commit measures how fast batches are committed to a
+// trie with each branch factor and hasher.
+func Benchmark_MerkleDB_Commit(b *testing.B) {
+	const batchSize = 1_000
+
+	for _, bf := range branchFactors {
+		for hasherName, hasher := range hashers {
+			b.Run(fmt.Sprintf("branch factor %d %s", bf, hasherName), func(b *testing.B) {
+				require := require.New(b)
+
+				db, err := getDBWithBranchFactorAndHasher(bf, hasher)
+				require.NoError(err)
+
+				r := rand.New(rand.NewSource(0)) // #nosec G404
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					keyValues := newKeyValues(r, batchSize)
+					b.StartTimer()
+
+					batch := db.NewBatch()
+					for _, kv := range keyValues {
+						require.NoError(batch.Put(kv.Key, kv.Value))
+					}
+					require.NoError(batch.Write())
+				}
+				b.ReportMetric(float64(b.N*batchSize)/b.Elapsed().Seconds(), "keys/s")
+			})
+		}
+	}
+}
+
 func Test_MerkleDB_DB_Load_Root_From_DB(t *testing.T) {
 	require := require.New(t)
 	rdb := memdb.New()
@@ -124,6 +203,92 @@ func Test_MerkleDB_DB_Load_Root_From_DB(t *testing.T) {
 	require.Equal(root, reloadedRoot)
 }
 
+func Test_MerkleDB_Branch_Factor_Mismatch(t *testing.T) {
+	require := require.New(t)
+	rdb := memdb.New()
+	defer rdb.Close()
+
+	config := Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		NodeCacheSize: 100,
+		BranchFactor:  BranchFactor4,
+	}
+	db, err := New(context.Background(), rdb, config)
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+	require.NoError(db.Close())
+
+	config.BranchFactor = BranchFactor16
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrBranchFactorMismatch)
+
+	config.BranchFactor = 3
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrInvalidBranchFactor)
+
+	config.BranchFactor = BranchFactor4
+	db, err = New(context.Background(), rdb, config)
+	require.NoError(err)
+	require.Equal(BranchFactor4, db.BranchFactor())
+	value, err := db.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.NoError(db.Close())
+
+	// A database written before the branch factor was stored has a branch
+	// factor of 16.
+	require.NoError(prefixdb.New(metadataPrefix, rdb).Delete(branchFactorKey))
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrBranchFactorMismatch)
+}
+
+func Test_MerkleDB_Hasher_Mismatch(t *testing.T) {
+	require := require.New(t)
+	rdb := memdb.New()
+	defer rdb.Close()
+
+	config := Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		NodeCacheSize: 100,
+		Hasher:        Keccak256Hasher,
+	}
+	db, err := New(context.Background(), rdb, config)
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+	require.NoError(db.Close())
+
+	config.Hasher = SHA256Hasher
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrHasherMismatch)
+
+	// The default hasher is SHA-256.
+	config.Hasher = nil
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrHasherMismatch)
+
+	config.Hasher = Keccak256Hasher
+	db, err = New(context.Background(), rdb, config)
+	require.NoError(err)
+	require.Equal(Keccak256Hasher, db.Hasher())
+	value, err := db.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.NoError(db.Close())
+
+	// A database written before the hasher was stored was built with
+	// SHA-256.
+	require.NoError(prefixdb.New(metadataPrefix, rdb).Delete(hasherKey))
+	_, err = New(context.Background(), rdb, config)
+	require.ErrorIs(err, ErrHasherMismatch)
+
+	config.Hasher = SHA256Hasher
+	db, err = New(context.Background(), rdb, config)
+	require.NoError(err)
+	require.NoError(db.Close())
+}
+
 func Test_MerkleDB_DB_Rebuild(t *testing.T) {
 	require := require.New(t)
 
@@ -774,13 +939,13 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest) {
 		case opUpdate:
 			err := currentBatch.Put(step.key, step.value)
 			require.NoError(err)
-			currentValues[newPath(step.key)] = step.value
-			delete(deleteValues, newPath(step.key))
+			currentValues[newPath(step.key, BranchFactor16)] = step.value
+			delete(deleteValues, newPath(step.key, BranchFactor16))
 		case opDelete:
 			err := currentBatch.Delete(step.key)
 			require.NoError(err)
-			deleteValues[newPath(step.key)] = struct{}{}
-			delete(currentValues, newPath(step.key))
+			deleteValues[newPath(step.key, BranchFactor16)] = struct{}{}
+			delete(currentValues, newPath(step.key, BranchFactor16))
 		case opGenerateRangeProof:
 			root, err := db.GetMerkleRoot(context.Background())
 			require.NoError(err)
@@ -794,6 +959,8 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest) {
 				step.key,
 				step.value,
 				root,
+				BranchFactor16,
+				DefaultHasher,
 			)
 			require.NoError(err)
 			require.LessOrEqual(len(rangeProof.KeyValues), 100)
@@ -851,7 +1018,7 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest) {
 			if err != nil {
 				require.ErrorIs(err, database.ErrNotFound)
 			}
-			want := values[newPath(step.key)]
+			want := values[newPath(step.key, BranchFactor16)]
 			require.True(bytes.Equal(want, v)) // Use bytes.Equal so nil treated equal to []byte{}
 			trieValue, err := getNodeValue(db, string(step.key))
 			if err != nil {
@@ -872,7 +1039,7 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest) {
 			require.NoError(err)
 			localTrie := Trie(dbTrie)
 			for key, value := range values {
-				err := localTrie.Insert(context.Background(), key.Serialize().Value, value)
+				err := localTrie.Insert(context.Background(), key.Serialize(BranchFactor16).Value, value)
 				require.NoError(err)
 			}
 			calculatedRoot, err := localTrie.GetMerkleRoot(context.Background())
@@ -0,0 +1,597 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+const (
+	// The maximum number of operations applied for a single fuzz input.
+	maxDifferentialOps = 64
+	// The maximum number of views, valid or not, tracked at once.
+	maxDifferentialViews = 4
+)
+
+type differentialOp byte
+
+const (
+	differentialPut differentialOp = iota
+	differentialDelete
+	differentialNewView
+	differentialCommitToParent
+	differentialCommitToDB
+	differentialClose
+	numDifferentialOps
+)
+
+var differentialHashers = []Hasher{SHA256Hasher, Keccak256Hasher}
+
+// referenceView is the reference model of a view: the key-values of its
+// parent with [changes] applied.
+type referenceView struct {
+	view TrieView
+	// nil if the parent is the database
+	parent   *referenceView
+	children []*referenceView
+	changes  map[string]Maybe[[]byte]
+	invalid  bool
+}
+
+// A root of the database and the key-values it was the root of.
+type referenceRoot struct {
+	rootID ids.ID
+	values map[string][]byte
+}
+
+// differentialTest applies the same operations to a [Database] and to a
+// reference model built from maps, and checks that they agree.
+type differentialTest struct {
+	t       *testing.T
+	require *require.Assertions
+	ctx     context.Context
+	input   []byte
+
+	baseDB database.Database
+	config Config
+	db     *Database
+
+	// The reference model of [db].
+	values map[string][]byte
+	// The views whose parent is [db].
+	children []*referenceView
+	// Every view that hasn't been committed.
+	views []*referenceView
+	// The roots committed since [db] was opened, oldest first.
+	roots []referenceRoot
+	// Every key that has been used.
+	keys map[string]struct{}
+}
+
+// FuzzMerkleDBDifferential applies random sequences of operations to a
+// database and its views and to a reference model of them. After every
+// operation it checks that values, iterators, roots, range proofs and change
+// proofs agree with the reference model.
+func FuzzMerkleDBDifferential(f *testing.F) {
+	for i := int64(0); i < 16; i++ {
+		r := rand.New(rand.NewSource(i)) // #nosec G404
+		input := make([]byte, 256)
+		_, _ = r.Read(input)
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		newDifferentialTest(t, input).run()
+	})
+}
+
+func newDifferentialTest(t *testing.T, input []byte) *differentialTest {
+	test := &differentialTest{
+		t:       t,
+		require: require.New(t),
+		ctx:     context.Background(),
+		input:   input,
+		baseDB:  memdb.New(),
+		values:  make(map[string][]byte),
+		keys:    make(map[string]struct{}),
+	}
+	configByte := test.nextByte()
+	test.config = Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: maxDifferentialOps,
+		NodeCacheSize: 100,
+		BranchFactor:  branchFactors[int(configByte)%len(branchFactors)],
+		Hasher:        differentialHashers[int(configByte>>4)%len(differentialHashers)],
+	}
+	test.open()
+	return test
+}
+
+func (test *differentialTest) run() {
+	for i := 0; i < maxDifferentialOps && len(test.input) > 0; i++ {
+		switch differentialOp(test.nextByte()) % numDifferentialOps {
+		case differentialPut:
+			test.put(test.nextView(), test.nextKey(), Some(test.nextValue()))
+		case differentialDelete:
+			test.put(test.nextView(), test.nextKey(), Nothing[[]byte]())
+		case differentialNewView:
+			test.newView(test.nextView())
+		case differentialCommitToParent:
+			if view := test.nextView(); view != nil {
+				test.commitToParent(view)
+			}
+		case differentialCommitToDB:
+			if view := test.nextView(); view != nil {
+				test.commitToDB(view)
+			}
+		case differentialClose:
+			test.reopen()
+		}
+		test.check()
+	}
+}
+
+// Returns the next byte of the input, or 0 if it's exhausted.
+func (test *differentialTest) nextByte() byte {
+	if len(test.input) == 0 {
+		return 0
+	}
+	b := test.input[0]
+	test.input = test.input[1:]
+	return b
+}
+
+// Returns a key of 1 to 3 bytes. Each byte is one of 4 values with
+// different leading bits, so keys share prefixes with every branch factor.
+// Keys are never empty since an empty key can't be distinguished from an
+// unbounded end of a range.
+func (test *differentialTest) nextKey() []byte {
+	b := test.nextByte()
+	key := make([]byte, int(b%3)+1)
+	for i := range key {
+		key[i] = (b >> (2 + 2*i) & 0x03) * 0x55
+	}
+	test.keys[string(key)] = struct{}{}
+	return key
+}
+
+// Returns a value of 0 to 63 bytes, so that some values are stored in proofs
+// as hashes.
+func (test *differentialTest) nextValue() []byte {
+	b := test.nextByte()
+	return bytes.Repeat([]byte{b}, int(b%64))
+}
+
+// Returns the view selected by the next byte of the input, or nil if the
+// database is selected.
+func (test *differentialTest) nextView() *referenceView {
+	b := int(test.nextByte())
+	if b%(len(test.views)+1) == 0 {
+		return nil
+	}
+	return test.views[b%(len(test.views)+1)-1]
+}
+
+func (test *differentialTest) open() {
+	db, err := New(test.ctx, test.baseDB, test.config)
+	test.require.NoError(err)
+	test.db = db
+}
+
+// Puts [value] in [view], or in the database if [view] is nil.
+// Deletes the key if [value] is Nothing.
+func (test *differentialTest) put(view *referenceView, key []byte, value Maybe[[]byte]) {
+	if view == nil {
+		if value.IsNothing() {
+			test.require.NoError(test.db.Delete(key))
+			delete(test.values, string(key))
+		} else {
+			test.require.NoError(test.db.Put(key, value.Value()))
+			test.values[string(key)] = value.Value()
+		}
+		test.invalidateChildren(&test.children, nil)
+		test.recordRoot()
+		return
+	}
+
+	var err error
+	if value.IsNothing() {
+		err = view.view.Remove(test.ctx, key)
+	} else {
+		err = view.view.Insert(test.ctx, key, value.Value())
+	}
+	if view.invalid {
+		test.require.ErrorIs(err, ErrInvalid)
+		return
+	}
+	test.require.NoError(err)
+	view.changes[string(key)] = value
+	test.invalidateChildren(&view.children, nil)
+}
+
+// Creates a view of [parent], or of the database if [parent] is nil.
+func (test *differentialTest) newView(parent *referenceView) {
+	if len(test.views) >= maxDifferentialViews {
+		return
+	}
+
+	var (
+		view TrieView
+		err  error
+	)
+	if parent == nil {
+		view, err = test.db.NewView()
+	} else {
+		view, err = parent.view.NewView()
+	}
+	if parent != nil && parent.invalid {
+		test.require.ErrorIs(err, ErrInvalid)
+		return
+	}
+	test.require.NoError(err)
+
+	child := &referenceView{
+		view:    view,
+		parent:  parent,
+		changes: make(map[string]Maybe[[]byte]),
+	}
+	if parent == nil {
+		test.children = append(test.children, child)
+	} else {
+		parent.children = append(parent.children, child)
+	}
+	test.views = append(test.views, child)
+}
+
+func (test *differentialTest) commitToParent(view *referenceView) {
+	err := view.view.CommitToParent(test.ctx)
+	if view.invalid {
+		test.require.ErrorIs(err, ErrInvalid)
+		return
+	}
+	test.require.NoError(err)
+	test.committed(view)
+}
+
+func (test *differentialTest) commitToDB(view *referenceView) {
+	err := view.view.CommitToDB(test.ctx)
+	if view.invalid {
+		test.require.ErrorIs(err, ErrInvalid)
+		return
+	}
+	test.require.NoError(err)
+	for ; view != nil; view = view.parent {
+		test.committed(view)
+	}
+}
+
+// Updates the reference model after [view] was committed to its parent.
+func (test *differentialTest) committed(view *referenceView) {
+	siblings := &test.children
+	if view.parent != nil {
+		siblings = &view.parent.children
+	}
+	for _, sibling := range *siblings {
+		if sibling != view {
+			sibling.invalidate()
+		}
+	}
+	*siblings = append((*siblings)[:0], view.children...)
+
+	for key, value := range view.changes {
+		if view.parent != nil {
+			view.parent.changes[key] = value
+		} else if value.IsNothing() {
+			delete(test.values, key)
+		} else {
+			test.values[key] = value.Value()
+		}
+	}
+	for _, child := range view.children {
+		child.parent = view.parent
+	}
+	view.children = nil
+
+	i := slices.Index(test.views, view)
+	test.views = slices.Delete(test.views, i, i+1)
+	test.require.ErrorIs(view.view.Insert(test.ctx, []byte{0}, nil), ErrCommitted)
+	if view.parent == nil {
+		test.recordRoot()
+	}
+}
+
+// Invalidates the views in [children], other than [exception], and their
+// descendants. Only [exception] remains in [children].
+func (test *differentialTest) invalidateChildren(children *[]*referenceView, exception *referenceView) {
+	for _, child := range *children {
+		if child != exception {
+			child.invalidate()
+		}
+	}
+	*children = (*children)[:0]
+	if exception != nil {
+		*children = append(*children, exception)
+	}
+}
+
+func (v *referenceView) invalidate() {
+	v.invalid = true
+	for _, child := range v.children {
+		child.invalidate()
+	}
+	v.children = nil
+}
+
+// Records the root of the database after a commit.
+func (test *differentialTest) recordRoot() {
+	rootID, err := test.db.GetMerkleRoot(test.ctx)
+	test.require.NoError(err)
+	if len(test.roots) > 0 && test.roots[len(test.roots)-1].rootID == rootID {
+		return
+	}
+	test.roots = append(test.roots, referenceRoot{
+		rootID: rootID,
+		values: maps.Clone(test.values),
+	})
+}
+
+// Closes the database and reopens it from the same base database.
+// Every view is dropped, as is the history.
+func (test *differentialTest) reopen() {
+	rootID, err := test.db.GetMerkleRoot(test.ctx)
+	test.require.NoError(err)
+	test.require.NoError(test.db.Close())
+
+	test.open()
+	reopenedRootID, err := test.db.GetMerkleRoot(test.ctx)
+	test.require.NoError(err)
+	test.require.Equal(rootID, reopenedRootID)
+
+	test.children = nil
+	test.views = nil
+	test.roots = nil
+}
+
+// Returns the key-values of [view] in the reference model, or those of the
+// database if [view] is nil.
+func (test *differentialTest) valuesOf(view *referenceView) map[string][]byte {
+	if view == nil {
+		return test.values
+	}
+	values := maps.Clone(test.valuesOf(view.parent))
+	for key, value := range view.changes {
+		if value.IsNothing() {
+			delete(values, key)
+		} else {
+			values[key] = value.Value()
+		}
+	}
+	return values
+}
+
+// Returns the root of a new trie containing [values].
+func (test *differentialTest) expectedRoot(values map[string][]byte) ids.ID {
+	db, err := New(test.ctx, memdb.New(), test.config)
+	test.require.NoError(err)
+	batch := db.NewBatch()
+	for key, value := range values {
+		test.require.NoError(batch.Put([]byte(key), value))
+	}
+	test.require.NoError(batch.Write())
+	rootID, err := db.GetMerkleRoot(test.ctx)
+	test.require.NoError(err)
+	return rootID
+}
+
+func (test *differentialTest) check() {
+	test.checkDB()
+
+	views := test.views[:0]
+	for _, view := range test.views {
+		if view.invalid {
+			_, err := view.view.GetMerkleRoot(test.ctx)
+			test.require.ErrorIs(err, ErrInvalid)
+			continue
+		}
+		test.checkTrie(view.view, test.valuesOf(view))
+		views = append(views, view)
+	}
+	test.views = views
+}
+
+func (test *differentialTest) checkDB() {
+	test.checkTrie(test.db, test.values)
+
+	// Check iteration over every key, and from a start key with a prefix.
+	test.checkIterator(test.db.NewIterator(), nil, nil)
+	start := test.nextKey()
+	prefix := start[:1]
+	test.checkIterator(test.db.NewIteratorWithStartAndPrefix(start, prefix), start, prefix)
+
+	test.checkChangeProof()
+}
+
+// Checks that [trie] contains [values] and that its root and range proofs
+// match them.
+func (test *differentialTest) checkTrie(trie Trie, values map[string][]byte) {
+	for key := range test.keys {
+		value, err := trie.GetValue(test.ctx, []byte(key))
+		if expected, ok := values[key]; ok {
+			test.require.NoError(err)
+			test.require.True(bytes.Equal(expected, value))
+		} else {
+			test.require.ErrorIs(err, database.ErrNotFound)
+		}
+	}
+
+	rootID, err := trie.GetMerkleRoot(test.ctx)
+	test.require.NoError(err)
+	test.require.Equal(test.expectedRoot(values), rootID)
+
+	start, end := test.nextRange()
+	maxLength := int(test.nextByte()%8) + 1
+	proof, err := trie.GetRangeProof(test.ctx, start, end, maxLength)
+	test.require.NoError(err)
+	test.require.NoError(proof.Verify(test.ctx, start, end, rootID, test.config.BranchFactor, test.config.Hasher))
+
+	expected := sortedKeyValuesInRange(values, start, end)
+	if len(expected) > maxLength {
+		expected = expected[:maxLength]
+	}
+	test.require.Len(proof.KeyValues, len(expected))
+	for i, kv := range expected {
+		test.require.Equal(kv.Key, proof.KeyValues[i].Key)
+		test.require.True(bytes.Equal(kv.Value, proof.KeyValues[i].Value))
+	}
+}
+
+// Checks that [it] iterates over the key-values of the reference model with
+// [prefix] that are >= [start].
+func (test *differentialTest) checkIterator(it database.Iterator, start, prefix []byte) {
+	defer it.Release()
+
+	var expected []KeyValue
+	for _, kv := range sortedKeyValuesInRange(test.values, start, nil) {
+		if bytes.HasPrefix(kv.Key, prefix) {
+			expected = append(expected, kv)
+		}
+	}
+	for _, kv := range expected {
+		test.require.True(it.Next())
+		test.require.Equal(kv.Key, it.Key())
+		test.require.True(bytes.Equal(kv.Value, it.Value()))
+	}
+	test.require.False(it.Next())
+	test.require.NoError(it.Error())
+}
+
+// Checks a change proof from a previous root of the database to its current
+// root.
+func (test *differentialTest) checkChangeProof() {
+	if len(test.roots) < 2 {
+		return
+	}
+	endRoot := test.roots[len(test.roots)-1]
+	startRoot := test.roots[int(test.nextByte())%(len(test.roots)-1)]
+	if startRoot.rootID == endRoot.rootID {
+		return
+	}
+
+	start, end := test.nextRange()
+	maxLength := int(test.nextByte()%8) + 1
+	proof, err := test.db.GetChangeProof(test.ctx, startRoot.rootID, endRoot.rootID, start, end, maxLength)
+	test.require.NoError(err)
+	test.require.True(proof.HadRootsInHistory)
+
+	// Verify the proof against a database containing the start root's values.
+	startDB, err := New(test.ctx, memdb.New(), test.config)
+	test.require.NoError(err)
+	batch := startDB.NewBatch()
+	for key, value := range startRoot.values {
+		test.require.NoError(batch.Put([]byte(key), value))
+	}
+	test.require.NoError(batch.Write())
+	test.require.NoError(proof.Verify(test.ctx, startDB, start, end, endRoot.rootID))
+
+	// The changes in the proof must match the end root's values.
+	for _, kv := range proof.KeyValues {
+		expected, ok := endRoot.values[string(kv.Key)]
+		test.require.True(ok)
+		test.require.True(bytes.Equal(expected, kv.Value))
+	}
+	for _, key := range proof.DeletedKeys {
+		test.require.NotContains(endRoot.values, string(key))
+	}
+
+	// Every key that changed in the range covered by the proof must be in
+	// the proof.
+	largestKey := proof.getLargestKey(end)
+	if len(proof.KeyValues)+len(proof.DeletedKeys) < maxLength {
+		largestKey = end
+	}
+	changedKeys := make(map[string]struct{})
+	for _, kv := range proof.KeyValues {
+		changedKeys[string(kv.Key)] = struct{}{}
+	}
+	for _, key := range proof.DeletedKeys {
+		changedKeys[string(key)] = struct{}{}
+	}
+	for key := range test.keys {
+		if !inRange([]byte(key), start, largestKey) {
+			continue
+		}
+		before, hadBefore := startRoot.values[key]
+		after, hasAfter := endRoot.values[key]
+		if hadBefore != hasAfter || !bytes.Equal(before, after) {
+			test.require.Contains(changedKeys, key)
+		}
+	}
+
+	// Committing the proof to the database with the start root's values
+	// gives it the end root's values in the range covered by the proof.
+	test.require.NoError(startDB.CommitChangeProof(test.ctx, proof))
+	for key := range test.keys {
+		if !inRange([]byte(key), start, largestKey) {
+			continue
+		}
+		value, err := startDB.Get([]byte(key))
+		if expected, ok := endRoot.values[key]; ok {
+			test.require.NoError(err)
+			test.require.True(bytes.Equal(expected, value))
+		} else {
+			test.require.ErrorIs(err, database.ErrNotFound)
+		}
+	}
+}
+
+// Returns a range [start, end] where either bound may be unbounded.
+func (test *differentialTest) nextRange() ([]byte, []byte) {
+	var start, end []byte
+	if b := test.nextByte(); b%4 != 0 {
+		start = test.nextKey()
+	}
+	if b := test.nextByte(); b%4 != 0 {
+		end = test.nextKey()
+	}
+	if len(end) > 0 && bytes.Compare(start, end) > 0 {
+		start, end = end, start
+	}
+	return start, end
+}
+
+// Returns whether [key] is in [start, end].
+// If [start] is empty, there is no lower bound.
+// If [end] is empty, there is no upper bound.
+func inRange(key, start, end []byte) bool {
+	return bytes.Compare(key, start) >= 0 && (len(end) == 0 || bytes.Compare(key, end) <= 0)
+}
+
+// Returns the key-values in [values] with keys in [start, end], sorted by key.
+func sortedKeyValuesInRange(values map[string][]byte, start, end []byte) []KeyValue {
+	keyValues := make([]KeyValue, 0, len(values))
+	for key, value := range values {
+		if inRange([]byte(key), start, end) {
+			keyValues = append(keyValues, KeyValue{
+				Key:   []byte(key),
+				Value: value,
+			})
+		}
+	}
+	slices.SortFunc(keyValues, func(a, b KeyValue) bool {
+		return bytes.Compare(a.Key, b.Key) < 0
+	})
+	return keyValues
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/units"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+const (
+	exportMagic     = "merkledb"
+	exportVersion   = 0
+	exportHeaderLen = len(exportMagic) + wrappers.ShortLen + idLen
+
+	// The maximum number of key/value pairs in each chunk of an export.
+	exportChunkLength = 2048
+
+	// The maximum size of an encoded chunk that will be read during an
+	// import.
+	maxExportChunkSize = 256 * units.MiB
+)
+
+var ErrInvalidExport = errors.New("invalid export")
+
+// Export writes every key/value pair in the trie when its root was [rootID]
+// to [w].
+//
+// The export is a header containing [rootID] followed by a sequence of chunks.
+// Each chunk is a range proof for the key/value pairs that follow the previous
+// chunk, so the export can be verified against [rootID] while it is imported.
+//
+// The proofs are generated from the history, so [rootID] must remain in the
+// history until the export finishes.
+func (db *Database) Export(ctx context.Context, rootID ids.ID, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, 0, exportHeaderLen)
+	header = append(header, exportMagic...)
+	header = binary.BigEndian.AppendUint16(header, exportVersion)
+	header = append(header, rootID[:]...)
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	var start []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		proof, err := db.GetRangeProofAtRoot(ctx, rootID, start, nil, exportChunkLength)
+		if err != nil {
+			return err
+		}
+		proofBytes, err := db.codec.EncodeRangeProof(Version, proof)
+		if err != nil {
+			return err
+		}
+		if err := writeExportChunk(bw, proofBytes); err != nil {
+			return err
+		}
+
+		if len(proof.KeyValues) < exportChunkLength {
+			break
+		}
+		start = nextKey(proof.KeyValues[len(proof.KeyValues)-1].Key)
+	}
+
+	// An empty chunk marks the end of the export.
+	if err := writeExportChunk(bw, nil); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Import writes the key/value pairs exported by [Database.Export] from [r]
+// into [db], which is expected to be empty.
+//
+// Each chunk is verified against the root in the export's header before it is
+// committed, and the root of [db] is verified to match it once every chunk has
+// been imported. The root is returned, and callers must check that it's the
+// root they expect.
+func Import(ctx context.Context, r io.Reader, db *Database) (ids.ID, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, exportHeaderLen)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return ids.Empty, fmt.Errorf("%w: couldn't read header: %w", ErrInvalidExport, err)
+	}
+	if !bytes.HasPrefix(header, []byte(exportMagic)) {
+		return ids.Empty, fmt.Errorf("%w: unexpected header", ErrInvalidExport)
+	}
+	if version := binary.BigEndian.Uint16(header[len(exportMagic):]); version != exportVersion {
+		return ids.Empty, fmt.Errorf("%w: unknown version %d", ErrInvalidExport, version)
+	}
+	rootID, err := ids.ToID(header[len(exportMagic)+wrappers.ShortLen:])
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	var start []byte
+	for chunkIndex := 0; ; chunkIndex++ {
+		if err := ctx.Err(); err != nil {
+			return ids.Empty, err
+		}
+
+		proofBytes, err := readExportChunk(br)
+		if err != nil {
+			return ids.Empty, fmt.Errorf("%w: couldn't read chunk %d: %w", ErrInvalidExport, chunkIndex, err)
+		}
+		if len(proofBytes) == 0 {
+			break
+		}
+
+		proof := &RangeProof{}
+		if _, err := db.codec.DecodeRangeProof(proofBytes, proof); err != nil {
+			return ids.Empty, fmt.Errorf("%w: couldn't parse chunk %d: %w", ErrInvalidExport, chunkIndex, err)
+		}
+		if err := proof.Verify(ctx, start, nil, rootID, db.branchFactor, db.hasher); err != nil {
+			return ids.Empty, fmt.Errorf("%w: couldn't verify chunk %d: %w", ErrInvalidExport, chunkIndex, err)
+		}
+		if err := db.CommitRangeProof(ctx, start, proof); err != nil {
+			return ids.Empty, err
+		}
+
+		if len(proof.KeyValues) == 0 {
+			continue
+		}
+		start = nextKey(proof.KeyValues[len(proof.KeyValues)-1].Key)
+	}
+
+	importedRootID, err := db.GetMerkleRoot(ctx)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if importedRootID != rootID {
+		return ids.Empty, fmt.Errorf("%w: imported root %s doesn't match expected root %s", ErrInvalidExport, importedRootID, rootID)
+	}
+	return rootID, nil
+}
+
+func writeExportChunk(w io.Writer, chunk []byte) error {
+	chunkLen := make([]byte, wrappers.IntLen)
+	binary.BigEndian.PutUint32(chunkLen, uint32(len(chunk)))
+	if _, err := w.Write(chunkLen); err != nil {
+		return err
+	}
+	_, err := w.Write(chunk)
+	return err
+}
+
+func readExportChunk(r io.Reader) ([]byte, error) {
+	chunkLenBytes := make([]byte, wrappers.IntLen)
+	if _, err := io.ReadFull(r, chunkLenBytes); err != nil {
+		return nil, err
+	}
+	chunkLen := binary.BigEndian.Uint32(chunkLenBytes)
+	if chunkLen > maxExportChunkSize {
+		return nil, fmt.Errorf("chunk size %d exceeds maximum %d", chunkLen, maxExportChunkSize)
+	}
+	chunk := make([]byte, chunkLen)
+	_, err := io.ReadFull(r, chunk)
+	return chunk, err
+}
+
+// Returns the smallest key that is greater than [key].
+func nextKey(key []byte) []byte {
+	next := make([]byte, len(key)+1)
+	copy(next, key)
+	return next
+}
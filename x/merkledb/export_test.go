@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Returns [num] key/value pairs with distinct keys.
+func newExportKeyValues(r *rand.Rand, num int) []KeyValue {
+	keyValues := make([]KeyValue, num)
+	for i := range keyValues {
+		val := make([]byte, r.Intn(32)+1) // #nosec G404
+		_, _ = r.Read(val)                // #nosec G404
+		keyValues[i] = KeyValue{
+			Key:   []byte(strconv.Itoa(i)),
+			Value: val,
+		}
+	}
+	return keyValues
+}
+
+func TestExportImport(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	for _, kv := range newExportKeyValues(r, 2*exportChunkLength+10) {
+		require.NoError(db.Put(kv.Key, kv.Value))
+	}
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	// Modify the trie so that the export is generated from the history.
+	require.NoError(db.Put([]byte("after export root"), []byte("value")))
+
+	buf := &bytes.Buffer{}
+	require.NoError(db.Export(context.Background(), rootID, buf))
+	exportBytes := buf.Bytes()
+
+	importDB, err := getBasicDB()
+	require.NoError(err)
+	importedRootID, err := Import(context.Background(), bytes.NewReader(exportBytes), importDB)
+	require.NoError(err)
+	require.Equal(rootID, importedRootID)
+
+	importedRootID, err = importDB.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(rootID, importedRootID)
+}
+
+func TestExportImportEmpty(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(db.Export(context.Background(), rootID, buf))
+
+	importDB, err := getBasicDB()
+	require.NoError(err)
+	importedRootID, err := Import(context.Background(), buf, importDB)
+	require.NoError(err)
+	require.Equal(rootID, importedRootID)
+}
+
+func TestImportInvalid(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	keyValues := newExportKeyValues(r, exportChunkLength+10)
+	for _, kv := range keyValues {
+		require.NoError(db.Put(kv.Key, kv.Value))
+	}
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(db.Export(context.Background(), rootID, buf))
+	exportBytes := buf.Bytes()
+
+	tests := []struct {
+		name        string
+		exportBytes func() []byte
+	}{
+		{
+			name: "truncated",
+			exportBytes: func() []byte {
+				return exportBytes[:len(exportBytes)-1]
+			},
+		},
+		{
+			name: "invalid magic",
+			exportBytes: func() []byte {
+				b := bytes.Clone(exportBytes)
+				b[0]++
+				return b
+			},
+		},
+		{
+			name: "wrong root",
+			exportBytes: func() []byte {
+				b := bytes.Clone(exportBytes)
+				b[len(exportMagic)+2]++
+				return b
+			},
+		},
+		{
+			name: "modified value",
+			exportBytes: func() []byte {
+				var value []byte
+				for _, kv := range keyValues {
+					if len(kv.Value) > len(value) {
+						value = kv.Value
+					}
+				}
+				index := bytes.Index(exportBytes, value)
+				require.Positive(index)
+
+				b := bytes.Clone(exportBytes)
+				b[index]++
+				return b
+			},
+		},
+		{
+			name: "missing chunk",
+			exportBytes: func() []byte {
+				r := bytes.NewReader(exportBytes[exportHeaderLen:])
+				firstChunk, err := readExportChunk(r)
+				require.NoError(err)
+
+				// Only write the first chunk and the terminator.
+				b := &bytes.Buffer{}
+				_, err = b.Write(exportBytes[:exportHeaderLen])
+				require.NoError(err)
+				require.NoError(writeExportChunk(b, firstChunk))
+				require.NoError(writeExportChunk(b, nil))
+				return b.Bytes()
+			},
+		},
+	}
+	for _, test := range tests {
+		importDB, err := getBasicDB()
+		require.NoError(err)
+
+		_, err = Import(context.Background(), bytes.NewReader(test.exportBytes()), importDB)
+		require.ErrorIs(err, ErrInvalidExport, test.name)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+)
+
+// The default number of stored nodes checked while the database is locked
+// during garbage collection.
+const defaultGarbageCollectionBatchSize = 1024
+
+// GarbageCollectionReport describes the result of a garbage collection pass.
+type GarbageCollectionReport struct {
+	// The number of stored nodes that were checked.
+	NodesChecked uint64
+	// The number of stored nodes that weren't reachable from the root and
+	// were deleted.
+	NodesDeleted uint64
+	// The number of key and value bytes of the deleted nodes.
+	BytesReclaimed uint64
+	// True iff the node database was compacted after the deletions.
+	Compacted bool
+	Duration  time.Duration
+}
+
+// CollectGarbage deletes the stored nodes that aren't reachable from the
+// current root. These may be left behind by interrupted commits or rebuilds.
+//
+// The stored nodes are checked in batches of [batchSize]. Commits are blocked
+// while a batch is checked but the database remains readable, and commits may
+// happen between batches. The unreachable nodes of a batch are deleted while
+// the database is write locked. If [batchSize] isn't positive, a default is
+// used. If any nodes are deleted, the node database is compacted once every
+// batch has been checked.
+func (db *Database) CollectGarbage(ctx context.Context, batchSize int) (*GarbageCollectionReport, error) {
+	if batchSize <= 0 {
+		batchSize = defaultGarbageCollectionBatchSize
+	}
+
+	var (
+		startTime = time.Now()
+		report    = &GarbageCollectionReport{}
+		start     []byte
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		next, done, err := db.collectGarbageBatch(start, batchSize, report)
+		if err != nil {
+			db.metrics.GarbageCollectionFailed()
+			return nil, err
+		}
+		if done {
+			break
+		}
+		start = next
+	}
+
+	if report.NodesDeleted > 0 {
+		if err := db.nodeDB.Compact(nil, nil); err != nil {
+			db.metrics.GarbageCollectionFailed()
+			return nil, err
+		}
+		report.Compacted = true
+	}
+	report.Duration = time.Since(startTime)
+	return report, nil
+}
+
+// A stored node that wasn't reachable from the root when it was checked.
+type garbageNode struct {
+	key []byte
+	// The number of key and value bytes of the node.
+	size int
+}
+
+// Checks up to [batchSize] stored nodes with keys >= [start] and deletes the
+// ones that aren't reachable from the current root. Updates [report] with the
+// result. Returns the key to start the next batch at, and true if there are no
+// more stored nodes to check.
+func (db *Database) collectGarbageBatch(
+	start []byte,
+	batchSize int,
+	report *GarbageCollectionReport,
+) ([]byte, bool, error) {
+	lastKey, numChecked, garbage, err := db.findGarbage(start, batchSize)
+	if err != nil {
+		return nil, false, err
+	}
+
+	numDeleted, numReclaimed, err := db.deleteGarbage(garbage)
+	if err != nil {
+		return nil, false, err
+	}
+
+	report.NodesChecked += uint64(numChecked)
+	report.NodesDeleted += numDeleted
+	report.BytesReclaimed += numReclaimed
+
+	if numChecked < batchSize {
+		return nil, true, nil
+	}
+	return nextKey(lastKey), false, nil
+}
+
+// Returns the last key of the up to [batchSize] stored nodes with keys >=
+// [start], the number of stored nodes that were checked and the ones that
+// aren't reachable from the current root.
+func (db *Database) findGarbage(start []byte, batchSize int) ([]byte, int, []garbageNode, error) {
+	// Prevent the trie from changing while the batch is checked.
+	db.commitLock.RLock()
+	defer db.commitLock.RUnlock()
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, 0, nil, database.ErrClosed
+	}
+
+	var (
+		it         = db.nodeDB.NewIteratorWithStart(start)
+		lastKey    []byte
+		numChecked int
+		garbage    []garbageNode
+	)
+	defer it.Release()
+
+	for numChecked < batchSize && it.Next() {
+		key := slices.Clone(it.Key())
+		lastKey = key
+		numChecked++
+
+		reachable, err := db.isReachable(path(key))
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		if !reachable {
+			garbage = append(garbage, garbageNode{
+				key:  key,
+				size: len(key) + len(it.Value()),
+			})
+		}
+	}
+	return lastKey, numChecked, garbage, it.Error()
+}
+
+// Deletes the nodes in [garbage] that are still unreachable from the current
+// root. Returns the number of deleted nodes and their size.
+func (db *Database) deleteGarbage(garbage []garbageNode) (uint64, uint64, error) {
+	if len(garbage) == 0 {
+		return 0, 0, nil
+	}
+
+	db.commitLock.Lock()
+	defer db.commitLock.Unlock()
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return 0, 0, database.ErrClosed
+	}
+
+	var (
+		numDeleted   uint64
+		numReclaimed uint64
+	)
+	for _, n := range garbage {
+		// The trie may have been changed after [n] was found to be
+		// unreachable.
+		reachable, err := db.isReachable(path(n.key))
+		if err != nil {
+			return 0, 0, err
+		}
+		if reachable {
+			continue
+		}
+
+		if err := db.nodeDB.Delete(n.key); err != nil {
+			return 0, 0, err
+		}
+		numDeleted++
+		numReclaimed += uint64(n.size)
+	}
+	if numDeleted == 0 {
+		return 0, 0, nil
+	}
+	if err := db.nodeDB.Commit(); err != nil {
+		return 0, 0, err
+	}
+	db.metrics.NodesGarbageCollected(numDeleted, numReclaimed)
+	return numDeleted, numReclaimed, nil
+}
+
+// Returns true iff the node with [key] is in the trie.
+// Assumes [db.lock] is held.
+func (db *Database) isReachable(key path) (bool, error) {
+	var (
+		currentNode     = db.root
+		matchedKeyIndex = 0
+	)
+	for matchedKeyIndex < len(key) {
+		child, hasChild := currentNode.children[key[matchedKeyIndex]]
+		matchedKeyIndex++
+
+		if !hasChild || !key[matchedKeyIndex:].HasPrefix(child.compressedPath) {
+			return false, nil
+		}
+		matchedKeyIndex += len(child.compressedPath)
+
+		var err error
+		currentNode, err = db.getNode(key[:matchedKeyIndex])
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Runs [CollectGarbage] every [frequency] until [db] is closed.
+func (db *Database) collectGarbagePeriodically(frequency time.Duration, batchSize int) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-db.stopGarbageCollection:
+			return
+		}
+
+		// Failures are reported through the metrics and the next pass is
+		// attempted after [frequency].
+		_, _ = db.CollectGarbage(context.Background(), batchSize)
+	}
+}
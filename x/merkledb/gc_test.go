@@ -0,0 +1,252 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/database/prefixdb"
+)
+
+// Writes nodes that aren't reachable from the root of the database stored in
+// [baseDB] directly to disk. Returns the number of bytes written.
+func writeOrphanedNodes(t *testing.T, baseDB database.Database, keys [][]byte) uint64 {
+	require := require.New(t)
+
+	nodeDB := prefixdb.New(nodePrefix, baseDB)
+	numBytes := uint64(0)
+	for _, key := range keys {
+		n := newNode(nil, newPath(key, BranchFactor16))
+		n.setValue(DefaultHasher, Some([]byte("orphaned")))
+		nodeBytes, err := n.marshal(Codec)
+		require.NoError(err)
+
+		nodeKey := n.key.Bytes()
+		require.NoError(nodeDB.Put(nodeKey, nodeBytes))
+		numBytes += uint64(len(nodeKey) + len(nodeBytes))
+	}
+	return numBytes
+}
+
+func TestCollectGarbage(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	config := Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		NodeCacheSize: 100,
+	}
+	db, err := New(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	keyValues := newExportKeyValues(r, 1000)
+	for _, kv := range keyValues {
+		require.NoError(db.Put(kv.Key, kv.Value))
+	}
+	// Persist the intermediary nodes.
+	require.NoError(db.Close())
+	db, err = New(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	orphanedBytes := writeOrphanedNodes(t, baseDB, [][]byte{[]byte("orphaned"), []byte("1a")})
+
+	// The orphaned nodes are returned by iterators over the database.
+	it := db.NewIteratorWithPrefix([]byte("orphaned"))
+	require.True(it.Next())
+	it.Release()
+
+	// Use a small batch size so that commits can happen between batches.
+	report, err := db.CollectGarbage(context.Background(), 10)
+	require.NoError(err)
+	require.Equal(uint64(2), report.NodesDeleted)
+	require.Equal(orphanedBytes, report.BytesReclaimed)
+	require.Greater(report.NodesChecked, uint64(len(keyValues)))
+	require.True(report.Compacted)
+
+	it = db.NewIteratorWithPrefix([]byte("orphaned"))
+	require.False(it.Next())
+	it.Release()
+
+	// The trie is unchanged.
+	newRootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(rootID, newRootID)
+	for _, kv := range keyValues {
+		value, err := db.Get(kv.Key)
+		require.NoError(err)
+		require.Equal(kv.Value, value)
+	}
+
+	// Nothing is left to collect.
+	report, err = db.CollectGarbage(context.Background(), 0)
+	require.NoError(err)
+	require.Zero(report.NodesDeleted)
+	require.False(report.Compacted)
+
+	require.NoError(db.Close())
+	db, err = New(context.Background(), baseDB, config)
+	require.NoError(err)
+	newRootID, err = db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(rootID, newRootID)
+
+	require.NoError(db.Close())
+	_, err = db.CollectGarbage(context.Background(), 0)
+	require.ErrorIs(err, database.ErrClosed)
+}
+
+func TestCollectGarbagePeriodically(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := New(context.Background(), baseDB, Config{
+		Tracer:                     newNoopTracer(),
+		HistoryLength:              100,
+		NodeCacheSize:              100,
+		GarbageCollectionFrequency: 10 * time.Millisecond,
+	})
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key"), []byte("value")))
+
+	writeOrphanedNodes(t, baseDB, [][]byte{[]byte("orphaned")})
+	require.Eventually(
+		func() bool {
+			has, err := prefixdb.New(nodePrefix, baseDB).Has(newPath([]byte("orphaned"), BranchFactor16).Bytes())
+			return err == nil && !has
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	value, err := db.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.NoError(db.Close())
+}
+
+// Garbage collection must be safe to run concurrently with commits and reads.
+// Run with -race.
+func TestCollectGarbageConcurrent(t *testing.T) {
+	require := require.New(t)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	keyValues := newExportKeyValues(r, 500)
+
+	sourceDB, err := getBasicDB()
+	require.NoError(err)
+
+	baseDB := memdb.New()
+	db, err := New(context.Background(), baseDB, Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		// A small cache causes intermediary nodes to be evicted to disk
+		// while the trie is read.
+		NodeCacheSize: 10,
+	})
+	require.NoError(err)
+
+	for _, kv := range keyValues {
+		require.NoError(sourceDB.Put(kv.Key, kv.Value))
+		require.NoError(db.Put(kv.Key, kv.Value))
+	}
+
+	// Change the source database and record the changes as proofs.
+	var proofs []*ChangeProof
+	prevRootID, err := sourceDB.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 10; j++ {
+			kv := keyValues[r.Intn(len(keyValues))] // #nosec G404
+			if r.Intn(2) == 0 {                     // #nosec G404
+				require.NoError(sourceDB.Put(kv.Key, []byte{byte(i), byte(j)}))
+			} else {
+				require.NoError(sourceDB.Delete(kv.Key))
+			}
+		}
+		rootID, err := sourceDB.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		proof, err := sourceDB.GetChangeProof(context.Background(), prevRootID, rootID, nil, nil, len(keyValues))
+		require.NoError(err)
+		proofs = append(proofs, proof)
+		prevRootID = rootID
+	}
+
+	writeOrphanedNodes(t, baseDB, [][]byte{[]byte("orphaned"), []byte("1a")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var eg errgroup.Group
+	eg.Go(func() error {
+		for ctx.Err() == nil {
+			if _, err := db.CollectGarbage(context.Background(), 10); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		for ctx.Err() == nil {
+			kv := keyValues[rand.Intn(len(keyValues))] // #nosec G404
+			if _, err := db.GetProof(context.Background(), kv.Key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	for _, proof := range proofs {
+		require.NoError(db.CommitChangeProof(context.Background(), proof))
+	}
+	cancel()
+	require.NoError(eg.Wait())
+
+	// Collect the remaining garbage now that the trie no longer changes.
+	_, err = db.CollectGarbage(context.Background(), 10)
+	require.NoError(err)
+
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(prevRootID, rootID)
+
+	it := db.NewIteratorWithPrefix([]byte("orphaned"))
+	require.False(it.Next())
+	it.Release()
+
+	// Every node that is reachable from the root must still be readable after
+	// the database is reopened.
+	require.NoError(db.Close())
+	db, err = New(context.Background(), baseDB, Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		NodeCacheSize: 10,
+	})
+	require.NoError(err)
+	rootID, err = db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(prevRootID, rootID)
+
+	sourceIt := sourceDB.NewIterator()
+	defer sourceIt.Release()
+	for sourceIt.Next() {
+		value, err := db.Get(sourceIt.Key())
+		require.NoError(err)
+		require.Equal(sourceIt.Value(), value)
+	}
+	require.NoError(sourceIt.Error())
+	require.NoError(db.Close())
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"golang.org/x/crypto/sha3"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/hashing"
+)
+
+var (
+	_ Hasher = sha256Hasher{}
+	_ Hasher = keccak256Hasher{}
+
+	// SHA256Hasher hashes with SHA-256.
+	SHA256Hasher Hasher = sha256Hasher{}
+	// Keccak256Hasher hashes with Keccak-256, the hash function used by
+	// Ethereum's tries.
+	Keccak256Hasher Hasher = keccak256Hasher{}
+
+	DefaultHasher = SHA256Hasher
+
+	hasherIDPreimage = []byte("merkledb hasher")
+)
+
+// Hasher calculates the IDs of nodes and the digests of large values.
+// The same hasher must be used to build a trie and to verify its proofs.
+type Hasher interface {
+	// Returns the hash of [data].
+	Hash(data []byte) ids.ID
+}
+
+// Returns an identifier of [hasher] that is stored with a trie so that the
+// trie isn't opened with a different hasher.
+func hasherID(hasher Hasher) ids.ID {
+	return hasher.Hash(hasherIDPreimage)
+}
+
+type sha256Hasher struct{}
+
+func (sha256Hasher) Hash(data []byte) ids.ID {
+	return hashing.ComputeHash256Array(data)
+}
+
+type keccak256Hasher struct{}
+
+func (keccak256Hasher) Hash(data []byte) ids.ID {
+	var id ids.ID
+	h := sha3.NewLegacyKeccak256()
+	_, _ = h.Write(data)
+	h.Sum(id[:0])
+	return id
+}
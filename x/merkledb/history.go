@@ -10,6 +10,7 @@ import (
 	"github.com/google/btree"
 
 	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/math"
 )
 
 var (
@@ -77,15 +78,17 @@ func newTrieHistory(maxHistoryLookback int) *trieHistory {
 	}
 }
 
-// Returns up to [maxLength] key-value pair changes with keys in [start, end] that
-// occurred between [startRoot] and [endRoot].
-func (th *trieHistory) getValueChanges(startRoot, endRoot ids.ID, start, end []byte, maxLength int) (*changeSummary, error) {
+// Returns up to [maxLength] key-value pair changes with keys in
+// [startPath, endPath] that occurred between [startRoot] and [endRoot].
+// If [startPath] is empty, all keys are considered > [startPath].
+// If [endPath] is empty, all keys are considered < [endPath].
+func (th *trieHistory) getValueChanges(startRoot, endRoot ids.ID, startPath, endPath path, maxLength int) (*changeSummary, error) {
 	if maxLength <= 0 {
 		return nil, fmt.Errorf("%w but was %d", ErrInvalidMaxLength, maxLength)
 	}
 
 	if startRoot == endRoot {
-		return newChangeSummary(maxLength), nil
+		return newChangeSummary(math.Min(maxLength, defaultPreallocationSize)), nil
 	}
 
 	// Confirm there's a change resulting in [startRoot] before
@@ -126,14 +129,11 @@ func (th *trieHistory) getValueChanges(startRoot, endRoot ids.ID, start, end []b
 		},
 	)
 
-	startPath := newPath(start)
-	endPath := newPath(end)
-
 	// For each element in the history in the range between [startRoot]'s
 	// last appearance (exclusive) and [endRoot]'s last appearance (inclusive),
-	// add the changes to keys in [start, end] to [combinedChanges].
+	// add the changes to keys in [startPath, endPath] to [combinedChanges].
 	// Only the key-value pairs with the greatest [maxLength] keys will be kept.
-	combinedChanges := newChangeSummary(maxLength)
+	combinedChanges := newChangeSummary(math.Min(maxLength, defaultPreallocationSize))
 
 	// For each change after [lastStartRootChange] up to and including
 	// [lastEndRootChange], record the change in [combinedChanges].
@@ -178,21 +178,17 @@ func (th *trieHistory) getValueChanges(startRoot, endRoot ids.ID, start, end []b
 }
 
 // Returns the changes to go from the current trie state back to the requested [rootID]
-// for the keys in [start, end].
-// If [start] is nil, all keys are considered > [start].
-// If  [end] is nil, all keys are considered < [end].
-func (th *trieHistory) getChangesToGetToRoot(rootID ids.ID, start, end []byte) (*changeSummary, error) {
+// for the keys in [startPath, endPath].
+// If [startPath] is empty, all keys are considered > [startPath].
+// If [endPath] is empty, all keys are considered < [endPath].
+func (th *trieHistory) getChangesToGetToRoot(rootID ids.ID, startPath, endPath path) (*changeSummary, error) {
 	// [lastRootChange] is the last change in the history resulting in [rootID].
 	lastRootChange, ok := th.lastChanges[rootID]
 	if !ok {
 		return nil, ErrRootIDNotPresent
 	}
 
-	var (
-		startPath       = newPath(start)
-		endPath         = newPath(end)
-		combinedChanges = newChangeSummary(defaultPreallocationSize)
-	)
+	combinedChanges := newChangeSummary(defaultPreallocationSize)
 
 	// Go backward from the most recent change in the history up to but
 	// not including the last change resulting in [rootID].
@@ -25,7 +25,7 @@ func (i *iterator) Key() []byte {
 	if i.current == nil {
 		return nil
 	}
-	return i.current.key.Serialize().Value
+	return i.current.key.Serialize(i.db.branchFactor).Value
 }
 
 func (i *iterator) Value() []byte {
@@ -42,7 +42,7 @@ func (i *iterator) Next() bool {
 	}
 	for i.nodeIter.Next() {
 		i.db.metrics.IOKeyRead()
-		n, err := parseNode(path(i.nodeIter.Key()), i.nodeIter.Value())
+		n, err := parseNode(i.db.codec, i.db.hasher, path(i.nodeIter.Key()), i.nodeIter.Value())
 		if err != nil {
 			i.err = err
 			return false
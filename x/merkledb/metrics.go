@@ -26,6 +26,8 @@ type merkleMetrics interface {
 	ViewNodeCacheMiss()
 	ViewValueCacheHit()
 	ViewValueCacheMiss()
+	NodesGarbageCollected(numNodes, numBytes uint64)
+	GarbageCollectionFailed()
 }
 
 type mockMetrics struct {
@@ -39,6 +41,9 @@ type mockMetrics struct {
 	viewNodeCacheMiss  int64
 	viewValueCacheHit  int64
 	viewValueCacheMiss int64
+	gcNodesDeleted     uint64
+	gcBytesReclaimed   uint64
+	gcFailures         int64
 }
 
 func (m *mockMetrics) HashCalculated() {
@@ -104,6 +109,21 @@ func (m *mockMetrics) DBNodeCacheMiss() {
 	m.dbNodeCacheMiss++
 }
 
+func (m *mockMetrics) NodesGarbageCollected(numNodes, numBytes uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.gcNodesDeleted += numNodes
+	m.gcBytesReclaimed += numBytes
+}
+
+func (m *mockMetrics) GarbageCollectionFailed() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.gcFailures++
+}
+
 type metrics struct {
 	ioKeyWrite         prometheus.Counter
 	ioKeyRead          prometheus.Counter
@@ -114,6 +134,9 @@ type metrics struct {
 	viewNodeCacheMiss  prometheus.Counter
 	viewValueCacheHit  prometheus.Counter
 	viewValueCacheMiss prometheus.Counter
+	gcNodesDeleted     prometheus.Counter
+	gcBytesReclaimed   prometheus.Counter
+	gcFailures         prometheus.Counter
 }
 
 func newMetrics(namespace string, reg prometheus.Registerer) (merkleMetrics, error) {
@@ -166,6 +189,21 @@ func newMetrics(namespace string, reg prometheus.Registerer) (merkleMetrics, err
 			Name:      "view_value_cache_miss",
 			Help:      "cumulative amount of misses on the view value cache",
 		}),
+		gcNodesDeleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "gc_nodes_deleted",
+			Help:      "cumulative number of unreachable nodes deleted by garbage collection",
+		}),
+		gcBytesReclaimed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "gc_bytes_reclaimed",
+			Help:      "cumulative number of bytes deleted by garbage collection",
+		}),
+		gcFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "gc_failures",
+			Help:      "cumulative number of failed garbage collection passes",
+		}),
 	}
 	errs := wrappers.Errs{}
 	errs.Add(
@@ -178,6 +216,9 @@ func newMetrics(namespace string, reg prometheus.Registerer) (merkleMetrics, err
 		reg.Register(m.viewNodeCacheMiss),
 		reg.Register(m.viewValueCacheHit),
 		reg.Register(m.viewValueCacheMiss),
+		reg.Register(m.gcNodesDeleted),
+		reg.Register(m.gcBytesReclaimed),
+		reg.Register(m.gcFailures),
 	)
 	return &m, errs.Err
 }
@@ -217,3 +258,12 @@ func (m *metrics) DBNodeCacheHit() {
 func (m *metrics) DBNodeCacheMiss() {
 	m.dbNodeCacheMiss.Inc()
 }
+
+func (m *metrics) NodesGarbageCollected(numNodes, numBytes uint64) {
+	m.gcNodesDeleted.Add(float64(numNodes))
+	m.gcBytesReclaimed.Add(float64(numBytes))
+}
+
+func (m *metrics) GarbageCollectionFailed() {
+	m.gcFailures.Inc()
+}
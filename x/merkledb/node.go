@@ -7,19 +7,15 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/VidarSolutions/avalanchego/ids"
-	"github.com/VidarSolutions/avalanchego/utils/hashing"
 )
 
-const (
-	NodeBranchFactor = 16
-	HashLength       = 32
-)
+const HashLength = 32
 
 // the values that go into the node's id
 type hashValues struct {
 	Children map[byte]child
 	Value    Maybe[[]byte]
-	Key      SerializedPath
+	Key      path
 }
 
 // Representation of a node stored in the database.
@@ -47,7 +43,7 @@ type node struct {
 func newNode(parent *node, key path) *node {
 	newNode := &node{
 		dbNode: dbNode{
-			children: make(map[byte]child, NodeBranchFactor),
+			children: make(map[byte]child),
 		},
 		key: key,
 	}
@@ -57,10 +53,11 @@ func newNode(parent *node, key path) *node {
 	return newNode
 }
 
-// Parse [nodeBytes] to a node and set its key to [key].
-func parseNode(key path, nodeBytes []byte) (*node, error) {
+// Parse [nodeBytes] to a node with [codec] and set its key to [key].
+// The node's value digest is calculated with [hasher].
+func parseNode(codec Decoder, hasher Hasher, key path, nodeBytes []byte) (*node, error) {
 	n := dbNode{}
-	if _, err := Codec.decodeDBNode(nodeBytes, &n); err != nil {
+	if _, err := codec.decodeDBNode(nodeBytes, &n); err != nil {
 		return nil, err
 	}
 	result := &node{
@@ -69,7 +66,7 @@ func parseNode(key path, nodeBytes []byte) (*node, error) {
 		nodeBytes: nodeBytes,
 	}
 
-	result.setValueDigest()
+	result.setValueDigest(hasher)
 	return result, nil
 }
 
@@ -78,13 +75,13 @@ func (n *node) hasValue() bool {
 	return !n.value.IsNothing()
 }
 
-// Returns the byte representation of this node.
-func (n *node) marshal() ([]byte, error) {
+// Returns the byte representation of this node, encoded with [codec].
+func (n *node) marshal(codec Encoder) ([]byte, error) {
 	if n.nodeBytes != nil {
 		return n.nodeBytes, nil
 	}
 
-	nodeBytes, err := Codec.encodeDBNode(Version, &(n.dbNode))
+	nodeBytes, err := codec.encodeDBNode(Version, &(n.dbNode))
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +97,8 @@ func (n *node) onNodeChanged() {
 }
 
 // Returns and caches the ID of this node.
-func (n *node) calculateID(metrics merkleMetrics) error {
+// The ID is the hash, by [hasher], of the node's values encoded with [codec].
+func (n *node) calculateID(codec Encoder, hasher Hasher, metrics merkleMetrics) error {
 	if n.id != ids.Empty {
 		return nil
 	}
@@ -108,31 +106,33 @@ func (n *node) calculateID(metrics merkleMetrics) error {
 	hv := &hashValues{
 		Children: n.children,
 		Value:    n.valueDigest,
-		Key:      n.key.Serialize(),
+		Key:      n.key,
 	}
 
-	bytes, err := Codec.encodeHashValues(Version, hv)
+	bytes, err := codec.encodeHashValues(Version, hv)
 	if err != nil {
 		return err
 	}
 
 	metrics.HashCalculated()
-	n.id = hashing.ComputeHash256Array(bytes)
+	n.id = hasher.Hash(bytes)
 	return nil
 }
 
 // Set [n]'s value to [val].
-func (n *node) setValue(val Maybe[[]byte]) {
+// The value digest is calculated with [hasher].
+func (n *node) setValue(hasher Hasher, val Maybe[[]byte]) {
 	n.onNodeChanged()
 	n.value = val
-	n.setValueDigest()
+	n.setValueDigest(hasher)
 }
 
-func (n *node) setValueDigest() {
+func (n *node) setValueDigest(hasher Hasher) {
 	if n.value.IsNothing() || len(n.value.value) < HashLength {
 		n.valueDigest = n.value
 	} else {
-		n.valueDigest = Some(hashing.ComputeHash256(n.value.value))
+		valueHash := hasher.Hash(n.value.value)
+		n.valueDigest = Some(valueHash[:])
 	}
 }
 
@@ -160,7 +160,7 @@ func (n *node) addChildWithoutNode(index byte, compressedPath path, childID ids.
 // Assumes this node has exactly one child.
 func (n *node) getSingleChildPath() path {
 	for index, entry := range n.children {
-		return n.key + path(index) + entry.compressedPath
+		return n.key.Append(index) + entry.compressedPath
 	}
 	return ""
 }
@@ -189,10 +189,11 @@ func (n *node) clone() *node {
 	}
 }
 
-// Returns the ProofNode representation of this node.
-func (n *node) asProofNode() ProofNode {
+// Returns the ProofNode representation of this node in a trie with
+// [branchFactor].
+func (n *node) asProofNode(branchFactor BranchFactor) ProofNode {
 	pn := ProofNode{
-		KeyPath:     n.key.Serialize(),
+		KeyPath:     n.key.Serialize(branchFactor),
 		Children:    make(map[byte]ids.ID, len(n.children)),
 		ValueOrHash: Clone(n.valueDigest),
 	}
@@ -5,6 +5,9 @@ package merkledb
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"math/bits"
 	"reflect"
 	"strings"
 	"unsafe"
@@ -12,69 +15,122 @@ import (
 
 const EmptyPath path = ""
 
+var ErrInvalidBranchFactor = errors.New("branch factor must be 2, 4, 16, or 256")
+
+// BranchFactor is the number of children each node in the trie can have.
+// Keys are split into tokens of log2(BranchFactor) bits, and the children of
+// a node are indexed by the next token of their paths.
+type BranchFactor int
+
+const (
+	BranchFactor2   BranchFactor = 2
+	BranchFactor4   BranchFactor = 4
+	BranchFactor16  BranchFactor = 16
+	BranchFactor256 BranchFactor = 256
+
+	DefaultBranchFactor = BranchFactor16
+)
+
+// Valid returns an error if [b] isn't a supported branch factor.
+func (b BranchFactor) Valid() error {
+	switch b {
+	case BranchFactor2, BranchFactor4, BranchFactor16, BranchFactor256:
+		return nil
+	default:
+		return fmt.Errorf("%w but was %d", ErrInvalidBranchFactor, b)
+	}
+}
+
+// Returns the number of bits in each token.
+func (b BranchFactor) tokenBits() int {
+	return bits.TrailingZeros(uint(b))
+}
+
+// Returns the number of tokens in each byte of a key.
+func (b BranchFactor) tokensPerByte() int {
+	return 8 / b.tokenBits()
+}
+
 // SerializedPath contains a path from the trie.
-// The trie branch factor is 16, so the path may contain an odd number of nibbles.
-// If it did contain an odd number of nibbles, the last 4 bits of the last byte should be discarded.
+// Each byte holds 8/log2(branch factor) tokens, so the last byte may only be
+// partially used. If so, the unused bits of the last byte are 0 and should be
+// discarded.
 type SerializedPath struct {
-	NibbleLength int
-	Value        []byte
+	TokenLength int
+	Value       []byte
 }
 
-func (s SerializedPath) hasOddLength() bool {
-	return s.NibbleLength&1 == 1
+// NewSerializedPath returns the serialized path of [key] in a trie with
+// [branchFactor].
+func NewSerializedPath(key []byte, branchFactor BranchFactor) SerializedPath {
+	return SerializedPath{
+		TokenLength: len(key) * branchFactor.tokensPerByte(),
+		Value:       key,
+	}
+}
+
+// Returns true iff the last byte of [s] isn't fully used by its tokens.
+func (s SerializedPath) hasPartialByte(branchFactor BranchFactor) bool {
+	return s.TokenLength%branchFactor.tokensPerByte() != 0
 }
 
 func (s SerializedPath) Equal(other SerializedPath) bool {
-	return s.NibbleLength == other.NibbleLength && bytes.Equal(s.Value, other.Value)
+	return s.TokenLength == other.TokenLength && bytes.Equal(s.Value, other.Value)
 }
 
-func (s SerializedPath) deserialize() path {
-	result := newPath(s.Value)
-	// trim the last nibble if the path has an odd length
-	return result[:len(result)-s.NibbleLength&1]
+func (s SerializedPath) deserialize(branchFactor BranchFactor) path {
+	result := newPath(s.Value, branchFactor)
+	// trim the unused tokens of the last byte if it's only partially used
+	tokensPerByte := branchFactor.tokensPerByte()
+	if remainder := s.TokenLength % tokensPerByte; remainder != 0 {
+		return result[:len(result)-tokensPerByte+remainder]
+	}
+	return result
 }
 
 // Returns true iff [prefix] is a prefix of [s] or equal to it.
-func (s SerializedPath) HasPrefix(prefix SerializedPath) bool {
+func (s SerializedPath) HasPrefix(prefix SerializedPath, branchFactor BranchFactor) bool {
 	if len(s.Value) < len(prefix.Value) {
 		return false
 	}
 	prefixValue := prefix.Value
-	if !prefix.hasOddLength() {
+	remainderBits := prefix.TokenLength * branchFactor.tokenBits() % 8
+	if remainderBits == 0 {
 		return bytes.HasPrefix(s.Value, prefixValue)
 	}
 	reducedSize := len(prefixValue) - 1
 
-	// grab the last nibble in the prefix and serialized path
-	prefixRemainder := prefixValue[reducedSize] >> 4
-	valueRemainder := s.Value[reducedSize] >> 4
+	// only compare the bits of the last byte that are used by the prefix
+	mask := byte(0xFF) << (8 - remainderBits)
+	prefixRemainder := prefixValue[reducedSize] & mask
+	valueRemainder := s.Value[reducedSize] & mask
 	prefixValue = prefixValue[:reducedSize]
 	return bytes.HasPrefix(s.Value, prefixValue) && valueRemainder == prefixRemainder
 }
 
 // Returns true iff [prefix] is a prefix of [s] but not equal to it.
-func (s SerializedPath) HasStrictPrefix(prefix SerializedPath) bool {
-	return s.HasPrefix(prefix) && !s.Equal(prefix)
+func (s SerializedPath) HasStrictPrefix(prefix SerializedPath, branchFactor BranchFactor) bool {
+	return s.HasPrefix(prefix, branchFactor) && !s.Equal(prefix)
 }
 
-func (s SerializedPath) NibbleVal(nibbleIndex int) byte {
-	value := s.Value[nibbleIndex>>1]
-	isOdd := byte(nibbleIndex & 1)
-	isEven := (1 - isOdd)
-
-	// return value first(even index) or last 4(odd index) bits of the corresponding byte
-	return isEven*value>>4 + isOdd*(value&0x0F)
+// Token returns the token at [tokenIndex] in [s].
+func (s SerializedPath) Token(tokenIndex int, branchFactor BranchFactor) byte {
+	tokenBits := branchFactor.tokenBits()
+	bitIndex := tokenIndex * tokenBits
+	shift := 8 - tokenBits - bitIndex%8
+	return s.Value[bitIndex/8] >> shift & byte(branchFactor-1)
 }
 
-func (s SerializedPath) AppendNibble(nibble byte) SerializedPath {
-	// even is 1 if even, 0 if odd
-	even := 1 - s.NibbleLength&1
-	value := make([]byte, len(s.Value)+even)
+// AppendToken returns [s] with [token] appended to it.
+func (s SerializedPath) AppendToken(token byte, branchFactor BranchFactor) SerializedPath {
+	tokenBits := branchFactor.tokenBits()
+	bitIndex := s.TokenLength * tokenBits
+	value := make([]byte, (bitIndex+tokenBits+7)/8)
 	copy(value, s.Value)
 
-	// shift the nibble 4 left if even, do nothing if odd
-	value[len(value)-1] += nibble << (4 * even)
-	return SerializedPath{Value: value, NibbleLength: s.NibbleLength + 1}
+	shift := 8 - tokenBits - bitIndex%8
+	value[bitIndex/8] |= token << shift
+	return SerializedPath{Value: value, TokenLength: s.TokenLength + 1}
 }
 
 type path string
@@ -103,49 +159,48 @@ func (p path) HasPrefix(prefix path) bool {
 
 // Append [val] to [p].
 func (p path) Append(val byte) path {
-	return p + path(val)
+	// convert from a byte slice, as converting [val] directly would append
+	// its UTF-8 encoding, which is 2 bytes when [val] >= 128
+	return p + path([]byte{val})
 }
 
-// Returns the serialized representation of [p].
-func (p path) Serialize() SerializedPath {
-	// need half the number of bytes as nibbles
-	// add one so there is a byte for the odd nibble if it exists
-	// the extra nibble gets rounded down if even length
-	byteLength := (len(p) + 1) / 2
+// Returns the serialized representation of [p] in a trie with [branchFactor].
+func (p path) Serialize(branchFactor BranchFactor) SerializedPath {
+	// round up so there is a byte for the trailing tokens if they don't fill
+	// a whole byte
+	tokenBits := branchFactor.tokenBits()
+	byteLength := (len(p)*tokenBits + 7) / 8
 
 	result := SerializedPath{
-		NibbleLength: len(p),
-		Value:        make([]byte, byteLength),
+		TokenLength: len(p),
+		Value:       make([]byte, byteLength),
 	}
 
-	// loop over the path's bytes
-	// if the length is odd, subtract 1 so we don't overflow on the p[pathIndex+1]
-	keyIndex := 0
-	lastIndex := len(p) - len(p)&1
-	for pathIndex := 0; pathIndex < lastIndex; pathIndex += 2 {
-		result.Value[keyIndex] = p[pathIndex]<<4 + p[pathIndex+1]
-		keyIndex++
+	// each token is shifted into its place in the byte, starting from the
+	// most significant bits
+	for tokenIndex, token := range []byte(p) {
+		bitIndex := tokenIndex * tokenBits
+		shift := 8 - tokenBits - bitIndex%8
+		result.Value[bitIndex/8] |= token << shift
 	}
-
-	// if there is was a odd number of nibbles, grab the last one
-	if result.hasOddLength() {
-		result.Value[keyIndex] = p[keyIndex<<1] << 4
-	}
-
 	return result
 }
 
-func newPath(p []byte) path {
-	// create new buffer with double the length of the input since each byte gets split into two nibbles
-	buffer := make([]byte, 2*len(p))
+// Returns the path of [p] in a trie with [branchFactor].
+func newPath(p []byte, branchFactor BranchFactor) path {
+	// create a new buffer with a token for each [tokenBits] bits of the input
+	tokenBits := branchFactor.tokenBits()
+	tokensPerByte := 8 / tokenBits
+	mask := byte(branchFactor - 1)
+	buffer := make([]byte, tokensPerByte*len(p))
 
-	// first nibble gets shifted right 4 (divided by 16) to isolate the first nibble
-	// second nibble gets bitwise anded with 0x0F (1111) to isolate the second nibble
+	// the first token of each byte is in its most significant bits
 	bufferIndex := 0
 	for _, currentByte := range p {
-		buffer[bufferIndex] = currentByte >> 4
-		buffer[bufferIndex+1] = currentByte & 0x0F
-		bufferIndex += 2
+		for shift := 8 - tokenBits; shift >= 0; shift -= tokenBits {
+			buffer[bufferIndex] = currentByte >> shift & mask
+			bufferIndex++
+		}
 	}
 
 	// avoid copying during the conversion
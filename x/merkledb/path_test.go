@@ -4,74 +4,153 @@
 package merkledb
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
-func Test_SerializedPath_NibbleVal(t *testing.T) {
+func Test_SerializedPath_Token(t *testing.T) {
 	path := SerializedPath{Value: []byte{240, 237}}
-	require.Equal(t, byte(15), path.NibbleVal(0))
-	require.Equal(t, byte(0), path.NibbleVal(1))
-	require.Equal(t, byte(14), path.NibbleVal(2))
-	require.Equal(t, byte(13), path.NibbleVal(3))
+	require.Equal(t, byte(15), path.Token(0, BranchFactor16))
+	require.Equal(t, byte(0), path.Token(1, BranchFactor16))
+	require.Equal(t, byte(14), path.Token(2, BranchFactor16))
+	require.Equal(t, byte(13), path.Token(3, BranchFactor16))
 }
 
-func Test_SerializedPath_AppendNibble(t *testing.T) {
+func Test_SerializedPath_AppendToken(t *testing.T) {
 	path := SerializedPath{Value: []byte{}}
-	require.Equal(t, 0, path.NibbleLength)
+	require.Equal(t, 0, path.TokenLength)
 
-	path = path.AppendNibble(1)
-	require.Equal(t, 1, path.NibbleLength)
-	require.Equal(t, byte(1), path.NibbleVal(0))
+	path = path.AppendToken(1, BranchFactor16)
+	require.Equal(t, 1, path.TokenLength)
+	require.Equal(t, byte(1), path.Token(0, BranchFactor16))
 
-	path = path.AppendNibble(2)
-	require.Equal(t, 2, path.NibbleLength)
-	require.Equal(t, byte(2), path.NibbleVal(1))
+	path = path.AppendToken(2, BranchFactor16)
+	require.Equal(t, 2, path.TokenLength)
+	require.Equal(t, byte(2), path.Token(1, BranchFactor16))
 }
 
 func Test_SerializedPath_Has_Prefix(t *testing.T) {
 	first := SerializedPath{Value: []byte("FirstKey")}
 	prefix := SerializedPath{Value: []byte("FirstKe")}
-	require.True(t, first.HasPrefix(prefix))
-	require.True(t, first.HasStrictPrefix(prefix))
-
-	first = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 16}
-	prefix = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
-	require.True(t, first.HasPrefix(prefix))
-	require.True(t, first.HasStrictPrefix(prefix))
-
-	first = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
-	prefix = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
-	require.True(t, first.HasPrefix(prefix))
-	require.False(t, first.HasStrictPrefix(prefix))
-
-	first = SerializedPath{Value: []byte{247}, NibbleLength: 2}
-	prefix = SerializedPath{Value: []byte{240}, NibbleLength: 2}
-	require.False(t, first.HasPrefix(prefix))
-	require.False(t, first.HasStrictPrefix(prefix))
-
-	first = SerializedPath{Value: []byte{247}, NibbleLength: 2}
-	prefix = SerializedPath{Value: []byte{240}, NibbleLength: 1}
-	require.True(t, first.HasPrefix(prefix))
-	require.True(t, first.HasStrictPrefix(prefix))
-
-	first = SerializedPath{Value: []byte{}, NibbleLength: 0}
-	prefix = SerializedPath{Value: []byte{}, NibbleLength: 0}
-	require.True(t, first.HasPrefix(prefix))
-	require.False(t, first.HasStrictPrefix(prefix))
+	require.True(t, first.HasPrefix(prefix, BranchFactor16))
+	require.True(t, first.HasStrictPrefix(prefix, BranchFactor16))
+
+	first = SerializedPath{Value: []byte("FirstKey"), TokenLength: 16}
+	prefix = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
+	require.True(t, first.HasPrefix(prefix, BranchFactor16))
+	require.True(t, first.HasStrictPrefix(prefix, BranchFactor16))
+
+	first = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
+	prefix = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
+	require.True(t, first.HasPrefix(prefix, BranchFactor16))
+	require.False(t, first.HasStrictPrefix(prefix, BranchFactor16))
+
+	first = SerializedPath{Value: []byte{247}, TokenLength: 2}
+	prefix = SerializedPath{Value: []byte{240}, TokenLength: 2}
+	require.False(t, first.HasPrefix(prefix, BranchFactor16))
+	require.False(t, first.HasStrictPrefix(prefix, BranchFactor16))
+
+	first = SerializedPath{Value: []byte{247}, TokenLength: 2}
+	prefix = SerializedPath{Value: []byte{240}, TokenLength: 1}
+	require.True(t, first.HasPrefix(prefix, BranchFactor16))
+	require.True(t, first.HasStrictPrefix(prefix, BranchFactor16))
+
+	first = SerializedPath{Value: []byte{}, TokenLength: 0}
+	prefix = SerializedPath{Value: []byte{}, TokenLength: 0}
+	require.True(t, first.HasPrefix(prefix, BranchFactor16))
+	require.False(t, first.HasStrictPrefix(prefix, BranchFactor16))
 }
 
 func Test_SerializedPath_Equal(t *testing.T) {
-	first := SerializedPath{Value: []byte("FirstKey"), NibbleLength: 16}
-	prefix := SerializedPath{Value: []byte("FirstKey"), NibbleLength: 16}
+	first := SerializedPath{Value: []byte("FirstKey"), TokenLength: 16}
+	prefix := SerializedPath{Value: []byte("FirstKey"), TokenLength: 16}
 	require.True(t, first.Equal(prefix))
 
-	first = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 16}
-	prefix = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
+	first = SerializedPath{Value: []byte("FirstKey"), TokenLength: 16}
+	prefix = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
 	require.False(t, first.Equal(prefix))
 
-	first = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
-	prefix = SerializedPath{Value: []byte("FirstKey"), NibbleLength: 15}
+	first = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
+	prefix = SerializedPath{Value: []byte("FirstKey"), TokenLength: 15}
 	require.True(t, first.Equal(prefix))
 }
+
+var branchFactors = []BranchFactor{
+	BranchFactor2,
+	BranchFactor4,
+	BranchFactor16,
+	BranchFactor256,
+}
+
+func Test_BranchFactor_Valid(t *testing.T) {
+	for _, bf := range branchFactors {
+		require.NoError(t, bf.Valid())
+	}
+	for _, bf := range []BranchFactor{0, 1, 3, 8, 32, 512} {
+		require.ErrorIs(t, bf.Valid(), ErrInvalidBranchFactor)
+	}
+}
+
+func Test_SerializedPath_Token_BranchFactors(t *testing.T) {
+	path := SerializedPath{Value: []byte{0b1110_0100}}
+
+	require.Equal(t, byte(1), path.Token(0, BranchFactor2))
+	require.Equal(t, byte(1), path.Token(2, BranchFactor2))
+	require.Equal(t, byte(0), path.Token(3, BranchFactor2))
+	require.Equal(t, byte(0), path.Token(7, BranchFactor2))
+
+	require.Equal(t, byte(3), path.Token(0, BranchFactor4))
+	require.Equal(t, byte(2), path.Token(1, BranchFactor4))
+	require.Equal(t, byte(1), path.Token(2, BranchFactor4))
+	require.Equal(t, byte(0), path.Token(3, BranchFactor4))
+
+	require.Equal(t, byte(14), path.Token(0, BranchFactor16))
+	require.Equal(t, byte(4), path.Token(1, BranchFactor16))
+
+	require.Equal(t, byte(0b1110_0100), path.Token(0, BranchFactor256))
+}
+
+func Test_SerializedPath_AppendToken_BranchFactors(t *testing.T) {
+	for _, bf := range branchFactors {
+		t.Run(fmt.Sprintf("branch factor %d", bf), func(t *testing.T) {
+			require := require.New(t)
+
+			path := SerializedPath{Value: []byte{}}
+			tokens := make([]byte, 2*bf.tokensPerByte()+1)
+			for i := range tokens {
+				tokens[i] = byte(i % int(bf))
+				path = path.AppendToken(tokens[i], bf)
+				require.Equal(i+1, path.TokenLength)
+				require.Len(path.Value, (i*bf.tokenBits())/8+1)
+			}
+			for i, token := range tokens {
+				require.Equal(token, path.Token(i, bf))
+			}
+			require.True(path.hasPartialByte(bf) || bf == BranchFactor256)
+			require.Equal(path, path.deserialize(bf).Serialize(bf))
+		})
+	}
+}
+
+func Test_Path_Serialize_BranchFactors(t *testing.T) {
+	key := []byte{0b1110_0100, 0b0001_1011}
+	for _, bf := range branchFactors {
+		t.Run(fmt.Sprintf("branch factor %d", bf), func(t *testing.T) {
+			require := require.New(t)
+
+			p := newPath(key, bf)
+			require.Len(p, len(key)*bf.tokensPerByte())
+			require.Equal(NewSerializedPath(key, bf), p.Serialize(bf))
+			require.Equal(p, p.Serialize(bf).deserialize(bf))
+
+			// Dropping the last token leaves a partially used byte unless each
+			// token is a whole byte.
+			prefix := p[:len(p)-1].Serialize(bf)
+			require.Equal(bf != BranchFactor256, prefix.hasPartialByte(bf))
+			require.Equal(p[:len(p)-1], prefix.deserialize(bf))
+			require.True(p.Serialize(bf).HasStrictPrefix(prefix, bf))
+		})
+	}
+}
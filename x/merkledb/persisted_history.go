@@ -0,0 +1,398 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/math"
+	"github.com/VidarSolutions/avalanchego/utils/timer/mockable"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+const timestampLen = wrappers.LongLen
+
+var (
+	// Key prefixes within the persisted history database.
+	historyChangePrefix = []byte{0}
+	historyRootPrefix   = []byte{1}
+	historyIndicesKey   = []byte{2}
+
+	errInvalidHistoryIndices = errors.New("invalid persisted history indices")
+)
+
+// Stores the change summaries committed to the database on disk so that
+// historical proofs can be served for roots that are no longer in the
+// in-memory history, including roots from before a restart.
+//
+// The history is stored as:
+//   - [historyChangePrefix] + index --> timestamp + change summary
+//   - [historyRootPrefix] + root ID --> index of the most recent change
+//     resulting in the root ID
+//   - [historyIndicesKey] --> [oldestIndex] + [nextIndex]
+//
+// Changes with larger indices were made after changes with smaller indices.
+type persistedHistory struct {
+	db database.Database
+
+	// Encodes and decodes the stored changes.
+	codec EncoderDecoder
+	// Calculates the IDs of the nodes in the stored changes.
+	hasher Hasher
+
+	// Maximum number of changes to store. If 0, the number isn't limited.
+	maxLength int
+	// Maximum age of stored changes. If 0, changes don't expire.
+	maxAge time.Duration
+
+	clock mockable.Clock
+
+	// The stored changes have indices in [oldestIndex, nextIndex).
+	oldestIndex uint64
+	nextIndex   uint64
+}
+
+// Returns the persisted history stored in [db].
+// If the most recent persisted change doesn't result in [rootID], the history
+// is no longer contiguous with the database and is discarded.
+// The history is guaranteed to contain a change resulting in [rootID].
+func newPersistedHistory(
+	db database.Database,
+	codec EncoderDecoder,
+	hasher Hasher,
+	maxLength int,
+	maxAge time.Duration,
+	rootID ids.ID,
+) (*persistedHistory, error) {
+	h := &persistedHistory{
+		db:        db,
+		codec:     codec,
+		hasher:    hasher,
+		maxLength: maxLength,
+		maxAge:    maxAge,
+	}
+
+	indicesBytes, err := db.Get(historyIndicesKey)
+	switch err {
+	case nil:
+		if len(indicesBytes) != 2*wrappers.LongLen {
+			return nil, errInvalidHistoryIndices
+		}
+		h.oldestIndex = binary.BigEndian.Uint64(indicesBytes)
+		h.nextIndex = binary.BigEndian.Uint64(indicesBytes[wrappers.LongLen:])
+		if h.oldestIndex > h.nextIndex {
+			return nil, errInvalidHistoryIndices
+		}
+	case database.ErrNotFound:
+	default:
+		return nil, err
+	}
+
+	if h.nextIndex > h.oldestIndex {
+		_, latest, err := h.getChange(h.nextIndex - 1)
+		if err != nil {
+			return nil, err
+		}
+		if latest.rootID == rootID {
+			return h, nil
+		}
+
+		// The database was modified without recording the changes. For
+		// example, history may not have been persisted during a previous
+		// run.
+		if err := h.clear(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add the current root to the history (has no changes) so that it can be
+	// used as the start root of change proofs.
+	batch := db.NewBatch()
+	oldestIndex, err := h.record(batch, &changeSummary{
+		rootID: rootID,
+		nodes:  map[path]*change[*node]{},
+		values: map[path]*change[Maybe[[]byte]]{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	h.recorded(oldestIndex)
+	return h, nil
+}
+
+// Writes [changes] to [batch] along with the removal of any changes that no
+// longer satisfy the retention limits. Returns the index of the oldest change
+// that will be stored once [batch] is written.
+// [recorded] must be called with the returned index after [batch] is written.
+func (h *persistedHistory) record(batch database.KeyValueWriterDeleter, changes *changeSummary) (uint64, error) {
+	var (
+		now         = h.clock.Time()
+		oldestIndex = h.oldestIndex
+	)
+	for ; oldestIndex < h.nextIndex; oldestIndex++ {
+		numChanges := h.nextIndex - oldestIndex + 1
+		if h.maxLength == 0 || numChanges <= uint64(h.maxLength) {
+			if h.maxAge == 0 {
+				break
+			}
+			timestamp, _, err := h.getChange(oldestIndex)
+			if err != nil {
+				return 0, err
+			}
+			if now.Sub(timestamp) <= h.maxAge {
+				break
+			}
+		}
+
+		if err := h.deleteChange(batch, oldestIndex); err != nil {
+			return 0, err
+		}
+	}
+
+	changesBytes, err := h.codec.encodeChangeSummary(Version, changes)
+	if err != nil {
+		return 0, err
+	}
+	changeBytes := make([]byte, timestampLen+len(changesBytes))
+	binary.BigEndian.PutUint64(changeBytes, uint64(now.UnixNano()))
+	copy(changeBytes[timestampLen:], changesBytes)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		batch.Put(historyChangeKey(h.nextIndex), changeBytes),
+		batch.Put(historyRootKey(changes.rootID), indexBytes(h.nextIndex)),
+		batch.Put(historyIndicesKey, append(indexBytes(oldestIndex), indexBytes(h.nextIndex+1)...)),
+	)
+	return oldestIndex, errs.Err
+}
+
+// Updates the in-memory indices after the batch passed to [record] has been
+// written.
+func (h *persistedHistory) recorded(oldestIndex uint64) {
+	h.oldestIndex = oldestIndex
+	h.nextIndex++
+}
+
+// Removes the change at [index] from the history.
+func (h *persistedHistory) deleteChange(batch database.KeyValueWriterDeleter, index uint64) error {
+	_, changes, err := h.getChange(index)
+	if err != nil {
+		return err
+	}
+	latestIndex, err := h.getRootIndex(changes.rootID)
+	if err != nil {
+		return err
+	}
+	if latestIndex == index {
+		// The removed change was the most recent resulting in this root ID.
+		if err := batch.Delete(historyRootKey(changes.rootID)); err != nil {
+			return err
+		}
+	}
+	return batch.Delete(historyChangeKey(index))
+}
+
+// Removes every change from the history.
+func (h *persistedHistory) clear() error {
+	batch := h.db.NewBatch()
+	it := h.db.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	h.oldestIndex = h.nextIndex
+	return nil
+}
+
+// Returns the same changes as [trieHistory.getValueChanges] using the
+// persisted history.
+func (h *persistedHistory) getValueChanges(startRoot, endRoot ids.ID, startPath, endPath path, maxLength int) (*changeSummary, error) {
+	if maxLength <= 0 {
+		return nil, fmt.Errorf("%w but was %d", ErrInvalidMaxLength, maxLength)
+	}
+
+	if startRoot == endRoot {
+		return newChangeSummary(math.Min(maxLength, defaultPreallocationSize)), nil
+	}
+
+	// [endIndex] is the index of the last change resulting in [endRoot].
+	endIndex, err := h.getRootIndex(endRoot)
+	if err == database.ErrNotFound {
+		return nil, ErrRootIDNotPresent
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	combinedChanges := newChangeSummary(math.Min(maxLength, defaultPreallocationSize))
+
+	// Go backward from [endIndex] until the latest change resulting in
+	// [startRoot] is found. Record each change after it in
+	// [combinedChanges].
+	for index := endIndex; ; index-- {
+		if index < h.oldestIndex {
+			return nil, ErrStartRootNotFound
+		}
+
+		_, changes, err := h.getChange(index)
+		if err != nil {
+			return nil, err
+		}
+		if index != endIndex && changes.rootID == startRoot {
+			break
+		}
+
+		for key, valueChange := range changes.values {
+			if (len(startPath) == 0 || key.Compare(startPath) >= 0) &&
+				(len(endPath) == 0 || key.Compare(endPath) <= 0) {
+				if existing, ok := combinedChanges.values[key]; ok {
+					existing.before = valueChange.before
+				} else {
+					combinedChanges.values[key] = &change[Maybe[[]byte]]{
+						before: valueChange.before,
+						after:  valueChange.after,
+					}
+				}
+			}
+		}
+
+		if index == 0 {
+			return nil, ErrStartRootNotFound
+		}
+	}
+
+	// Keep only the smallest [maxLength] items in [combinedChanges.values].
+	if len(combinedChanges.values) > maxLength {
+		sortedKeys := maps.Keys(combinedChanges.values)
+		slices.SortFunc(sortedKeys, func(i, j path) bool {
+			return i.Compare(j) < 0
+		})
+		for _, key := range sortedKeys[maxLength:] {
+			delete(combinedChanges.values, key)
+		}
+	}
+	return combinedChanges, nil
+}
+
+// Returns the same changes as [trieHistory.getChangesToGetToRoot] using the
+// persisted history.
+func (h *persistedHistory) getChangesToGetToRoot(rootID ids.ID, startPath, endPath path, metrics merkleMetrics) (*changeSummary, error) {
+	// [rootIndex] is the index of the last change resulting in [rootID].
+	rootIndex, err := h.getRootIndex(rootID)
+	if err == database.ErrNotFound {
+		return nil, ErrRootIDNotPresent
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	combinedChanges := newChangeSummary(defaultPreallocationSize)
+
+	// Go backward from the most recent change in the history up to but
+	// not including the last change resulting in [rootID].
+	// Record each change in [combinedChanges].
+	for index := h.nextIndex - 1; index > rootIndex; index-- {
+		_, changes, err := h.getChange(index)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, changedNode := range changes.nodes {
+			if changedNode.before != nil {
+				// The decoded nodes don't have their IDs or value digests
+				// calculated.
+				changedNode.before.setValueDigest(h.hasher)
+				if err := changedNode.before.calculateID(h.codec, h.hasher, metrics); err != nil {
+					return nil, err
+				}
+			}
+			combinedChanges.nodes[key] = &change[*node]{
+				after: changedNode.before,
+			}
+		}
+
+		for key, valueChange := range changes.values {
+			if (len(startPath) == 0 || key.Compare(startPath) >= 0) &&
+				(len(endPath) == 0 || key.Compare(endPath) <= 0) {
+				if existing, ok := combinedChanges.values[key]; ok {
+					existing.after = valueChange.before
+				} else {
+					combinedChanges.values[key] = &change[Maybe[[]byte]]{
+						before: valueChange.after,
+						after:  valueChange.before,
+					}
+				}
+			}
+		}
+	}
+	return combinedChanges, nil
+}
+
+// Returns the time at which the change at [index] was recorded and the
+// change itself.
+func (h *persistedHistory) getChange(index uint64) (time.Time, *changeSummary, error) {
+	changeBytes, err := h.db.Get(historyChangeKey(index))
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if len(changeBytes) < timestampLen {
+		return time.Time{}, nil, io.ErrUnexpectedEOF
+	}
+
+	timestamp := time.Unix(0, int64(binary.BigEndian.Uint64(changeBytes)))
+	changes := &changeSummary{}
+	if _, err := h.codec.decodeChangeSummary(changeBytes[timestampLen:], changes); err != nil {
+		return time.Time{}, nil, err
+	}
+	return timestamp, changes, nil
+}
+
+// Returns the index of the most recent change resulting in [rootID].
+// Returns database.ErrNotFound if no change in the history results in
+// [rootID].
+func (h *persistedHistory) getRootIndex(rootID ids.ID) (uint64, error) {
+	indexBytes, err := h.db.Get(historyRootKey(rootID))
+	if err != nil {
+		return 0, err
+	}
+	if len(indexBytes) != wrappers.LongLen {
+		return 0, errInvalidHistoryIndices
+	}
+	return binary.BigEndian.Uint64(indexBytes), nil
+}
+
+func historyChangeKey(index uint64) []byte {
+	return append(slices.Clone(historyChangePrefix), indexBytes(index)...)
+}
+
+func historyRootKey(rootID ids.ID) []byte {
+	return append(slices.Clone(historyRootPrefix), rootID[:]...)
+}
+
+func indexBytes(index uint64) []byte {
+	b := make([]byte, wrappers.LongLen)
+	binary.BigEndian.PutUint64(b, index)
+	return b
+}
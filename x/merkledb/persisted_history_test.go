@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+func newPersistedHistoryConfig(persist bool, maxLength int, maxAge time.Duration) Config {
+	return Config{
+		Tracer: newNoopTracer(),
+		// Only the current root is kept in memory so that historical proofs
+		// must be served from disk.
+		HistoryLength:          1,
+		PersistHistory:         persist,
+		PersistedHistoryLength: maxLength,
+		PersistedHistoryMaxAge: maxAge,
+		NodeCacheSize:          minCacheSize,
+	}
+}
+
+// Writes commits [first, first+numCommits) to [db] and returns the root after
+// each one.
+func writeCommits(t *testing.T, db *Database, first int, numCommits int) []ids.ID {
+	require := require.New(t)
+
+	roots := make([]ids.ID, 0, numCommits)
+	for i := first; i < first+numCommits; i++ {
+		batch := db.NewBatch()
+		for j := 0; j < 5; j++ {
+			key := []byte(strconv.Itoa(i*5 + j))
+			require.NoError(batch.Put(key, []byte(strconv.Itoa(i))))
+		}
+		// Overwrite and delete keys from previous commits
+		require.NoError(batch.Put([]byte(strconv.Itoa(i)), []byte("overwritten")))
+		require.NoError(batch.Delete([]byte(strconv.Itoa(i * 2))))
+		require.NoError(batch.Write())
+
+		root, err := db.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+func TestPersistedHistoryRestart(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := New(context.Background(), baseDB, newPersistedHistoryConfig(true, 0, 0))
+	require.NoError(err)
+
+	// [expectedDB] keeps the full history in memory.
+	expectedDB, err := New(context.Background(), memdb.New(), Config{
+		Tracer:        newNoopTracer(),
+		HistoryLength: 100,
+		NodeCacheSize: minCacheSize,
+	})
+	require.NoError(err)
+
+	roots := writeCommits(t, db, 0, 10)
+	expectedRoots := writeCommits(t, expectedDB, 0, 10)
+	require.Equal(expectedRoots, roots)
+
+	require.NoError(db.Close())
+	db, err = New(context.Background(), baseDB, newPersistedHistoryConfig(true, 0, 0))
+	require.NoError(err)
+
+	// Commits after the restart should be served along with the ones before
+	// the restart.
+	roots = append(roots, writeCommits(t, db, 10, 2)...)
+	expectedRoots = append(expectedRoots, writeCommits(t, expectedDB, 10, 2)...)
+	require.Equal(expectedRoots, roots)
+
+	for _, root := range roots {
+		proof, err := db.GetRangeProofAtRoot(context.Background(), root, nil, nil, 100)
+		require.NoError(err)
+		require.NoError(proof.Verify(context.Background(), nil, nil, root, BranchFactor16, DefaultHasher))
+
+		expectedProof, err := expectedDB.GetRangeProofAtRoot(context.Background(), root, nil, nil, 100)
+		require.NoError(err)
+		require.Equal(expectedProof, proof)
+	}
+
+	for i, startRoot := range roots {
+		for _, endRoot := range roots[i+1:] {
+			proof, err := db.GetChangeProof(context.Background(), startRoot, endRoot, nil, nil, 5)
+			require.NoError(err)
+			require.True(proof.HadRootsInHistory)
+
+			expectedProof, err := expectedDB.GetChangeProof(context.Background(), startRoot, endRoot, nil, nil, 5)
+			require.NoError(err)
+			require.Equal(expectedProof, proof)
+		}
+	}
+
+	// Without persisted history, the old roots are lost on restart.
+	require.NoError(db.Close())
+	db, err = New(context.Background(), baseDB, newPersistedHistoryConfig(false, 0, 0))
+	require.NoError(err)
+
+	_, err = db.GetRangeProofAtRoot(context.Background(), roots[0], nil, nil, 100)
+	require.ErrorIs(err, ErrRootIDNotPresent)
+}
+
+func TestPersistedHistoryMaxLength(t *testing.T) {
+	require := require.New(t)
+
+	db, err := New(context.Background(), memdb.New(), newPersistedHistoryConfig(true, 3, 0))
+	require.NoError(err)
+
+	initialRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	roots := writeCommits(t, db, 0, 5)
+
+	// Only the last 3 changes are kept.
+	for _, root := range append([]ids.ID{initialRoot}, roots[:2]...) {
+		_, err := db.GetRangeProofAtRoot(context.Background(), root, nil, nil, 100)
+		require.ErrorIs(err, ErrRootIDNotPresent)
+	}
+	for _, root := range roots[2:] {
+		proof, err := db.GetRangeProofAtRoot(context.Background(), root, nil, nil, 100)
+		require.NoError(err)
+		require.NoError(proof.Verify(context.Background(), nil, nil, root, BranchFactor16, DefaultHasher))
+	}
+
+	// The change resulting in [roots[1]] was removed, so there are no changes
+	// to serve starting from it.
+	_, err = db.GetChangeProof(context.Background(), roots[1], roots[4], nil, nil, 100)
+	require.ErrorIs(err, ErrStartRootNotFound)
+
+	proof, err := db.GetChangeProof(context.Background(), roots[2], roots[4], nil, nil, 100)
+	require.NoError(err)
+	require.True(proof.HadRootsInHistory)
+
+	it := db.persistedHistory.db.NewIteratorWithPrefix(historyChangePrefix)
+	defer it.Release()
+
+	numChanges := 0
+	for it.Next() {
+		numChanges++
+	}
+	require.NoError(it.Error())
+	require.Equal(3, numChanges)
+}
+
+func TestPersistedHistoryMaxAge(t *testing.T) {
+	require := require.New(t)
+
+	db, err := New(context.Background(), memdb.New(), newPersistedHistoryConfig(true, 0, time.Hour))
+	require.NoError(err)
+
+	now := time.Now()
+	db.persistedHistory.clock.Set(now)
+	oldRoots := writeCommits(t, db, 0, 2)
+
+	db.persistedHistory.clock.Set(now.Add(time.Hour + time.Second))
+	newRoots := writeCommits(t, db, 2, 2)
+
+	for _, root := range oldRoots {
+		_, err := db.GetRangeProofAtRoot(context.Background(), root, nil, nil, 100)
+		require.ErrorIs(err, ErrRootIDNotPresent)
+	}
+	proof, err := db.GetRangeProofAtRoot(context.Background(), newRoots[0], nil, nil, 100)
+	require.NoError(err)
+	require.NoError(proof.Verify(context.Background(), nil, nil, newRoots[0], BranchFactor16, DefaultHasher))
+}
+
+func TestPersistedHistoryDiscardedAfterUnrecordedChanges(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	db, err := New(context.Background(), baseDB, newPersistedHistoryConfig(true, 0, 0))
+	require.NoError(err)
+	roots := writeCommits(t, db, 0, 2)
+	require.NoError(db.Close())
+
+	// Commit without recording the changes.
+	db, err = New(context.Background(), baseDB, newPersistedHistoryConfig(false, 0, 0))
+	require.NoError(err)
+	require.NoError(db.Put([]byte("unrecorded"), []byte("value")))
+	currentRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.NoError(db.Close())
+
+	db, err = New(context.Background(), baseDB, newPersistedHistoryConfig(true, 0, 0))
+	require.NoError(err)
+
+	// The previous history can't be used to reach the current root.
+	_, err = db.GetRangeProofAtRoot(context.Background(), roots[0], nil, nil, 100)
+	require.ErrorIs(err, ErrRootIDNotPresent)
+
+	// The current root is used as the start of the new history.
+	newRoots := writeCommits(t, db, 2, 1)
+	_, err = db.persistedHistory.getRootIndex(currentRoot)
+	require.NoError(err)
+	proof, err := db.GetChangeProof(context.Background(), currentRoot, newRoots[0], nil, nil, 100)
+	require.NoError(err)
+	require.True(proof.HadRootsInHistory)
+
+	_, err = db.persistedHistory.getRootIndex(roots[1])
+	require.ErrorIs(err, database.ErrNotFound)
+}
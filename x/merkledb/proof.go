@@ -9,11 +9,12 @@ import (
 	"errors"
 	"fmt"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/trace"
-	"github.com/VidarSolutions/avalanchego/utils/hashing"
 )
 
 const verificationCacheSize = 2_000
@@ -34,6 +35,11 @@ var (
 	ErrProofNodeNotForKey          = errors.New("the provided node has a key that is not a prefix of the specified key")
 	ErrProofValueDoesntMatch       = errors.New("the provided value does not match the proof node for the provided key's value")
 	ErrProofNodeHasUnincludedValue = errors.New("the provided proof has a value for a key within the range that is not present in the provided key/values")
+	ErrUnsortedProofNodes          = errors.New("proof nodes are not sorted in increasing order of their keys")
+	ErrNoRootProofNode             = errors.New("the first proof node must be the root")
+	ErrMissingProofNode            = errors.New("the proof is missing a node on the path to a key")
+	ErrKeysValuesLengthMismatch    = errors.New("the number of keys and values differ")
+	ErrInvalidChildIndex           = errors.New("the provided proof node has a child index that isn't less than the branch factor")
 )
 
 type ProofNode struct {
@@ -62,12 +68,22 @@ type Proof struct {
 // Returns nil if the trie given in [proof] has root [expectedRootID].
 // That is, this is a valid proof that [proof.Key] exists/doesn't exist
 // in the trie with root [expectedRootID].
-func (proof *Proof) Verify(ctx context.Context, expectedRootID ids.ID) error {
+// [branchFactor] and [hasher] must be those of the trie.
+func (proof *Proof) Verify(
+	ctx context.Context,
+	expectedRootID ids.ID,
+	branchFactor BranchFactor,
+	hasher Hasher,
+) error {
+	if err := branchFactor.Valid(); err != nil {
+		return err
+	}
+
 	// Make sure the proof is well-formed.
 	if len(proof.Path) == 0 {
 		return ErrNoProof
 	}
-	if err := verifyProofPath(proof.Path, newPath(proof.Key)); err != nil {
+	if err := verifyProofPath(proof.Path, newPath(proof.Key, branchFactor), branchFactor); err != nil {
 		return err
 	}
 
@@ -76,24 +92,24 @@ func (proof *Proof) Verify(ctx context.Context, expectedRootID ids.ID) error {
 
 	// If the last proof node's key is [proof.Key] (i.e. this is an inclusion proof)
 	// then the value of the last proof node must match [proof.Value].
-	// Note odd length keys can never match the [proof.Key] since it's bytes,
-	// and thus an even number of nibbles.
-	if !lastNode.KeyPath.hasOddLength() &&
+	// Note keys that end in a partial byte can never match the [proof.Key]
+	// since it's bytes.
+	if !lastNode.KeyPath.hasPartialByte(branchFactor) &&
 		bytes.Equal(proof.Key, lastNode.KeyPath.Value) &&
-		!valueOrHashMatches(proof.Value, lastNode.ValueOrHash) {
+		!valueOrHashMatches(proof.Value, lastNode.ValueOrHash, hasher) {
 		return ErrProofValueDoesntMatch
 	}
 
-	// If the last proof node has an odd length or a different key than [proof.Key]
+	// If the last proof node ends in a partial byte or has a different key than [proof.Key]
 	// then this is an exclusion proof and should prove that [proof.Key] isn't in the trie..
-	// Note odd length keys can never match the [proof.Key] since it's bytes,
-	// and thus an even number of nibbles.
-	if (lastNode.KeyPath.hasOddLength() || !bytes.Equal(proof.Key, lastNode.KeyPath.Value)) &&
+	// Note keys that end in a partial byte can never match the [proof.Key]
+	// since it's bytes.
+	if (lastNode.KeyPath.hasPartialByte(branchFactor) || !bytes.Equal(proof.Key, lastNode.KeyPath.Value)) &&
 		!proof.Value.IsNothing() {
 		return ErrProofValueDoesntMatch
 	}
 
-	view, err := getEmptyTrieView(ctx)
+	view, err := getEmptyTrieView(ctx, branchFactor, hasher)
 	if err != nil {
 		return err
 	}
@@ -101,7 +117,7 @@ func (proof *Proof) Verify(ctx context.Context, expectedRootID ids.ID) error {
 	// Insert all of the proof nodes.
 	// [provenPath] is the path that we are proving exists, or the path
 	// that is where the path we are proving doesn't exist should be.
-	provenPath := proof.Path[len(proof.Path)-1].KeyPath.deserialize()
+	provenPath := proof.Path[len(proof.Path)-1].KeyPath.deserialize(branchFactor)
 
 	// Don't bother locking [db] and [view] -- nobody else has a reference to them.
 	if err = addPathInfo(view, proof.Path, provenPath, provenPath); err != nil {
@@ -118,6 +134,173 @@ func (proof *Proof) Verify(ctx context.Context, expectedRootID ids.ID) error {
 	return nil
 }
 
+// An inclusion/exclusion proof of a set of keys.
+type MultiProof struct {
+	// The union of the nodes in the proof paths of each key in [Keys].
+	// Sorted by increasing key path. Each node appears once.
+	// Must always be non-empty (i.e. have the root node).
+	Nodes []ProofNode
+	// The keys this is a proof of the existence/non-existence of.
+	// Sorted by increasing key.
+	Keys [][]byte
+	// Values[i] is Nothing if Keys[i] isn't in the trie.
+	// Otherwise it's the value corresponding to Keys[i].
+	Values []Maybe[[]byte]
+}
+
+// Returns nil if the trie given in [proof] has root [expectedRootID].
+// That is, this is a valid proof that each key in [proof.Keys] exists/doesn't
+// exist in the trie with root [expectedRootID].
+// [branchFactor] and [hasher] must be those of the trie.
+func (proof *MultiProof) Verify(
+	ctx context.Context,
+	expectedRootID ids.ID,
+	branchFactor BranchFactor,
+	hasher Hasher,
+) error {
+	if err := branchFactor.Valid(); err != nil {
+		return err
+	}
+
+	// Make sure the proof is well-formed.
+	switch {
+	case len(proof.Nodes) == 0:
+		return ErrNoProof
+	case proof.Nodes[0].KeyPath.TokenLength != 0:
+		return ErrNoRootProofNode
+	case len(proof.Keys) != len(proof.Values):
+		return ErrKeysValuesLengthMismatch
+	}
+	for i := 1; i < len(proof.Keys); i++ {
+		if bytes.Compare(proof.Keys[i-1], proof.Keys[i]) >= 0 {
+			return ErrNonIncreasingValues
+		}
+	}
+
+	nodePaths := make([]path, len(proof.Nodes))
+	for i, proofNode := range proof.Nodes {
+		nodePaths[i] = proofNode.KeyPath.deserialize(branchFactor)
+		if i > 0 && nodePaths[i-1].Compare(nodePaths[i]) >= 0 {
+			return ErrUnsortedProofNodes
+		}
+		if err := verifyProofNode(proofNode, branchFactor); err != nil {
+			return err
+		}
+	}
+
+	// Walk from the root towards each key to make sure that the proof
+	// contains every node needed to prove the key's value and that the value
+	// matches the proof.
+	usedNodes := make([]bool, len(proof.Nodes))
+	for i, key := range proof.Keys {
+		if err := verifyMultiProofKey(proof.Nodes, nodePaths, usedNodes, newPath(key, branchFactor), proof.Values[i], hasher); err != nil {
+			return err
+		}
+	}
+	for _, used := range usedNodes {
+		if !used {
+			return ErrExtraProofNodes
+		}
+	}
+
+	view, err := getEmptyTrieView(ctx, branchFactor, hasher)
+	if err != nil {
+		return err
+	}
+
+	// Insert the proof nodes in decreasing order so that the descendants of
+	// each node are inserted before it. Any children of a node that weren't
+	// inserted are added using the IDs given in the proof.
+	// Don't bother locking [view] -- nobody else has a reference to it.
+	for i := len(proof.Nodes) - 1; i >= 0; i-- {
+		proofNode := proof.Nodes[i]
+
+		// load the node associated with the key or create a new one
+		// pass nothing because we are going to overwrite the value digest below
+		n, err := view.insertIntoTrie(nodePaths[i], Nothing[[]byte]())
+		if err != nil {
+			return err
+		}
+		// We overwrite the valueDigest to be the hash provided in the proof
+		// node because we may not know the pre-image of the valueDigest.
+		n.valueDigest = proofNode.ValueOrHash
+
+		for index, childID := range proofNode.Children {
+			if _, ok := n.children[index]; !ok {
+				n.addChildWithoutNode(index, EmptyPath, childID)
+			}
+		}
+	}
+
+	gotRootID, err := view.GetMerkleRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if expectedRootID != gotRootID {
+		return fmt.Errorf("%w:[%s], expected:[%s]", ErrInvalidProof, gotRootID, expectedRootID)
+	}
+	return nil
+}
+
+// Verifies that [nodes] contains the proof path of [keyPath] and that the
+// proof path shows that [keyPath] has [value].
+// Marks each node in the proof path of [keyPath] as used in [usedNodes].
+// Assumes [nodePaths] are the sorted paths of [nodes] and that the first node
+// is the root.
+func verifyMultiProofKey(
+	nodes []ProofNode,
+	nodePaths []path,
+	usedNodes []bool,
+	keyPath path,
+	value Maybe[[]byte],
+	hasher Hasher,
+) error {
+	nodeIndex := 0
+	for {
+		usedNodes[nodeIndex] = true
+		var (
+			proofNode = nodes[nodeIndex]
+			nodePath  = nodePaths[nodeIndex]
+		)
+
+		if !keyPath.HasPrefix(nodePath) {
+			// The node diverges from [keyPath], so [keyPath] isn't in the
+			// trie.
+			break
+		}
+		if len(nodePath) == len(keyPath) {
+			// This is the node with [keyPath] so it must have [value].
+			if !valueOrHashMatches(value, proofNode.ValueOrHash, hasher) {
+				return ErrProofValueDoesntMatch
+			}
+			return nil
+		}
+
+		childIndex := keyPath[len(nodePath)]
+		if _, ok := proofNode.Children[childIndex]; !ok {
+			// There is no child where [keyPath] would be, so [keyPath]
+			// isn't in the trie.
+			break
+		}
+
+		// The child is the node with the smallest path that has
+		// [childPath] as a prefix.
+		childPath := nodePath.Append(childIndex)
+		childIndexInProof, _ := slices.BinarySearchFunc(nodePaths, childPath, func(p, target path) int {
+			return p.Compare(target)
+		})
+		if childIndexInProof == len(nodePaths) || !nodePaths[childIndexInProof].HasPrefix(childPath) {
+			return ErrMissingProofNode
+		}
+		nodeIndex = childIndexInProof
+	}
+
+	if !value.IsNothing() {
+		return ErrProofValueDoesntMatch
+	}
+	return nil
+}
+
 type KeyValue struct {
 	Key   []byte
 	Value []byte
@@ -157,12 +340,20 @@ type RangeProof struct {
 //     [end] is non-empty and [proof.EndProof] is a valid proof of a key <= [end].
 //   - [expectedRootID] is the root of the trie containing the given key-value
 //     pairs and start/end proofs.
+//
+// [branchFactor] and [hasher] must be those of the trie.
 func (proof *RangeProof) Verify(
 	ctx context.Context,
 	start []byte,
 	end []byte,
 	expectedRootID ids.ID,
+	branchFactor BranchFactor,
+	hasher Hasher,
 ) error {
+	if err := branchFactor.Valid(); err != nil {
+		return err
+	}
+
 	switch {
 	case len(end) > 0 && bytes.Compare(start, end) > 0:
 		return ErrStartAfterEnd
@@ -190,39 +381,39 @@ func (proof *RangeProof) Verify(
 	// The key-value pairs (allegedly) proven by [proof].
 	keyValues := make(map[path][]byte, len(proof.KeyValues))
 	for _, keyValue := range proof.KeyValues {
-		keyValues[newPath(keyValue.Key)] = keyValue.Value
+		keyValues[newPath(keyValue.Key, branchFactor)] = keyValue.Value
 	}
 
-	smallestPath := newPath(start)
-	largestPath := newPath(largestkey)
+	smallestPath := newPath(start, branchFactor)
+	largestPath := newPath(largestkey, branchFactor)
 
 	// Ensure that the start proof is valid and contains values that
 	// match the key/values that were sent.
-	if err := verifyProofPath(proof.StartProof, smallestPath); err != nil {
+	if err := verifyProofPath(proof.StartProof, smallestPath, branchFactor); err != nil {
 		return err
 	}
-	if err := verifyAllRangeProofKeyValuesPresent(proof.StartProof, smallestPath, largestPath, keyValues); err != nil {
+	if err := verifyAllRangeProofKeyValuesPresent(proof.StartProof, smallestPath, largestPath, keyValues, branchFactor, hasher); err != nil {
 		return err
 	}
 
 	// Ensure that the end proof is valid and contains values that
 	// match the key/values that were sent.
-	if err := verifyProofPath(proof.EndProof, largestPath); err != nil {
+	if err := verifyProofPath(proof.EndProof, largestPath, branchFactor); err != nil {
 		return err
 	}
-	if err := verifyAllRangeProofKeyValuesPresent(proof.EndProof, smallestPath, largestPath, keyValues); err != nil {
+	if err := verifyAllRangeProofKeyValuesPresent(proof.EndProof, smallestPath, largestPath, keyValues, branchFactor, hasher); err != nil {
 		return err
 	}
 
 	// Don't need to lock [view] because nobody else has a reference to it.
-	view, err := getEmptyTrieView(ctx)
+	view, err := getEmptyTrieView(ctx, branchFactor, hasher)
 	if err != nil {
 		return err
 	}
 
 	// Insert all key-value pairs into the trie.
 	for _, kv := range proof.KeyValues {
-		if _, err := view.insertIntoTrie(newPath(kv.Key), Some(kv.Value)); err != nil {
+		if _, err := view.insertIntoTrie(newPath(kv.Key, branchFactor), Some(kv.Value)); err != nil {
 			return err
 		}
 	}
@@ -251,22 +442,29 @@ func (proof *RangeProof) Verify(
 
 // Verify that all non-intermediate nodes in [proof] which have keys
 // in [[start], [end]] have the value given for that key in [keysValues].
-func verifyAllRangeProofKeyValuesPresent(proof []ProofNode, start, end path, keysValues map[path][]byte) error {
+func verifyAllRangeProofKeyValuesPresent(
+	proof []ProofNode,
+	start path,
+	end path,
+	keysValues map[path][]byte,
+	branchFactor BranchFactor,
+	hasher Hasher,
+) error {
 	for i := 0; i < len(proof); i++ {
 		var (
 			node     = proof[i]
 			nodeKey  = node.KeyPath
-			nodePath = nodeKey.deserialize()
+			nodePath = nodeKey.deserialize(branchFactor)
 		)
 
-		// Skip odd length keys since they cannot have a value (enforced by [verifyProofPath]).
-		if !nodeKey.hasOddLength() && nodePath.Compare(start) >= 0 && nodePath.Compare(end) <= 0 {
+		// Skip keys that end in a partial byte since they cannot have a value (enforced by [verifyProofPath]).
+		if !nodeKey.hasPartialByte(branchFactor) && nodePath.Compare(start) >= 0 && nodePath.Compare(end) <= 0 {
 			value, ok := keysValues[nodePath]
 			if !ok && !node.ValueOrHash.IsNothing() {
 				// We didn't get a key-value pair for this key, but the proof node has a value.
 				return ErrProofNodeHasUnincludedValue
 			}
-			if ok && !valueOrHashMatches(Some(value), node.ValueOrHash) {
+			if ok && !valueOrHashMatches(Some(value), node.ValueOrHash, hasher) {
 				// We got a key-value pair for this key, but the value in the proof
 				// node doesn't match the value we got for this key.
 				return ErrProofValueDoesntMatch
@@ -365,30 +563,30 @@ func (proof *ChangeProof) Verify(
 		return err
 	}
 
-	smallestPath := newPath(start)
+	smallestPath := newPath(start, db.branchFactor)
 
 	// Make sure the start proof, if given, is well-formed.
-	if err := verifyProofPath(proof.StartProof, smallestPath); err != nil {
+	if err := verifyProofPath(proof.StartProof, smallestPath, db.branchFactor); err != nil {
 		return err
 	}
 
 	// Find the greatest key in [proof.KeyValues] and [proof.DeletedKeys].
 	// Note that [proof.EndProof] is a proof for this key.
 	// [largestPath] is also used when we add children of proof nodes to [trie] below.
-	largestPath := newPath(proof.getLargestKey(end))
+	largestPath := newPath(proof.getLargestKey(end), db.branchFactor)
 
 	// Make sure the end proof, if given, is well-formed.
-	if err := verifyProofPath(proof.EndProof, largestPath); err != nil {
+	if err := verifyProofPath(proof.EndProof, largestPath, db.branchFactor); err != nil {
 		return err
 	}
 
 	// gather all key/values in the proof
 	keyValues := make(map[path]Maybe[[]byte], len(proof.KeyValues)+len(proof.DeletedKeys))
 	for _, keyValue := range proof.KeyValues {
-		keyValues[newPath(keyValue.Key)] = Some(keyValue.Value)
+		keyValues[newPath(keyValue.Key, db.branchFactor)] = Some(keyValue.Value)
 	}
 	for _, key := range proof.DeletedKeys {
-		keyValues[newPath(key)] = Nothing[[]byte]()
+		keyValues[newPath(key, db.branchFactor)] = Nothing[[]byte]()
 	}
 
 	// want to prevent commit writes to DB, but not prevent db reads
@@ -425,18 +623,25 @@ func (proof *ChangeProof) Verify(
 
 	// Insert the key-value pairs into the trie.
 	for _, kv := range proof.KeyValues {
-		if _, err := view.insertIntoTrie(newPath(kv.Key), Some(kv.Value)); err != nil {
+		if _, err := view.insertIntoTrie(newPath(kv.Key, db.branchFactor), Some(kv.Value)); err != nil {
 			return err
 		}
 	}
 
 	// Remove the deleted keys from the trie.
 	for _, key := range proof.DeletedKeys {
-		if err := view.removeFromTrie(newPath(key)); err != nil {
+		if err := view.removeFromTrie(newPath(key, db.branchFactor)); err != nil {
 			return err
 		}
 	}
 
+	// The keys < [start] and > [largestKey] in [db] may differ from those at
+	// [expectedEndRootID], so remove them before they're replaced by the
+	// children of the nodes along the edges of the proof.
+	if err := removeKeysOutsideRange(view, smallestPath, largestPath); err != nil {
+		return err
+	}
+
 	// For all the nodes along the edges of the proof, insert children < [start] and > [largestKey]
 	// into the trie so that we get the expected root ID (if this proof is valid).
 	if err := addPathInfo(view, proof.StartProof, smallestPath, largestPath); err != nil {
@@ -459,7 +664,7 @@ func (proof *ChangeProof) Verify(
 }
 
 // Verifies that all values present in the [proof]:
-// - Are nothing when deleted, not in the db, or the node has an odd path length.
+// - Are nothing when deleted, not in the db, or the node's path ends in a partial byte.
 // - if the node's path is within the key range, that has a value that matches the value passed in the change list or in the db
 func verifyAllChangeProofKeyValuesPresent(
 	ctx context.Context,
@@ -473,12 +678,12 @@ func verifyAllChangeProofKeyValuesPresent(
 		var (
 			node     = proof[i]
 			nodeKey  = node.KeyPath
-			nodePath = nodeKey.deserialize()
+			nodePath = nodeKey.deserialize(db.branchFactor)
 		)
 
 		// Check the value of any node with a key that is within the range.
-		// Skip odd length keys since they cannot have a value (enforced by [verifyProofPath]).
-		if !nodeKey.hasOddLength() && nodePath.Compare(start) >= 0 && nodePath.Compare(end) <= 0 {
+		// Skip keys that end in a partial byte since they cannot have a value (enforced by [verifyProofPath]).
+		if !nodeKey.hasPartialByte(db.branchFactor) && nodePath.Compare(start) >= 0 && nodePath.Compare(end) <= 0 {
 			value, ok := keysValues[nodePath]
 			if !ok {
 				// This value isn't in the list of key-value pairs we got.
@@ -494,7 +699,7 @@ func verifyAllChangeProofKeyValuesPresent(
 					value = Some(dbValue)
 				}
 			}
-			if !valueOrHashMatches(value, node.ValueOrHash) {
+			if !valueOrHashMatches(value, node.ValueOrHash, db.hasher) {
 				return ErrProofValueDoesntMatch
 			}
 		}
@@ -545,50 +750,64 @@ func verifyKeyValues(kvs []KeyValue, start, end []byte) error {
 }
 
 // Returns nil iff all the following hold:
-//   - Any node with an odd nibble length, should not have a value associated with it
-//     since all keys with values are written in bytes, so have even nibble length.
+//   - Each node in [proof] is well-formed. See [verifyProofNode].
 //   - Each key in [proof] is a strict prefix of the following key.
 //   - Each key in [proof] is a strict prefix of [keyBytes], except possibly the last.
 //   - If the last element in [proof] is [keyBytes], this is an inclusion proof.
 //     Otherwise, this is an exclusion proof and [keyBytes] must not be in [proof].
-func verifyProofPath(proof []ProofNode, keyPath path) error {
-	provenKey := keyPath.Serialize()
+func verifyProofPath(proof []ProofNode, keyPath path, branchFactor BranchFactor) error {
+	provenKey := keyPath.Serialize(branchFactor)
 
 	// loop over all but the last node since it will not have the prefix in exclusion proofs
 	for i := 0; i < len(proof)-1; i++ {
 		nodeKey := proof[i].KeyPath
 
-		// intermediate nodes (nodes with odd nibble length) should never have a value associated with them
-		if nodeKey.hasOddLength() && !proof[i].ValueOrHash.IsNothing() {
-			return ErrOddLengthWithValue
+		if err := verifyProofNode(proof[i], branchFactor); err != nil {
+			return err
 		}
 
 		// each node should have a key that has the proven key as a prefix
-		if !provenKey.HasStrictPrefix(nodeKey) {
+		if !provenKey.HasStrictPrefix(nodeKey, branchFactor) {
 			return ErrProofNodeNotForKey
 		}
 
 		// each node should have a key that is a prefix of the next node's key
 		nextKey := proof[i+1].KeyPath
-		if !nextKey.HasStrictPrefix(nodeKey) {
+		if !nextKey.HasStrictPrefix(nodeKey, branchFactor) {
 			return ErrNonIncreasingProofNodes
 		}
 	}
 
-	// check the last node for a value since the above loop doesn't check the last node
+	// check the last node since the above loop doesn't check the last node
 	if len(proof) > 0 {
-		lastNode := proof[len(proof)-1]
-		if lastNode.KeyPath.hasOddLength() && !lastNode.ValueOrHash.IsNothing() {
-			return ErrOddLengthWithValue
+		if err := verifyProofNode(proof[len(proof)-1], branchFactor); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// Returns nil iff both hold:
+//   - If [node]'s key ends in a partial byte, it doesn't have a value
+//     since all keys with values are written in bytes.
+//   - Each of [node]'s child indices is less than [branchFactor].
+func verifyProofNode(node ProofNode, branchFactor BranchFactor) error {
+	if node.KeyPath.hasPartialByte(branchFactor) && !node.ValueOrHash.IsNothing() {
+		return ErrPartialByteLengthWithValue
+	}
+	for index := range node.Children {
+		if int(index) >= int(branchFactor) {
+			return ErrInvalidChildIndex
+		}
+	}
+	return nil
+}
+
 // Returns true if [value] and [valueDigest] match.
 // [valueOrHash] should be the [ValueOrHash] field of a [ProofNode].
-func valueOrHashMatches(value Maybe[[]byte], valueOrHash Maybe[[]byte]) bool {
+// Values whose length is at least [HashLength] are hashed with [hasher].
+func valueOrHashMatches(value Maybe[[]byte], valueOrHash Maybe[[]byte], hasher Hasher) bool {
 	var (
 		valueIsNothing  = value.IsNothing()
 		digestIsNothing = valueOrHash.IsNothing()
@@ -604,8 +823,8 @@ func valueOrHashMatches(value Maybe[[]byte], valueOrHash Maybe[[]byte]) bool {
 	case len(value.value) < HashLength:
 		return bytes.Equal(value.value, valueOrHash.value)
 	default:
-		valueHash := hashing.ComputeHash256(value.value)
-		return bytes.Equal(valueHash, valueOrHash.value)
+		valueHash := hasher.Hash(value.value)
+		return bytes.Equal(valueHash[:], valueOrHash.value)
 	}
 }
 
@@ -627,11 +846,10 @@ func addPathInfo(
 
 	for i := len(proofPath) - 1; i >= 0; i-- {
 		proofNode := proofPath[i]
-		keyPath := proofNode.KeyPath.deserialize()
+		keyPath := proofNode.KeyPath.deserialize(t.db.branchFactor)
 
-		if len(keyPath)&1 == 1 && !proofNode.ValueOrHash.IsNothing() {
-			// a value cannot have an odd number of nibbles in its key
-			return ErrOddLengthWithValue
+		if err := verifyProofNode(proofNode, t.db.branchFactor); err != nil {
+			return err
 		}
 
 		// load the node associated with the key or create a new one
@@ -651,8 +869,8 @@ func addPathInfo(
 		}
 
 		// Add [proofNode]'s children which are outside the range [start, end].
-		compressedPath := EmptyPath
 		for index, childID := range proofNode.Children {
+			compressedPath := EmptyPath
 			if existingChild, ok := n.children[index]; ok {
 				compressedPath = existingChild.compressedPath
 			}
@@ -667,7 +885,82 @@ func addPathInfo(
 	return nil
 }
 
-func getEmptyTrieView(ctx context.Context) (*trieView, error) {
+// Removes the keys < [startPath] and > [endPath] from [t].
+// Such keys are in the subtrees that branch off the paths to [startPath] and
+// [endPath], so only the nodes on those paths are visited.
+// If [startPath] is empty, no keys are < [startPath].
+// If [endPath] is empty, no keys are > [endPath].
+// Assumes [t.lock] is held.
+func removeKeysOutsideRange(t *trieView, startPath path, endPath path) error {
+	if len(startPath) > 0 {
+		if err := removeBranchesOffPath(t, startPath, true /*before*/); err != nil {
+			return err
+		}
+	}
+	if len(endPath) > 0 {
+		return removeBranchesOffPath(t, endPath, false /*before*/)
+	}
+	return nil
+}
+
+// Removes the subtrees branching off the path to [key] whose keys are all
+// < [key] if [before], or all > [key] otherwise.
+// If [before], the values of the nodes on the path are removed too, other
+// than [key]'s, since their keys are < [key].
+// The nodes on the path that are left without a value and with less than 2
+// children are removed or merged with their only child.
+// Assumes [t.lock] is held.
+func removeBranchesOffPath(t *trieView, key path, before bool) error {
+	nodePath, err := t.getPathTo(key)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodePath {
+		if err := t.recordNodeChange(n); err != nil {
+			return err
+		}
+	}
+
+	// Start from the deepest node so that each node's children are already
+	// cleaned up when the node is.
+	for i := len(nodePath) - 1; i >= 0; i-- {
+		n := nodePath[i]
+		for index, entry := range n.children {
+			childPath := n.key.Append(index) + entry.compressedPath
+			if key.HasPrefix(childPath) {
+				// the child is the next node on the path
+				continue
+			}
+			if (childPath.Compare(key) < 0) == before {
+				n.onNodeChanged()
+				delete(n.children, index)
+			}
+		}
+		if before && n.key != key && n.hasValue() {
+			n.setValue(t.db.hasher, Nothing[[]byte]())
+		}
+
+		// the root is never removed
+		if i == 0 || n.hasValue() || len(n.children) > 1 {
+			continue
+		}
+		parent := nodePath[i-1]
+		if len(n.children) == 0 {
+			if err := t.recordNodeDeleted(n); err != nil {
+				return err
+			}
+			parent.removeChild(n)
+			continue
+		}
+		if err := t.compressNodePath(parent, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns an empty view of a trie with [branchFactor] and [hasher].
+func getEmptyTrieView(ctx context.Context, branchFactor BranchFactor, hasher Hasher) (*trieView, error) {
 	tracer, err := trace.New(trace.Config{Enabled: false})
 	if err != nil {
 		return nil, err
@@ -678,6 +971,8 @@ func getEmptyTrieView(ctx context.Context) (*trieView, error) {
 		Config{
 			Tracer:        tracer,
 			NodeCacheSize: verificationCacheSize,
+			BranchFactor:  branchFactor,
+			Hasher:        hasher,
 		},
 		&mockMetrics{},
 	)
@@ -6,11 +6,14 @@ package merkledb
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/utils/hashing"
@@ -29,6 +32,26 @@ func getBasicDB() (*Database, error) {
 	)
 }
 
+func getDBWithBranchFactorAndHasher(branchFactor BranchFactor, hasher Hasher) (*Database, error) {
+	return newDatabase(
+		context.Background(),
+		memdb.New(),
+		Config{
+			Tracer:        newNoopTracer(),
+			HistoryLength: 1000,
+			NodeCacheSize: 1000,
+			BranchFactor:  branchFactor,
+			Hasher:        hasher,
+		},
+		&mockMetrics{},
+	)
+}
+
+var hashers = map[string]Hasher{
+	"sha256":    SHA256Hasher,
+	"keccak256": Keccak256Hasher,
+}
+
 func writeBasicBatch(t *testing.T, db *Database) {
 	batch := db.NewBatch()
 	require.NoError(t, batch.Put([]byte{0}, []byte{0}))
@@ -63,7 +86,7 @@ func Test_Proof_Marshal(t *testing.T) {
 
 func Test_Proof_Empty(t *testing.T) {
 	proof := &Proof{}
-	err := proof.Verify(context.Background(), ids.Empty)
+	err := proof.Verify(context.Background(), ids.Empty, BranchFactor16, DefaultHasher)
 	require.ErrorIs(t, err, ErrNoProof)
 }
 
@@ -125,7 +148,7 @@ func verifyPath(t *testing.T, path1, path2 []ProofNode) {
 	require.Equal(t, len(path1), len(path2))
 	for i := range path1 {
 		require.True(t, bytes.Equal(path1[i].KeyPath.Value, path2[i].KeyPath.Value))
-		require.Equal(t, path1[i].KeyPath.hasOddLength(), path2[i].KeyPath.hasOddLength())
+		require.Equal(t, path1[i].KeyPath.hasPartialByte(BranchFactor16), path2[i].KeyPath.hasPartialByte(BranchFactor16))
 		require.True(t, bytes.Equal(path1[i].ValueOrHash.value, path2[i].ValueOrHash.value))
 		for childIndex := range path1[i].Children {
 			require.Equal(t, path1[i].Children[childIndex], path2[i].Children[childIndex])
@@ -151,7 +174,7 @@ func Test_Proof_Verify_Bad_Data(t *testing.T) {
 			malform: func(proof *Proof) {
 				proof.Path[1].ValueOrHash = Some([]byte{1, 2})
 			},
-			expectedErr: ErrOddLengthWithValue,
+			expectedErr: ErrPartialByteLengthWithValue,
 		},
 		{
 			name: "last proof node has missing value",
@@ -197,21 +220,21 @@ func Test_Proof_Verify_Bad_Data(t *testing.T) {
 
 			tt.malform(proof)
 
-			err = proof.Verify(context.Background(), db.getMerkleRoot())
+			err = proof.Verify(context.Background(), db.getMerkleRoot(), BranchFactor16, DefaultHasher)
 			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
 
 func Test_Proof_ValueOrHashMatches(t *testing.T) {
-	require.True(t, valueOrHashMatches(Some([]byte{0}), Some([]byte{0})))
-	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some(hashing.ComputeHash256([]byte{0}))))
-	require.True(t, valueOrHashMatches(Nothing[[]byte](), Nothing[[]byte]()))
-
-	require.False(t, valueOrHashMatches(Some([]byte{0}), Nothing[[]byte]()))
-	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some([]byte{0})))
-	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some(hashing.ComputeHash256([]byte{1}))))
-	require.False(t, valueOrHashMatches(Some(hashing.ComputeHash256([]byte{0})), Nothing[[]byte]()))
+	require.True(t, valueOrHashMatches(Some([]byte{0}), Some([]byte{0}), DefaultHasher))
+	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some(hashing.ComputeHash256([]byte{0})), DefaultHasher))
+	require.True(t, valueOrHashMatches(Nothing[[]byte](), Nothing[[]byte](), DefaultHasher))
+
+	require.False(t, valueOrHashMatches(Some([]byte{0}), Nothing[[]byte](), DefaultHasher))
+	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some([]byte{0}), DefaultHasher))
+	require.False(t, valueOrHashMatches(Nothing[[]byte](), Some(hashing.ComputeHash256([]byte{1})), DefaultHasher))
+	require.False(t, valueOrHashMatches(Some(hashing.ComputeHash256([]byte{0})), Nothing[[]byte](), DefaultHasher))
 }
 
 func Test_RangeProof_Extra_Value(t *testing.T) {
@@ -232,6 +255,8 @@ func Test_RangeProof_Extra_Value(t *testing.T) {
 		[]byte{1},
 		[]byte{5, 5},
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.NoError(t, err)
 
@@ -242,6 +267,8 @@ func Test_RangeProof_Extra_Value(t *testing.T) {
 		[]byte{1},
 		[]byte{5, 5},
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.ErrorIs(t, err, ErrInvalidProof)
 }
@@ -271,7 +298,7 @@ func Test_RangeProof_Verify_Bad_Data(t *testing.T) {
 			malform: func(proof *RangeProof) {
 				proof.EndProof[1].ValueOrHash = Some([]byte{1, 2})
 			},
-			expectedErr: ErrOddLengthWithValue,
+			expectedErr: ErrPartialByteLengthWithValue,
 		},
 		{
 			name: "EndProof: last proof node has missing value",
@@ -301,7 +328,7 @@ func Test_RangeProof_Verify_Bad_Data(t *testing.T) {
 
 			tt.malform(proof)
 
-			err = proof.Verify(context.Background(), []byte{2}, []byte{3, 0}, db.getMerkleRoot())
+			err = proof.Verify(context.Background(), []byte{2}, []byte{3, 0}, db.getMerkleRoot(), BranchFactor16, DefaultHasher)
 			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
@@ -347,20 +374,20 @@ func Test_Proof(t *testing.T) {
 
 	require.Len(t, proof.Path, 3)
 
-	require.Equal(t, newPath([]byte("key1")).Serialize(), proof.Path[2].KeyPath)
+	require.Equal(t, newPath([]byte("key1"), BranchFactor16).Serialize(BranchFactor16), proof.Path[2].KeyPath)
 	require.Equal(t, Some([]byte("value1")), proof.Path[2].ValueOrHash)
 
-	require.Equal(t, newPath([]byte{}).Serialize(), proof.Path[0].KeyPath)
+	require.Equal(t, newPath([]byte{}, BranchFactor16).Serialize(BranchFactor16), proof.Path[0].KeyPath)
 	require.True(t, proof.Path[0].ValueOrHash.IsNothing())
 
 	expectedRootID, err := trie.GetMerkleRoot(context.Background())
 	require.NoError(t, err)
-	err = proof.Verify(context.Background(), expectedRootID)
+	err = proof.Verify(context.Background(), expectedRootID, BranchFactor16, DefaultHasher)
 	require.NoError(t, err)
 
 	proof.Path[0].ValueOrHash = Some([]byte("value2"))
 
-	err = proof.Verify(context.Background(), expectedRootID)
+	err = proof.Verify(context.Background(), expectedRootID, BranchFactor16, DefaultHasher)
 	require.ErrorIs(t, err, ErrInvalidProof)
 }
 
@@ -451,10 +478,10 @@ func Test_RangeProof_Syntactic_Verify(t *testing.T) {
 				},
 				StartProof: []ProofNode{
 					{
-						KeyPath: newPath([]byte{2}).Serialize(),
+						KeyPath: newPath([]byte{2}, BranchFactor16).Serialize(BranchFactor16),
 					},
 					{
-						KeyPath: newPath([]byte{1}).Serialize(),
+						KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16),
 					},
 				},
 			},
@@ -470,13 +497,13 @@ func Test_RangeProof_Syntactic_Verify(t *testing.T) {
 				},
 				StartProof: []ProofNode{
 					{
-						KeyPath: newPath([]byte{1}).Serialize(),
+						KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16),
 					},
 					{
-						KeyPath: newPath([]byte{1, 2, 3}).Serialize(), // Not a prefix of [1, 2]
+						KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16), // Not a prefix of [1, 2]
 					},
 					{
-						KeyPath: newPath([]byte{1, 2, 3, 4}).Serialize(),
+						KeyPath: newPath([]byte{1, 2, 3, 4}, BranchFactor16).Serialize(BranchFactor16),
 					},
 				},
 			},
@@ -492,10 +519,10 @@ func Test_RangeProof_Syntactic_Verify(t *testing.T) {
 				},
 				EndProof: []ProofNode{
 					{
-						KeyPath: newPath([]byte{2}).Serialize(),
+						KeyPath: newPath([]byte{2}, BranchFactor16).Serialize(BranchFactor16),
 					},
 					{
-						KeyPath: newPath([]byte{1}).Serialize(),
+						KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16),
 					},
 				},
 			},
@@ -511,13 +538,13 @@ func Test_RangeProof_Syntactic_Verify(t *testing.T) {
 				},
 				EndProof: []ProofNode{
 					{
-						KeyPath: newPath([]byte{1}).Serialize(),
+						KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16),
 					},
 					{
-						KeyPath: newPath([]byte{1, 2, 3}).Serialize(), // Not a prefix of [1, 2]
+						KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16), // Not a prefix of [1, 2]
 					},
 					{
-						KeyPath: newPath([]byte{1, 2, 3, 4}).Serialize(),
+						KeyPath: newPath([]byte{1, 2, 3, 4}, BranchFactor16).Serialize(BranchFactor16),
 					},
 				},
 			},
@@ -529,7 +556,7 @@ func Test_RangeProof_Syntactic_Verify(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
 
-			err := tt.proof.Verify(context.Background(), tt.start, tt.end, ids.Empty)
+			err := tt.proof.Verify(context.Background(), tt.start, tt.end, ids.Empty, BranchFactor16, DefaultHasher)
 			require.ErrorIs(err, tt.expectedErr)
 		})
 	}
@@ -567,6 +594,8 @@ func Test_RangeProof(t *testing.T) {
 		[]byte{1},
 		[]byte{3, 5},
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.NoError(err)
 }
@@ -612,15 +641,17 @@ func Test_RangeProof_NilStart(t *testing.T) {
 	require.Equal(t, []byte("value1"), proof.KeyValues[0].Value)
 	require.Equal(t, []byte("value2"), proof.KeyValues[1].Value)
 
-	require.Equal(t, newPath([]byte("key2")).Serialize(), proof.EndProof[2].KeyPath)
-	require.Equal(t, SerializedPath{Value: []uint8{0x6b, 0x65, 0x79, 0x30}, NibbleLength: 7}, proof.EndProof[1].KeyPath)
-	require.Equal(t, newPath([]byte("")).Serialize(), proof.EndProof[0].KeyPath)
+	require.Equal(t, newPath([]byte("key2"), BranchFactor16).Serialize(BranchFactor16), proof.EndProof[2].KeyPath)
+	require.Equal(t, SerializedPath{Value: []uint8{0x6b, 0x65, 0x79, 0x30}, TokenLength: 7}, proof.EndProof[1].KeyPath)
+	require.Equal(t, newPath([]byte(""), BranchFactor16).Serialize(BranchFactor16), proof.EndProof[0].KeyPath)
 
 	err = proof.Verify(
 		context.Background(),
 		nil,
 		[]byte("key35"),
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.NoError(t, err)
 }
@@ -654,6 +685,8 @@ func Test_RangeProof_NilEnd(t *testing.T) {
 		[]byte{1},
 		nil,
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.NoError(t, err)
 }
@@ -688,17 +721,19 @@ func Test_RangeProof_EmptyValues(t *testing.T) {
 	require.Empty(t, proof.KeyValues[2].Value)
 
 	require.Len(t, proof.StartProof, 1)
-	require.Equal(t, newPath([]byte("key1")).Serialize(), proof.StartProof[0].KeyPath)
+	require.Equal(t, newPath([]byte("key1"), BranchFactor16).Serialize(BranchFactor16), proof.StartProof[0].KeyPath)
 
 	require.Len(t, proof.EndProof, 3)
-	require.Equal(t, newPath([]byte("key2")).Serialize(), proof.EndProof[2].KeyPath)
-	require.Equal(t, newPath([]byte{}).Serialize(), proof.EndProof[0].KeyPath)
+	require.Equal(t, newPath([]byte("key2"), BranchFactor16).Serialize(BranchFactor16), proof.EndProof[2].KeyPath)
+	require.Equal(t, newPath([]byte{}, BranchFactor16).Serialize(BranchFactor16), proof.EndProof[0].KeyPath)
 
 	err = proof.Verify(
 		context.Background(),
 		[]byte("key1"),
 		[]byte("key2"),
 		db.root.id,
+		BranchFactor16,
+		DefaultHasher,
 	)
 	require.NoError(t, err)
 }
@@ -1139,6 +1174,43 @@ func Test_ChangeProof_Verify(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// Tests that a change proof which doesn't cover every change is verified
+// against a database whose keys outside of the proof's range differ from
+// those at the end root.
+func Test_ChangeProof_Verify_Partial_Deletions(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+	dbClone, err := getBasicDB()
+	require.NoError(err)
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		require.NoError(db.Put(key, key))
+		require.NoError(dbClone.Put(key, key))
+	}
+	startRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	batch := db.NewBatch()
+	for i := 1; i < 9; i++ {
+		require.NoError(batch.Delete([]byte(fmt.Sprintf("key%d", i))))
+	}
+	require.NoError(batch.Write())
+	endRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	for maxLength := 1; maxLength < 10; maxLength++ {
+		proof, err := db.GetChangeProof(context.Background(), startRoot, endRoot, nil, nil, maxLength)
+		require.NoError(err)
+		require.NoError(proof.Verify(context.Background(), dbClone, nil, nil, endRoot))
+
+		proof, err = db.GetChangeProof(context.Background(), startRoot, endRoot, []byte("key2"), nil, maxLength)
+		require.NoError(err)
+		require.NoError(proof.Verify(context.Background(), dbClone, []byte("key2"), nil, endRoot))
+	}
+}
+
 func Test_ChangeProof_Verify_Bad_Data(t *testing.T) {
 	type test struct {
 		name        string
@@ -1157,7 +1229,7 @@ func Test_ChangeProof_Verify_Bad_Data(t *testing.T) {
 			malform: func(proof *ChangeProof) {
 				proof.EndProof[1].ValueOrHash = Some([]byte{1, 2})
 			},
-			expectedErr: ErrOddLengthWithValue,
+			expectedErr: ErrPartialByteLengthWithValue,
 		},
 		{
 			name: "last proof node has missing value",
@@ -1382,8 +1454,8 @@ func Test_ChangeProof_Syntactic_Verify(t *testing.T) {
 			proof: &ChangeProof{
 				HadRootsInHistory: true,
 				StartProof: []ProofNode{
-					{KeyPath: newPath([]byte{2}).Serialize()},
-					{KeyPath: newPath([]byte{2, 3}).Serialize()},
+					{KeyPath: newPath([]byte{2}, BranchFactor16).Serialize(BranchFactor16)},
+					{KeyPath: newPath([]byte{2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 				},
 			},
 			start:       []byte{1, 2, 3},
@@ -1395,8 +1467,8 @@ func Test_ChangeProof_Syntactic_Verify(t *testing.T) {
 			proof: &ChangeProof{
 				HadRootsInHistory: true,
 				StartProof: []ProofNode{
-					{KeyPath: newPath([]byte{1}).Serialize()},
-					{KeyPath: newPath([]byte{2, 3}).Serialize()},
+					{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+					{KeyPath: newPath([]byte{2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 				},
 			},
 			start:       []byte{1, 2, 3},
@@ -1411,8 +1483,8 @@ func Test_ChangeProof_Syntactic_Verify(t *testing.T) {
 					{Key: []byte{1, 2}}, // Also tests [end] set to greatest key-value/deleted key
 				},
 				EndProof: []ProofNode{
-					{KeyPath: newPath([]byte{2}).Serialize()},
-					{KeyPath: newPath([]byte{2, 3}).Serialize()},
+					{KeyPath: newPath([]byte{2}, BranchFactor16).Serialize(BranchFactor16)},
+					{KeyPath: newPath([]byte{2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 				},
 			},
 			start:       nil,
@@ -1427,8 +1499,8 @@ func Test_ChangeProof_Syntactic_Verify(t *testing.T) {
 					{1, 2, 3}, // Also tests [end] set to greatest key-value/deleted key
 				},
 				EndProof: []ProofNode{
-					{KeyPath: newPath([]byte{1}).Serialize()},
-					{KeyPath: newPath([]byte{2, 3}).Serialize()},
+					{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+					{KeyPath: newPath([]byte{2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 				},
 			},
 			start:       nil,
@@ -1548,9 +1620,9 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "non-increasing keys",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: ErrNonIncreasingProofNodes,
@@ -1558,10 +1630,10 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "invalid key",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 4}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 4}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: ErrProofNodeNotForKey,
@@ -1569,9 +1641,9 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "extra node inclusion proof",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2},
 			expectedErr: ErrProofNodeNotForKey,
@@ -1579,9 +1651,9 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "extra node exclusion proof",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 3}).Serialize()},
-				{KeyPath: newPath([]byte{1, 3, 4}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 3}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 3, 4}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2},
 			expectedErr: ErrProofNodeNotForKey,
@@ -1589,9 +1661,9 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "happy path exclusion proof",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 4}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 4}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: nil,
@@ -1599,9 +1671,9 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "happy path inclusion proof",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: nil,
@@ -1609,10 +1681,10 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "repeat nodes",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: ErrNonIncreasingProofNodes,
@@ -1620,10 +1692,10 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "repeat nodes 2",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: ErrNonIncreasingProofNodes,
@@ -1631,10 +1703,10 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "repeat nodes 3",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2, 3}).Serialize()},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2, 3}, BranchFactor16).Serialize(BranchFactor16)},
 			},
 			proofKey:    []byte{1, 2, 3},
 			expectedErr: ErrProofNodeNotForKey,
@@ -1642,19 +1714,312 @@ func TestVerifyProofPath(t *testing.T) {
 		{
 			name: "oddLength key with value",
 			path: []ProofNode{
-				{KeyPath: newPath([]byte{1}).Serialize()},
-				{KeyPath: newPath([]byte{1, 2}).Serialize()},
-				{KeyPath: SerializedPath{Value: []byte{1, 2, 240}, NibbleLength: 5}, ValueOrHash: Some([]byte{1})},
+				{KeyPath: newPath([]byte{1}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: newPath([]byte{1, 2}, BranchFactor16).Serialize(BranchFactor16)},
+				{KeyPath: SerializedPath{Value: []byte{1, 2, 240}, TokenLength: 5}, ValueOrHash: Some([]byte{1})},
 			},
 			proofKey:    []byte{1, 2, 3},
-			expectedErr: ErrOddLengthWithValue,
+			expectedErr: ErrPartialByteLengthWithValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyProofPath(tt.path, newPath(tt.proofKey, BranchFactor16), BranchFactor16)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_MultiProof(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	keyValues := newKeyValues(r, 1000)
+	for _, kv := range keyValues {
+		require.NoError(db.Put(kv.Key, kv.Value))
+	}
+	rootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	// Request existing keys, missing keys, and duplicates.
+	keys := make([][]byte, 0, 150)
+	for _, kv := range keyValues[:50] {
+		keys = append(keys, kv.Key)
+	}
+	for _, kv := range newKeyValues(r, 50) {
+		keys = append(keys, kv.Key)
+	}
+	keys = append(keys, keys[:50]...)
+
+	proof, err := db.GetMultiProof(context.Background(), keys)
+	require.NoError(err)
+	require.NoError(proof.Verify(context.Background(), rootID, BranchFactor16, DefaultHasher))
+
+	numIndividualProofNodes := 0
+	for i, key := range proof.Keys {
+		if i > 0 {
+			require.Equal(-1, bytes.Compare(proof.Keys[i-1], key))
+		}
+
+		value, err := db.Get(key)
+		if err == database.ErrNotFound {
+			require.True(proof.Values[i].IsNothing())
+		} else {
+			require.NoError(err)
+			require.Equal(value, proof.Values[i].Value())
+		}
+
+		keyProof, err := db.GetProof(context.Background(), key)
+		require.NoError(err)
+		numIndividualProofNodes += len(keyProof.Path)
+	}
+	// Shared nodes are only included once.
+	require.Less(len(proof.Nodes), numIndividualProofNodes)
+
+	proofBytes, err := Codec.EncodeMultiProof(Version, proof)
+	require.NoError(err)
+
+	parsedProof := &MultiProof{}
+	_, err = Codec.DecodeMultiProof(proofBytes, parsedProof)
+	require.NoError(err)
+	require.NoError(parsedProof.Verify(context.Background(), rootID, BranchFactor16, DefaultHasher))
+}
+
+func Test_MultiProof_Verify_Bad_Data(t *testing.T) {
+	type test struct {
+		name        string
+		malform     func(proof *MultiProof)
+		expectedErr error
+	}
+
+	nodeIndex := func(proof *MultiProof, key []byte) int {
+		keyPath := newPath(key, BranchFactor16).Serialize(BranchFactor16)
+		for i, proofNode := range proof.Nodes {
+			if proofNode.KeyPath.Equal(keyPath) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	tests := []test{
+		{
+			name:        "happyPath",
+			malform:     func(proof *MultiProof) {},
+			expectedErr: nil,
+		},
+		{
+			name: "no nodes",
+			malform: func(proof *MultiProof) {
+				proof.Nodes = nil
+			},
+			expectedErr: ErrNoProof,
+		},
+		{
+			name: "missing root",
+			malform: func(proof *MultiProof) {
+				proof.Nodes = proof.Nodes[1:]
+			},
+			expectedErr: ErrNoRootProofNode,
+		},
+		{
+			name: "missing value",
+			malform: func(proof *MultiProof) {
+				proof.Values = proof.Values[1:]
+			},
+			expectedErr: ErrKeysValuesLengthMismatch,
+		},
+		{
+			name: "unsorted keys",
+			malform: func(proof *MultiProof) {
+				proof.Keys[0], proof.Keys[1] = proof.Keys[1], proof.Keys[0]
+				proof.Values[0], proof.Values[1] = proof.Values[1], proof.Values[0]
+			},
+			expectedErr: ErrNonIncreasingValues,
+		},
+		{
+			name: "unsorted nodes",
+			malform: func(proof *MultiProof) {
+				last := len(proof.Nodes) - 1
+				proof.Nodes[last-1], proof.Nodes[last] = proof.Nodes[last], proof.Nodes[last-1]
+			},
+			expectedErr: ErrUnsortedProofNodes,
+		},
+		{
+			name: "mismatched value",
+			malform: func(proof *MultiProof) {
+				proof.Values[0] = Some([]byte{10})
+			},
+			expectedErr: ErrProofValueDoesntMatch,
+		},
+		{
+			name: "value of excluded key",
+			malform: func(proof *MultiProof) {
+				proof.Values[2] = Some([]byte{5})
+			},
+			expectedErr: ErrProofValueDoesntMatch,
+		},
+		{
+			name: "missing node",
+			malform: func(proof *MultiProof) {
+				i := nodeIndex(proof, []byte{1})
+				proof.Nodes = append(proof.Nodes[:i], proof.Nodes[i+1:]...)
+			},
+			expectedErr: ErrMissingProofNode,
+		},
+		{
+			name: "extra node",
+			malform: func(proof *MultiProof) {
+				proof.Nodes = append(proof.Nodes, ProofNode{
+					KeyPath: newPath([]byte{255}, BranchFactor16).Serialize(BranchFactor16),
+				})
+			},
+			expectedErr: ErrExtraProofNodes,
+		},
+		{
+			name: "modified node",
+			malform: func(proof *MultiProof) {
+				i := nodeIndex(proof, []byte{2})
+				proof.Nodes[i].ValueOrHash = Some([]byte{10})
+				proof.Values[1] = Some([]byte{10})
+			},
+			expectedErr: ErrInvalidProof,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := verifyProofPath(tt.path, newPath(tt.proofKey))
+			db, err := getBasicDB()
+			require.NoError(t, err)
+
+			writeBasicBatch(t, db)
+
+			proof, err := db.GetMultiProof(context.Background(), [][]byte{{1}, {2}, {5}})
+			require.NoError(t, err)
+			require.NotNil(t, proof)
+
+			tt.malform(proof)
+
+			err = proof.Verify(context.Background(), db.getMerkleRoot(), BranchFactor16, DefaultHasher)
 			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
+
+func Test_Proofs_BranchFactors_Hashers(t *testing.T) {
+	for _, bf := range branchFactors {
+		for hasherName, hasher := range hashers {
+			bf, hasher := bf, hasher
+			t.Run(fmt.Sprintf("branch factor %d %s", bf, hasherName), func(t *testing.T) {
+				require := require.New(t)
+				ctx := context.Background()
+
+				db, err := getDBWithBranchFactorAndHasher(bf, hasher)
+				require.NoError(err)
+				codec, err := NewCodec(bf)
+				require.NoError(err)
+
+				r := rand.New(rand.NewSource(int64(bf))) // #nosec G404
+				keyValues := newKeyValues(r, 500)
+				for _, kv := range keyValues {
+					require.NoError(db.Put(kv.Key, kv.Value))
+				}
+				startRoot, err := db.GetMerkleRoot(ctx)
+				require.NoError(err)
+
+				batch := db.NewBatch()
+				for _, kv := range keyValues[:100] {
+					require.NoError(batch.Delete(kv.Key))
+				}
+				for _, kv := range newKeyValues(r, 100) {
+					require.NoError(batch.Put(kv.Key, kv.Value))
+				}
+				require.NoError(batch.Write())
+				endRoot, err := db.GetMerkleRoot(ctx)
+				require.NoError(err)
+
+				// Proofs of existence and of exclusion.
+				for _, key := range [][]byte{keyValues[200].Key, {1, 2, 3}} {
+					proof, err := db.GetProof(ctx, key)
+					require.NoError(err)
+					require.NoError(proof.Verify(ctx, endRoot, bf, hasher))
+
+					proofBytes, err := codec.EncodeProof(Version, proof)
+					require.NoError(err)
+					parsedProof := &Proof{}
+					_, err = codec.DecodeProof(proofBytes, parsedProof)
+					require.NoError(err)
+					require.NoError(parsedProof.Verify(ctx, endRoot, bf, hasher))
+				}
+
+				multiProof, err := db.GetMultiProof(ctx, [][]byte{keyValues[200].Key, keyValues[300].Key, {1, 2, 3}})
+				require.NoError(err)
+				require.NoError(multiProof.Verify(ctx, endRoot, bf, hasher))
+
+				multiProofBytes, err := codec.EncodeMultiProof(Version, multiProof)
+				require.NoError(err)
+				parsedMultiProof := &MultiProof{}
+				_, err = codec.DecodeMultiProof(multiProofBytes, parsedMultiProof)
+				require.NoError(err)
+				require.NoError(parsedMultiProof.Verify(ctx, endRoot, bf, hasher))
+
+				start, end := []byte{0x40}, []byte{0xC0}
+				rangeProof, err := db.GetRangeProof(ctx, start, end, 50)
+				require.NoError(err)
+				require.Len(rangeProof.KeyValues, 50)
+				require.NoError(rangeProof.Verify(ctx, start, end, endRoot, bf, hasher))
+
+				rangeProofBytes, err := codec.EncodeRangeProof(Version, rangeProof)
+				require.NoError(err)
+				parsedRangeProof := &RangeProof{}
+				_, err = codec.DecodeRangeProof(rangeProofBytes, parsedRangeProof)
+				require.NoError(err)
+				require.NoError(parsedRangeProof.Verify(ctx, start, end, endRoot, bf, hasher))
+
+				// A proof doesn't verify with another hasher.
+				for otherName, otherHasher := range hashers {
+					if otherName != hasherName {
+						require.ErrorIs(rangeProof.Verify(ctx, start, end, endRoot, bf, otherHasher), ErrInvalidProof)
+					}
+				}
+
+				changeProof, err := db.GetChangeProof(ctx, startRoot, endRoot, nil, nil, 1000)
+				require.NoError(err)
+
+				changeProofBytes, err := codec.EncodeChangeProof(Version, changeProof)
+				require.NoError(err)
+				parsedChangeProof := &ChangeProof{}
+				_, err = codec.DecodeChangeProof(changeProofBytes, parsedChangeProof)
+				require.NoError(err)
+
+				dbClone, err := getDBWithBranchFactorAndHasher(bf, hasher)
+				require.NoError(err)
+				for _, kv := range keyValues {
+					require.NoError(dbClone.Put(kv.Key, kv.Value))
+				}
+				require.NoError(parsedChangeProof.Verify(ctx, dbClone, nil, nil, endRoot))
+			})
+		}
+	}
+}
+
+func Test_Proof_Verify_Invalid_Branch_Factor(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	db, err := getBasicDB()
+	require.NoError(err)
+	writeBasicBatch(t, db)
+
+	proof, err := db.GetProof(ctx, []byte{1})
+	require.NoError(err)
+	require.ErrorIs(proof.Verify(ctx, db.getMerkleRoot(), 3, DefaultHasher), ErrInvalidBranchFactor)
+
+	// A child index that can't exist with the branch factor is rejected.
+	proof.Path[0].Children[byte(BranchFactor4)] = ids.GenerateTestID()
+	require.ErrorIs(proof.Verify(ctx, db.getMerkleRoot(), BranchFactor4, DefaultHasher), ErrInvalidChildIndex)
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils"
+)
+
+var (
+	// ErrSubscriptionTooSlow is returned by [Subscription.Err] if the
+	// subscription was closed because its buffer was full when a commit
+	// happened.
+	ErrSubscriptionTooSlow = errors.New("subscription didn't keep up with committed changes")
+
+	errInvalidBufferSize = errors.New("subscription buffer size must be positive")
+)
+
+// KeyChange is a change to the value of a key.
+type KeyChange struct {
+	Key []byte
+	// Nothing if the key wasn't in the trie before the change.
+	Before Maybe[[]byte]
+	// Nothing if the key isn't in the trie after the change.
+	After Maybe[[]byte]
+}
+
+// CommittedChanges are the changes made to the keys a subscription is
+// interested in by a commit to the database.
+type CommittedChanges struct {
+	// The root of the trie after the commit.
+	RootID ids.ID
+	// The changed keys with the subscribed prefix, sorted by key.
+	// May be empty if the commit didn't change any of those keys.
+	Changes []KeyChange
+}
+
+type SubscriptionConfig struct {
+	// Only changes to keys with this prefix are delivered.
+	// If empty, changes to every key are delivered.
+	Prefix []byte
+	// The number of commits that can be buffered before the subscription is
+	// closed with [ErrSubscriptionTooSlow]. Must be positive.
+	BufferSize int
+	// If not Nothing, the first changes delivered are the combined changes
+	// made since the trie's root was this root, so that a subscriber can
+	// resume from the last root it processed. The root must be in the
+	// database's history.
+	ResumeFrom Maybe[ids.ID]
+}
+
+// Subscription delivers the changes made by each commit to a [Database].
+//
+// Commits never wait for a subscriber. If a subscriber doesn't keep up and
+// its buffer fills, the subscription is closed with [ErrSubscriptionTooSlow].
+// The subscriber can then subscribe again, resuming from the last root it
+// processed.
+type Subscription struct {
+	db      *Database
+	prefix  path
+	changes chan *CommittedChanges
+	err     utils.Atomic[error]
+}
+
+// Subscribe returns a subscription to the changes made to the database by
+// each commit after this call.
+// If [config.ResumeFrom] is set, the changes made since that root are
+// delivered first.
+func (db *Database) Subscribe(config SubscriptionConfig) (*Subscription, error) {
+	if config.BufferSize <= 0 {
+		return nil, errInvalidBufferSize
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	s := &Subscription{
+		db:      db,
+		prefix:  newPath(config.Prefix, db.branchFactor),
+		changes: make(chan *CommittedChanges, config.BufferSize),
+	}
+
+	if !config.ResumeFrom.IsNothing() {
+		startRootID := config.ResumeFrom.Value()
+		rootID := db.getMerkleRoot()
+		if startRootID != rootID {
+			changes, err := db.getValueChanges(startRootID, rootID, config.Prefix, nil, math.MaxInt)
+			if err != nil {
+				return nil, err
+			}
+			changes.rootID = rootID
+			s.changes <- s.committedChanges(changes, sortedChangedKeys(changes))
+		}
+	}
+
+	db.subscriptions.Add(s)
+	return s, nil
+}
+
+// Changes returns the channel the changes are delivered on.
+// The channel is closed when the subscription is closed, after which [Err]
+// reports why.
+func (s *Subscription) Changes() <-chan *CommittedChanges {
+	return s.changes
+}
+
+// Err returns the reason the subscription was closed, or nil if it's open or
+// was closed by [Close].
+func (s *Subscription) Err() error {
+	return s.err.Get()
+}
+
+// Close stops the delivery of changes. The changes already buffered remain
+// readable.
+func (s *Subscription) Close() {
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+
+	s.close(nil)
+}
+
+// Closes the subscription with [err].
+// Assumes [s.db.lock] is held.
+func (s *Subscription) close(err error) {
+	if !s.db.subscriptions.Contains(s) {
+		return
+	}
+	s.db.subscriptions.Remove(s)
+	s.err.Set(err)
+	close(s.changes)
+}
+
+// Returns the changes in [changes] to keys with [s.prefix].
+// [sortedKeys] are the changed keys in [changes], in increasing order.
+func (s *Subscription) committedChanges(changes *changeSummary, sortedKeys []path) *CommittedChanges {
+	committed := &CommittedChanges{
+		RootID: changes.rootID,
+	}
+	for _, key := range sortedKeys {
+		if !key.HasPrefix(s.prefix) {
+			continue
+		}
+		valueChange := changes.values[key]
+		committed.Changes = append(committed.Changes, KeyChange{
+			Key:    key.Serialize(s.db.branchFactor).Value,
+			Before: Clone(valueChange.before),
+			After:  Clone(valueChange.after),
+		})
+	}
+	return committed
+}
+
+// Delivers [changes] to every subscription. Subscriptions whose buffers are
+// full are closed.
+// Assumes [db.lock] is held.
+func (db *Database) notifySubscriptions(changes *changeSummary) {
+	if db.subscriptions.Len() == 0 {
+		return
+	}
+
+	sortedKeys := sortedChangedKeys(changes)
+	for s := range db.subscriptions {
+		select {
+		case s.changes <- s.committedChanges(changes, sortedKeys):
+		default:
+			s.close(ErrSubscriptionTooSlow)
+		}
+	}
+}
+
+// Returns the keys of the values changed in [changes] in increasing order.
+func sortedChangedKeys(changes *changeSummary) []path {
+	keys := make([]path, 0, len(changes.values))
+	for key := range changes.values {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b path) bool {
+		return a.Compare(b) < 0
+	})
+	return keys
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+func TestSubscribe(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+	require.NoError(db.Put([]byte("a1"), []byte("old")))
+
+	s, err := db.Subscribe(SubscriptionConfig{
+		Prefix:     []byte("a"),
+		BufferSize: 10,
+	})
+	require.NoError(err)
+
+	batch := db.NewBatch()
+	require.NoError(batch.Put([]byte("a1"), []byte("new")))
+	require.NoError(batch.Put([]byte("a0"), []byte("value")))
+	require.NoError(batch.Put([]byte("b"), []byte("value")))
+	require.NoError(batch.Write())
+	root1, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	view, err := db.NewView()
+	require.NoError(err)
+	require.NoError(view.Remove(context.Background(), []byte("a1")))
+	require.NoError(view.Insert(context.Background(), []byte("c"), []byte("value")))
+	require.NoError(view.CommitToDB(context.Background()))
+	root2, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	require.Equal(
+		&CommittedChanges{
+			RootID: root1,
+			Changes: []KeyChange{
+				{
+					Key:    []byte("a0"),
+					Before: Nothing[[]byte](),
+					After:  Some([]byte("value")),
+				},
+				{
+					Key:    []byte("a1"),
+					Before: Some([]byte("old")),
+					After:  Some([]byte("new")),
+				},
+			},
+		},
+		<-s.Changes(),
+	)
+	require.Equal(
+		&CommittedChanges{
+			RootID: root2,
+			Changes: []KeyChange{
+				{
+					Key:    []byte("a1"),
+					Before: Some([]byte("new")),
+					After:  Nothing[[]byte](),
+				},
+			},
+		},
+		<-s.Changes(),
+	)
+
+	// Changes that don't touch the prefix are still delivered with the new root.
+	require.NoError(db.Put([]byte("b"), []byte("other value")))
+	root3, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(&CommittedChanges{RootID: root3}, <-s.Changes())
+
+	s.Close()
+	_, ok := <-s.Changes()
+	require.False(ok)
+	require.NoError(s.Err())
+
+	// Closing again is a no-op.
+	s.Close()
+}
+
+func TestSubscriptionTooSlow(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	s, err := db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+	})
+	require.NoError(err)
+
+	require.NoError(db.Put([]byte("key1"), []byte("value1")))
+	root1, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.NoError(db.Put([]byte("key2"), []byte("value2")))
+	require.NoError(db.Put([]byte("key1"), []byte("value3")))
+	root2, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	// The buffered changes are delivered before the channel is closed.
+	changes, ok := <-s.Changes()
+	require.True(ok)
+	require.Equal(root1, changes.RootID)
+	_, ok = <-s.Changes()
+	require.False(ok)
+	require.ErrorIs(s.Err(), ErrSubscriptionTooSlow)
+
+	// Resuming from the last processed root delivers the missed changes.
+	s, err = db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+		ResumeFrom: Some(root1),
+	})
+	require.NoError(err)
+	require.Equal(
+		&CommittedChanges{
+			RootID: root2,
+			Changes: []KeyChange{
+				{
+					Key:    []byte("key1"),
+					Before: Some([]byte("value1")),
+					After:  Some([]byte("value3")),
+				},
+				{
+					Key:    []byte("key2"),
+					Before: Nothing[[]byte](),
+					After:  Some([]byte("value2")),
+				},
+			},
+		},
+		<-s.Changes(),
+	)
+
+	// Resuming from the current root delivers nothing until the next commit.
+	s, err = db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+		ResumeFrom: Some(root2),
+	})
+	require.NoError(err)
+	require.Empty(s.Changes())
+}
+
+func TestSubscribeInvalid(t *testing.T) {
+	require := require.New(t)
+
+	db, err := getBasicDB()
+	require.NoError(err)
+
+	_, err = db.Subscribe(SubscriptionConfig{})
+	require.ErrorIs(err, errInvalidBufferSize)
+
+	_, err = db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+		ResumeFrom: Some(ids.GenerateTestID()),
+	})
+	require.ErrorIs(err, ErrStartRootNotFound)
+
+	s, err := db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+	})
+	require.NoError(err)
+	require.NoError(db.Close())
+	_, ok := <-s.Changes()
+	require.False(ok)
+	require.ErrorIs(s.Err(), database.ErrClosed)
+
+	_, err = db.Subscribe(SubscriptionConfig{
+		BufferSize: 1,
+	})
+	require.ErrorIs(err, database.ErrClosed)
+}
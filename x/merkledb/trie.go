@@ -35,6 +35,9 @@ type ReadOnlyTrie interface {
 	// GetProof generates a proof of the value associated with a particular key, or a proof of its absence from the trie
 	GetProof(ctx context.Context, bytesPath []byte) (*Proof, error)
 
+	// GetMultiProof generates a proof of the values associated with a set of keys, or proofs of their absence from the trie
+	GetMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error)
+
 	// GetRangeProof generates a proof of up to maxLength smallest key/values with keys between start and end
 	GetRangeProof(ctx context.Context, start, end []byte, maxLength int) (*RangeProof, error)
 
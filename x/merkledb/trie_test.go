@@ -22,7 +22,7 @@ func getNodeValue(t ReadOnlyTrie, key string) ([]byte, error) {
 		if err := asTrieView.calculateNodeIDs(context.Background()); err != nil {
 			return nil, err
 		}
-		path := newPath([]byte(key))
+		path := newPath([]byte(key), BranchFactor16)
 		nodePath, err := asTrieView.getPathTo(path)
 		if err != nil {
 			return nil, err
@@ -39,7 +39,7 @@ func getNodeValue(t ReadOnlyTrie, key string) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		path := newPath([]byte(key))
+		path := newPath([]byte(key), BranchFactor16)
 		nodePath, err := view.(*trieView).getPathTo(path)
 		if err != nil {
 			return nil, err
@@ -110,7 +110,7 @@ func TestTrieViewGetPathTo(t *testing.T) {
 	trie, ok := trieIntf.(*trieView)
 	require.True(ok)
 
-	path, err := trie.getPathTo(newPath(nil))
+	path, err := trie.getPathTo(newPath(nil, BranchFactor16))
 	require.NoError(err)
 
 	// Just the root
@@ -124,13 +124,13 @@ func TestTrieViewGetPathTo(t *testing.T) {
 	err = trie.calculateNodeIDs(context.Background())
 	require.NoError(err)
 
-	path, err = trie.getPathTo(newPath(key1))
+	path, err = trie.getPathTo(newPath(key1, BranchFactor16))
 	require.NoError(err)
 
 	// Root and 1 value
 	require.Len(path, 2)
 	require.Equal(trie.root, path[0])
-	require.Equal(newPath(key1), path[1].key)
+	require.Equal(newPath(key1, BranchFactor16), path[1].key)
 
 	// Insert another key which is a child of the first
 	key2 := []byte{0, 1}
@@ -139,12 +139,12 @@ func TestTrieViewGetPathTo(t *testing.T) {
 	err = trie.calculateNodeIDs(context.Background())
 	require.NoError(err)
 
-	path, err = trie.getPathTo(newPath(key2))
+	path, err = trie.getPathTo(newPath(key2, BranchFactor16))
 	require.NoError(err)
 	require.Len(path, 3)
 	require.Equal(trie.root, path[0])
-	require.Equal(newPath(key1), path[1].key)
-	require.Equal(newPath(key2), path[2].key)
+	require.Equal(newPath(key1, BranchFactor16), path[1].key)
+	require.Equal(newPath(key2, BranchFactor16), path[2].key)
 
 	// Insert a key which shares no prefix with the others
 	key3 := []byte{255}
@@ -153,32 +153,32 @@ func TestTrieViewGetPathTo(t *testing.T) {
 	err = trie.calculateNodeIDs(context.Background())
 	require.NoError(err)
 
-	path, err = trie.getPathTo(newPath(key3))
+	path, err = trie.getPathTo(newPath(key3, BranchFactor16))
 	require.NoError(err)
 	require.Len(path, 2)
 	require.Equal(trie.root, path[0])
-	require.Equal(newPath(key3), path[1].key)
+	require.Equal(newPath(key3, BranchFactor16), path[1].key)
 
 	// Other key paths not affected
-	path, err = trie.getPathTo(newPath(key2))
+	path, err = trie.getPathTo(newPath(key2, BranchFactor16))
 	require.NoError(err)
 	require.Len(path, 3)
 	require.Equal(trie.root, path[0])
-	require.Equal(newPath(key1), path[1].key)
-	require.Equal(newPath(key2), path[2].key)
+	require.Equal(newPath(key1, BranchFactor16), path[1].key)
+	require.Equal(newPath(key2, BranchFactor16), path[2].key)
 
 	// Gets closest node when key doesn't exist
 	key4 := []byte{0, 1, 2}
-	path, err = trie.getPathTo(newPath(key4))
+	path, err = trie.getPathTo(newPath(key4, BranchFactor16))
 	require.NoError(err)
 	require.Len(path, 3)
 	require.Equal(trie.root, path[0])
-	require.Equal(newPath(key1), path[1].key)
-	require.Equal(newPath(key2), path[2].key)
+	require.Equal(newPath(key1, BranchFactor16), path[1].key)
+	require.Equal(newPath(key2, BranchFactor16), path[2].key)
 
 	// Gets just root when key doesn't exist and no key shares a prefix
 	key5 := []byte{128}
-	path, err = trie.getPathTo(newPath(key5))
+	path, err = trie.getPathTo(newPath(key5, BranchFactor16))
 	require.NoError(err)
 	require.Len(path, 1)
 	require.Equal(trie.root, path[0])
@@ -211,6 +211,40 @@ func Test_Trie_ViewOnCommitedView(t *testing.T) {
 	require.Equal(t, []byte{1}, val1)
 }
 
+func Test_Trie_Commit_Empty_View(t *testing.T) {
+	require := require.New(t)
+
+	dbTrie, err := getBasicDB()
+	require.NoError(err)
+	require.NoError(dbTrie.Put([]byte{0}, []byte{0}))
+	startRoot, err := dbTrie.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	view, err := dbTrie.NewView()
+	require.NoError(err)
+	require.NoError(view.Insert(context.Background(), []byte{1}, []byte{1}))
+	expectedRoot, err := view.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	// committing a view without changes leaves its parent unchanged
+	emptyView, err := view.NewView()
+	require.NoError(err)
+	require.NoError(emptyView.CommitToParent(context.Background()))
+	root, err := view.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(expectedRoot, root)
+
+	require.NoError(view.CommitToDB(context.Background()))
+	root, err = dbTrie.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(expectedRoot, root)
+
+	// the new root is in the history
+	proof, err := dbTrie.GetChangeProof(context.Background(), startRoot, expectedRoot, nil, nil, 10)
+	require.NoError(err)
+	require.True(proof.HadRootsInHistory)
+}
+
 func Test_Trie_Partial_Commit_Leaves_Valid_Tries(t *testing.T) {
 	dbTrie, err := getBasicDB()
 	require.NoError(t, err)
@@ -270,10 +304,10 @@ func Test_Trie_WriteToDB(t *testing.T) {
 
 	err = trie.CommitToDB(context.Background())
 	require.NoError(t, err)
-	p := newPath([]byte("key"))
+	p := newPath([]byte("key"), BranchFactor16)
 	rawBytes, err := dbTrie.nodeDB.Get(p.Bytes())
 	require.NoError(t, err)
-	node, err := parseNode(p, rawBytes)
+	node, err := parseNode(Codec, DefaultHasher, p, rawBytes)
 	require.NoError(t, err)
 	require.Equal(t, []byte("value"), node.value.value)
 }
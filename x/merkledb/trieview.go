@@ -15,6 +15,7 @@ import (
 
 	oteltrace "go.opentelemetry.io/otel/trace"
 
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 
@@ -26,10 +27,10 @@ import (
 const defaultPreallocationSize = 100
 
 var (
-	ErrCommitted          = errors.New("view has been committed")
-	ErrInvalid            = errors.New("the trie this view was based on has changed, rending this view invalid")
-	ErrOddLengthWithValue = errors.New(
-		"the underlying db only supports whole number of byte keys, so cannot record changes with odd nibble length",
+	ErrCommitted                  = errors.New("view has been committed")
+	ErrInvalid                    = errors.New("the trie this view was based on has changed, rending this view invalid")
+	ErrPartialByteLengthWithValue = errors.New(
+		"the underlying db only supports whole number of byte keys, so cannot record changes with partial byte length",
 	)
 	ErrGetPathToFailure = errors.New("GetPathTo failed to return the closest node")
 	ErrStartAfterEnd    = errors.New("start key > end key")
@@ -269,7 +270,7 @@ func (t *trieView) calculateNodeIDsHelper(ctx context.Context, n *node, eg *errg
 	for childIndex, child := range n.children {
 		childIndex, child := childIndex, child
 
-		childPath := n.key + path(childIndex) + child.compressedPath
+		childPath := n.key.Append(childIndex) + child.compressedPath
 		childNodeChange, ok := t.changes.nodes[childPath]
 		if !ok {
 			// This child wasn't changed.
@@ -307,7 +308,7 @@ func (t *trieView) calculateNodeIDsHelper(ctx context.Context, n *node, eg *errg
 	}
 
 	// The IDs [n]'s descendants are up to date so we can calculate [n]'s ID.
-	return n.calculateID(t.db.metrics)
+	return n.calculateID(t.db.codec, t.db.hasher, t.db.metrics)
 }
 
 // GetProof returns a proof that [bytesPath] is in or not in trie [t].
@@ -333,6 +334,73 @@ func (t *trieView) GetProof(ctx context.Context, key []byte) (*Proof, error) {
 	return t.getProof(ctx, key)
 }
 
+// GetMultiProof returns a proof that each key in [keys] is in or not in trie [t].
+func (t *trieView) GetMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error) {
+	_, span := t.db.tracer.Start(ctx, "MerkleDB.trieview.GetMultiProof")
+	defer span.End()
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	// only need full lock if nodes ids need to be calculated
+	// looped to ensure that the value didn't change after the lock was released
+	for t.needsRecalculation {
+		t.lock.RUnlock()
+		t.lock.Lock()
+		if err := t.calculateNodeIDs(ctx); err != nil {
+			return nil, err
+		}
+		t.lock.Unlock()
+		t.lock.RLock()
+	}
+
+	return t.getMultiProof(ctx, keys)
+}
+
+// Returns a proof that each key in [keys] is in or not in trie [t].
+// Assumes [t.lock] is held.
+func (t *trieView) getMultiProof(ctx context.Context, keys [][]byte) (*MultiProof, error) {
+	ctx, span := t.db.tracer.Start(ctx, "MerkleDB.trieview.getMultiProof", oteltrace.WithAttributes(
+		attribute.Int("keyCount", len(keys)),
+	))
+	defer span.End()
+
+	keys = slices.Clone(keys)
+	slices.SortFunc(keys, func(i, j []byte) bool {
+		return bytes.Compare(i, j) < 0
+	})
+	keys = slices.CompactFunc(keys, bytes.Equal)
+
+	proof := &MultiProof{
+		Keys:   keys,
+		Values: make([]Maybe[[]byte], len(keys)),
+	}
+
+	// The proof paths of the keys are merged so that each node shared by
+	// multiple paths is only included once.
+	proofNodes := make(map[path]ProofNode)
+	for i, key := range keys {
+		keyProof, err := t.getProof(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		proof.Values[i] = keyProof.Value
+		for _, proofNode := range keyProof.Path {
+			proofNodes[proofNode.KeyPath.deserialize(t.db.branchFactor)] = proofNode
+		}
+	}
+
+	nodePaths := maps.Keys(proofNodes)
+	slices.SortFunc(nodePaths, func(i, j path) bool {
+		return i.Compare(j) < 0
+	})
+	proof.Nodes = make([]ProofNode, len(nodePaths))
+	for i, nodePath := range nodePaths {
+		proof.Nodes[i] = proofNodes[nodePath]
+	}
+	return proof, nil
+}
+
 // Returns a proof that [bytesPath] is in or not in trie [t].
 // Assumes [t.lock] is held.
 func (t *trieView) getProof(ctx context.Context, key []byte) (*Proof, error) {
@@ -344,7 +412,7 @@ func (t *trieView) getProof(ctx context.Context, key []byte) (*Proof, error) {
 	}
 
 	// Get the node at the given path, or the node closest to it.
-	keyPath := newPath(key)
+	keyPath := newPath(key, t.db.branchFactor)
 
 	proofPath, err := t.getPathTo(keyPath)
 	if err != nil {
@@ -354,7 +422,7 @@ func (t *trieView) getProof(ctx context.Context, key []byte) (*Proof, error) {
 	// From root --> node from left --> right.
 	proof.Path = make([]ProofNode, len(proofPath), len(proofPath)+1)
 	for i, node := range proofPath {
-		proof.Path[i] = node.asProofNode()
+		proof.Path[i] = node.asProofNode(t.db.branchFactor)
 	}
 
 	closestNode := proofPath[len(proofPath)-1]
@@ -374,12 +442,12 @@ func (t *trieView) getProof(ctx context.Context, key []byte) (*Proof, error) {
 		return proof, nil
 	}
 
-	childPath := closestNode.key + path(nextIndex) + child.compressedPath
+	childPath := closestNode.key.Append(nextIndex) + child.compressedPath
 	childNode, err := t.getNodeFromParent(closestNode, childPath)
 	if err != nil {
 		return nil, err
 	}
-	proof.Path = append(proof.Path, childNode.asProofNode())
+	proof.Path = append(proof.Path, childNode.asProofNode(t.db.branchFactor))
 	if t.isInvalid() {
 		return nil, ErrInvalid
 	}
@@ -532,6 +600,13 @@ func (t *trieView) commitChanges(ctx context.Context, trieToCommit *trieView) er
 		return err
 	}
 
+	if len(trieToCommit.changes.nodes) == 0 {
+		// [trieToCommit] has no changes, so its root may be an outdated copy
+		// of this view's root and shouldn't replace it.
+		t.moveChildViewsToView(trieToCommit)
+		return nil
+	}
+
 	for key, nodeChange := range trieToCommit.changes.nodes {
 		if existing, ok := t.changes.nodes[key]; ok {
 			existing.after = nodeChange.after
@@ -750,12 +825,17 @@ func (t *trieView) getKeyValues(
 	// collect all values that have changed or been deleted
 	changes := make([]KeyValue, 0, len(t.changes.values))
 	for key, change := range t.changes.values {
+		serializedKey := key.Serialize(t.db.branchFactor).Value
+		if bytes.Compare(serializedKey, start) < 0 || keysToIgnore.Contains(string(serializedKey)) {
+			// The key is before the range or was deleted by a descendant view.
+			continue
+		}
 		if change.after.IsNothing() {
 			// This was deleted
-			keysToIgnore.Add(string(key.Serialize().Value))
+			keysToIgnore.Add(string(serializedKey))
 		} else {
 			changes = append(changes, KeyValue{
-				Key:   key.Serialize().Value,
+				Key:   serializedKey,
 				Value: change.after.value,
 			})
 		}
@@ -864,7 +944,7 @@ func (t *trieView) GetValues(_ context.Context, keys [][]byte) ([][]byte, []erro
 	valueErrors := make([]error, len(keys))
 
 	for i, key := range keys {
-		results[i], valueErrors[i] = t.getValueCopy(newPath(key), false)
+		results[i], valueErrors[i] = t.getValueCopy(newPath(key, t.db.branchFactor), false)
 	}
 	return results, valueErrors
 }
@@ -872,7 +952,7 @@ func (t *trieView) GetValues(_ context.Context, keys [][]byte) ([][]byte, []erro
 // GetValue returns the value for the given [key].
 // Returns database.ErrNotFound if it doesn't exist.
 func (t *trieView) GetValue(_ context.Context, key []byte) ([]byte, error) {
-	return t.getValueCopy(newPath(key), true)
+	return t.getValueCopy(newPath(key, t.db.branchFactor), true)
 }
 
 // getValueCopy returns a copy of the value for the given [key].
@@ -941,7 +1021,7 @@ func (t *trieView) insert(key []byte, value []byte) error {
 
 	valCopy := slices.Clone(value)
 
-	if err := t.recordValueChange(newPath(key), Some(valCopy)); err != nil {
+	if err := t.recordValueChange(newPath(key, t.db.branchFactor), Some(valCopy)); err != nil {
 		return err
 	}
 
@@ -975,7 +1055,7 @@ func (t *trieView) remove(key []byte) error {
 	// the trie has been changed, so invalidate all children and remove them from tracking
 	t.invalidateChildren()
 
-	if err := t.recordValueChange(newPath(key), Nothing[[]byte]()); err != nil {
+	if err := t.recordValueChange(newPath(key, t.db.branchFactor), Nothing[[]byte]()); err != nil {
 		return err
 	}
 
@@ -1174,7 +1254,7 @@ func (t *trieView) insertIntoTrie(
 
 	// a node with that exact path already exists so update its value
 	if closestNode.key.Compare(key) == 0 {
-		closestNode.setValue(value)
+		closestNode.setValue(t.db.hasher, value)
 		return closestNode, nil
 	}
 
@@ -1192,7 +1272,7 @@ func (t *trieView) insertIntoTrie(
 			closestNode,
 			key,
 		)
-		newNode.setValue(value)
+		newNode.setValue(t.db.hasher, value)
 		return newNode, t.recordNodeChange(newNode)
 	} else if err != nil {
 		return nil, err
@@ -1215,14 +1295,14 @@ func (t *trieView) insertIntoTrie(
 
 	if len(key)-len(branchNode.key) == 0 {
 		// there was no residual path for the inserted key, so the value goes directly into the new branch node
-		branchNode.setValue(value)
+		branchNode.setValue(t.db.hasher, value)
 	} else {
 		// generate a new node and add it as a child of the branch node
 		newNode := newNode(
 			branchNode,
 			key,
 		)
-		newNode.setValue(value)
+		newNode.setValue(t.db.hasher, value)
 		if err := t.recordNodeChange(newNode); err != nil {
 			return nil, err
 		}
@@ -1347,7 +1427,7 @@ func (t *trieView) removeFromTrie(key path) error {
 		}
 	}
 
-	nodeToDelete.setValue(Nothing[[]byte]())
+	nodeToDelete.setValue(t.db.hasher, Nothing[[]byte]())
 	if err := t.recordNodeChange(nodeToDelete); err != nil {
 		return err
 	}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/math"
+	"github.com/VidarSolutions/avalanchego/utils/units"
 	"github.com/VidarSolutions/avalanchego/version"
 	"github.com/VidarSolutions/avalanchego/x/merkledb"
 )
@@ -22,6 +24,14 @@ const (
 	failedRequestSleepInterval = 10 * time.Millisecond
 
 	epsilon = 1e-6 // small amount to add to time to avoid division by 0
+
+	// The time within which a peer is expected to respond to a request.
+	// The bytes limit of a request is scaled to the bandwidth of the peer
+	// it's sent to so that slow peers are sent smaller requests.
+	targetResponseTime = time.Second
+	// The minimum limits of a request sent to a slow peer.
+	minBytesLimit = 16 * units.KiB
+	minKeyLimit   = 16
 )
 
 var (
@@ -29,6 +39,7 @@ var (
 
 	errInvalidRangeProof = errors.New("failed to verify range proof")
 	errTooManyLeaves     = errors.New("response contains more than requested leaves")
+	errNoPeers           = errors.New("no peers found")
 )
 
 // Client synchronously fetches data from the network to fulfill state sync requests.
@@ -50,6 +61,7 @@ type client struct {
 	stateSyncMinVersion *version.Application
 	log                 logging.Logger
 	metrics             SyncMetrics
+	rangeProofs         rangeProofParser
 }
 
 type ClientConfig struct {
@@ -58,39 +70,35 @@ type ClientConfig struct {
 	StateSyncMinVersion *version.Application
 	Log                 logging.Logger
 	Metrics             SyncMetrics
+	// The branch factor and hasher of the trie being synced. Range proofs
+	// are verified with them. If unset, merkledb's defaults are used.
+	// They must match those of the database being synced to.
+	BranchFactor merkledb.BranchFactor
+	Hasher       merkledb.Hasher
 }
 
-func NewClient(config *ClientConfig) Client {
+func NewClient(config *ClientConfig) (Client, error) {
+	rangeProofs, err := newRangeProofParser(config.BranchFactor, config.Hasher)
+	if err != nil {
+		return nil, err
+	}
 	c := &client{
 		networkClient:       config.NetworkClient,
 		stateSyncNodes:      config.StateSyncNodeIDs,
 		stateSyncMinVersion: config.StateSyncMinVersion,
 		log:                 config.Log,
 		metrics:             config.Metrics,
+		rangeProofs:         rangeProofs,
 	}
-	return c
+	return c, nil
 }
 
 // GetChangeProof synchronously retrieves the change proof given by [req].
 // Upon failure, retries until the context is expired.
 // The returned change proof is verified.
 func (c *client) GetChangeProof(ctx context.Context, req *ChangeProofRequest, db *merkledb.Database) (*merkledb.ChangeProof, error) {
-	parseFn := func(ctx context.Context, responseBytes []byte) (*merkledb.ChangeProof, error) {
-		changeProof := &merkledb.ChangeProof{}
-		if _, err := merkledb.Codec.DecodeChangeProof(responseBytes, changeProof); err != nil {
-			return nil, err
-		}
-
-		// Ensure the response does not contain more than the requested number of leaves
-		// and the start and end roots match the requested roots.
-		if len(changeProof.KeyValues)+len(changeProof.DeletedKeys) > int(req.Limit) {
-			return nil, fmt.Errorf("%w: (%d) > %d)", errTooManyLeaves, len(changeProof.KeyValues), req.Limit)
-		}
-
-		if err := changeProof.Verify(ctx, db, req.Start, req.End, req.EndingRoot); err != nil {
-			return nil, fmt.Errorf("%s due to %w", errInvalidRangeProof, err)
-		}
-		return changeProof, nil
+	parseFn := func(ctx context.Context, keyLimit uint16, responseBytes []byte) (*merkledb.ChangeProof, error) {
+		return parseChangeProof(ctx, db, req, keyLimit, responseBytes)
 	}
 	return getAndParse(ctx, c, req, parseFn)
 }
@@ -99,41 +107,106 @@ func (c *client) GetChangeProof(ctx context.Context, req *ChangeProofRequest, db
 // Upon failure, retries until the context is expired.
 // The returned range proof is verified.
 func (c *client) GetRangeProof(ctx context.Context, req *RangeProofRequest) (*merkledb.RangeProof, error) {
-	parseFn := func(ctx context.Context, responseBytes []byte) (*merkledb.RangeProof, error) {
-		rangeProof := &merkledb.RangeProof{}
-		if _, err := merkledb.Codec.DecodeRangeProof(responseBytes, rangeProof); err != nil {
-			return nil, err
-		}
+	parseFn := func(ctx context.Context, keyLimit uint16, responseBytes []byte) (*merkledb.RangeProof, error) {
+		return c.rangeProofs.parse(ctx, req, keyLimit, responseBytes)
+	}
+	return getAndParse(ctx, c, req, parseFn)
+}
 
-		// Ensure the response does not contain more than the maximum requested number of leaves.
-		if len(rangeProof.KeyValues) > int(req.Limit) {
-			return nil, fmt.Errorf("%w: (%d) > %d)", errTooManyLeaves, len(rangeProof.KeyValues), req.Limit)
-		}
+// Parses and verifies the change proof in [responseBytes], which was sent in
+// response to [req] with its key limit set to [keyLimit].
+func parseChangeProof(
+	ctx context.Context,
+	db *merkledb.Database,
+	req *ChangeProofRequest,
+	keyLimit uint16,
+	responseBytes []byte,
+) (*merkledb.ChangeProof, error) {
+	changeProof := &merkledb.ChangeProof{}
+	if _, err := db.Codec().DecodeChangeProof(responseBytes, changeProof); err != nil {
+		return nil, err
+	}
 
-		if err := rangeProof.Verify(
-			ctx,
-			req.Start,
-			req.End,
-			req.Root,
-		); err != nil {
-			return nil, fmt.Errorf("%s due to %w", errInvalidRangeProof, err)
-		}
-		return rangeProof, nil
+	// Ensure the response does not contain more than the requested number of leaves
+	// and the start and end roots match the requested roots.
+	if len(changeProof.KeyValues)+len(changeProof.DeletedKeys) > int(keyLimit) {
+		return nil, fmt.Errorf("%w: (%d) > %d)", errTooManyLeaves, len(changeProof.KeyValues), keyLimit)
 	}
-	return getAndParse(ctx, c, req, parseFn)
+
+	if err := changeProof.Verify(ctx, db, req.Start, req.End, req.EndingRoot); err != nil {
+		return nil, fmt.Errorf("%s due to %w", errInvalidRangeProof, err)
+	}
+	return changeProof, nil
+}
+
+// Parses and verifies range proofs of a trie with a given branch factor and
+// hasher.
+type rangeProofParser struct {
+	codec        merkledb.EncoderDecoder
+	branchFactor merkledb.BranchFactor
+	hasher       merkledb.Hasher
+}
+
+// Returns a parser for the range proofs of a trie with [branchFactor] and
+// [hasher]. If they're unset, merkledb's defaults are used.
+func newRangeProofParser(branchFactor merkledb.BranchFactor, hasher merkledb.Hasher) (rangeProofParser, error) {
+	if branchFactor == 0 {
+		branchFactor = merkledb.DefaultBranchFactor
+	}
+	if hasher == nil {
+		hasher = merkledb.DefaultHasher
+	}
+	codec, err := merkledb.NewCodec(branchFactor)
+	return rangeProofParser{
+		codec:        codec,
+		branchFactor: branchFactor,
+		hasher:       hasher,
+	}, err
+}
+
+// Parses and verifies the range proof in [responseBytes], which was sent in
+// response to [req] with its key limit set to [keyLimit].
+func (p rangeProofParser) parse(
+	ctx context.Context,
+	req *RangeProofRequest,
+	keyLimit uint16,
+	responseBytes []byte,
+) (*merkledb.RangeProof, error) {
+	rangeProof := &merkledb.RangeProof{}
+	if _, err := p.codec.DecodeRangeProof(responseBytes, rangeProof); err != nil {
+		return nil, err
+	}
+
+	// Ensure the response does not contain more than the maximum requested number of leaves.
+	if len(rangeProof.KeyValues) > int(keyLimit) {
+		return nil, fmt.Errorf("%w: (%d) > %d)", errTooManyLeaves, len(rangeProof.KeyValues), keyLimit)
+	}
+
+	if err := rangeProof.Verify(
+		ctx,
+		req.Start,
+		req.End,
+		req.Root,
+		p.branchFactor,
+		p.hasher,
+	); err != nil {
+		return nil, fmt.Errorf("%s due to %w", errInvalidRangeProof, err)
+	}
+	return rangeProof, nil
 }
 
 // getAndParse uses [client] to send [request] to an arbitrary peer. If the peer responds,
 // [parseFn] is called with the raw response. If [parseFn] returns an error or the request
 // times out, this function will retry the request to a different peer until [ctx] expires.
 // If [parseFn] returns a nil error, the result is returned from getAndParse.
-func getAndParse[T any](ctx context.Context, client *client, request Request, parseFn func(context.Context, []byte) (*T, error)) (*T, error) {
-	// marshal the request into requestBytes
-	requestBytes, err := syncCodec.Marshal(Version, &request)
-	if err != nil {
-		return nil, err
-	}
-
+// The limits of [request] are lowered for peers with low bandwidth, and [parseFn] is called
+// with the key limit of the request that was sent.
+func getAndParse[T any](
+	ctx context.Context,
+	client *client,
+	request Request,
+	parseFn func(context.Context, uint16, []byte) (*T, error),
+) (*T, error) {
 	var (
 		lastErr  error
 		response *T
@@ -147,10 +220,25 @@ func getAndParse[T any](ctx context.Context, client *client, request Request, pa
 			}
 			return nil, err
 		}
-		responseBytes, nodeID, err := client.get(ctx, requestBytes)
+
+		nodeID, err := client.getPeer()
 		if err == nil {
-			if response, err = parseFn(ctx, responseBytes); err == nil {
-				return response, nil
+			limitedRequest := client.limitRequest(nodeID, request)
+			keyLimit, _ := limitedRequest.limits()
+
+			var (
+				responseBytes []byte
+				bandwidth     float64
+			)
+			responseBytes, bandwidth, err = client.get(ctx, nodeID, limitedRequest)
+			if err == nil {
+				if response, err = parseFn(ctx, keyLimit, responseBytes); err == nil {
+					client.networkClient.TrackBandwidth(nodeID, bandwidth)
+					return response, nil
+				}
+				if err != ctx.Err() {
+					client.networkClient.TrackInvalidResponse(nodeID)
+				}
 			}
 		}
 
@@ -168,34 +256,67 @@ func getAndParse[T any](ctx context.Context, client *client, request Request, pa
 	}
 }
 
-// get sends [request] to an arbitrary peer and blocks until the node receives a response
-// or [ctx] expires. Returns the raw response from the peer, the peer's NodeID, and an
-// error if the request timed out. Thread safe.
-func (c *client) get(ctx context.Context, requestBytes []byte) ([]byte, ids.NodeID, error) {
-	c.metrics.RequestMade()
-	var (
-		response  []byte
-		nodeID    ids.NodeID
-		err       error
-		startTime = time.Now()
-	)
-	if len(c.stateSyncNodes) == 0 {
-		response, nodeID, err = c.networkClient.RequestAny(ctx, c.stateSyncMinVersion, requestBytes)
-	} else {
+// Returns the peer to send the next request to.
+func (c *client) getPeer() (ids.NodeID, error) {
+	if len(c.stateSyncNodes) != 0 {
 		// get the next nodeID using the nodeIdx offset. If we're out of nodes, loop back to 0
 		// we do this every attempt to ensure we get a different node each time if possible.
 		nodeIdx := atomic.AddUint32(&c.stateSyncNodeIdx, 1)
-		nodeID = c.stateSyncNodes[nodeIdx%uint32(len(c.stateSyncNodes))]
-		response, err = c.networkClient.Request(ctx, nodeID, requestBytes)
+		return c.stateSyncNodes[nodeIdx%uint32(len(c.stateSyncNodes))], nil
 	}
+
+	nodeID, ok := c.networkClient.GetPeer(c.stateSyncMinVersion)
+	if !ok {
+		return ids.EmptyNodeID, fmt.Errorf("%w matching version %s", errNoPeers, c.stateSyncMinVersion)
+	}
+	return nodeID, nil
+}
+
+// Returns a copy of [request] with its limits scaled to the bandwidth of
+// [nodeID], so that the peer is expected to respond within
+// [targetResponseTime]. The limits are never raised, and peers whose bandwidth
+// hasn't been measured are sent [request] unchanged.
+func (c *client) limitRequest(nodeID ids.NodeID, request Request) Request {
+	bandwidth := c.networkClient.PeerBandwidth(nodeID)
+	if bandwidth <= 0 {
+		return request
+	}
+
+	keyLimit, bytesLimit := request.limits()
+	peerBytesLimit := math.Max(
+		uint32(math.Min(bandwidth*targetResponseTime.Seconds(), float64(bytesLimit))),
+		minBytesLimit,
+	)
+	if peerBytesLimit >= bytesLimit {
+		return request
+	}
+
+	// Scale the key limit by the same factor as the bytes limit.
+	peerKeyLimit := uint64(keyLimit) * uint64(peerBytesLimit) / uint64(bytesLimit)
+	peerKeyLimit = math.Max(peerKeyLimit, minKeyLimit)
+	peerKeyLimit = math.Min(peerKeyLimit, uint64(keyLimit))
+	return request.withLimits(uint16(peerKeyLimit), peerBytesLimit)
+}
+
+// get sends [request] to [nodeID] and blocks until the node receives a response
+// or [ctx] expires. Returns the raw response from the peer, the bandwidth of the
+// response in bytes per second, and an error if the request timed out. Thread safe.
+func (c *client) get(ctx context.Context, nodeID ids.NodeID, request Request) ([]byte, float64, error) {
+	requestBytes, err := marshalRequest(request, c.networkClient.PeerVersion(nodeID))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.metrics.RequestMade()
+	startTime := time.Now()
+	response, err := c.networkClient.Request(ctx, nodeID, requestBytes)
 	if err != nil {
 		c.metrics.RequestFailed()
 		c.networkClient.TrackBandwidth(nodeID, 0)
-		return response, nodeID, err
+		return response, 0, err
 	}
 
 	bandwidth := float64(len(response)) / (time.Since(startTime).Seconds() + epsilon)
-	c.networkClient.TrackBandwidth(nodeID, bandwidth)
 	c.metrics.RequestSucceeded()
-	return response, nodeID, nil
+	return response, bandwidth, nil
 }
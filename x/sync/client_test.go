@@ -14,10 +14,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/VidarSolutions/avalanchego/codec"
+	"github.com/VidarSolutions/avalanchego/codec/linearcodec"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/snow/engine/common"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
 	"github.com/VidarSolutions/avalanchego/utils/set"
+	"github.com/VidarSolutions/avalanchego/utils/units"
 	"github.com/VidarSolutions/avalanchego/version"
 	"github.com/VidarSolutions/avalanchego/x/merkledb"
 )
@@ -42,13 +45,14 @@ func sendRequest(
 	handler := NewNetworkServer(sender, db, logging.NoLog{})
 	clientNodeID, serverNodeID := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
 	networkClient := NewNetworkClient(sender, clientNodeID, 1, logging.NoLog{})
-	err := networkClient.Connected(context.Background(), serverNodeID, version.CurrentApp)
+	err := networkClient.Connected(context.Background(), serverNodeID, minRequestVersionApp)
 	require.NoError(err)
-	client := NewClient(&ClientConfig{
+	client, err := NewClient(&ClientConfig{
 		NetworkClient: networkClient,
 		Metrics:       &mockMetrics{},
 		Log:           logging.NoLog{},
 	})
+	require.NoError(err)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	deadline := time.Now().Add(1 * time.Hour) // enough time to complete a request
@@ -132,16 +136,18 @@ func TestGetRangeProof(t *testing.T) {
 		"full response for small (single request) trie": {
 			db: smallTrieDB,
 			request: &RangeProofRequest{
-				Root:  smallTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       smallTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			expectedResponseLen: defaultLeafRequestLimit,
 		},
 		"too many leaves in response": {
 			db: smallTrieDB,
 			request: &RangeProofRequest{
-				Root:  smallTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       smallTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				response.KeyValues = append(response.KeyValues, merkledb.KeyValue{})
@@ -151,35 +157,39 @@ func TestGetRangeProof(t *testing.T) {
 		"partial response to request for entire trie (full leaf limit)": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			expectedResponseLen: defaultLeafRequestLimit,
 		},
 		"full response from near end of trie to end of trie (less than leaf limit)": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Start: largeTrieKeys[len(largeTrieKeys)-30], // Set start 30 keys from the end of the large trie
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				Start:      largeTrieKeys[len(largeTrieKeys)-30], // Set start 30 keys from the end of the large trie
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			expectedResponseLen: 30,
 		},
 		"full response for intermediate range of trie (less than leaf limit)": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Start: largeTrieKeys[1000], // Set the range for 1000 leafs in an intermediate range of the trie
-				End:   largeTrieKeys[1099], // (inclusive range)
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				Start:      largeTrieKeys[1000], // Set the range for 1000 leafs in an intermediate range of the trie
+				End:        largeTrieKeys[1099], // (inclusive range)
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			expectedResponseLen: 100,
 		},
 		"removed first key in response": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				response.KeyValues = response.KeyValues[1:]
@@ -189,8 +199,9 @@ func TestGetRangeProof(t *testing.T) {
 		"removed first key in response and replaced proof": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				start := response.KeyValues[1].Key
@@ -207,8 +218,9 @@ func TestGetRangeProof(t *testing.T) {
 		"removed last key in response": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				response.KeyValues = response.KeyValues[:len(response.KeyValues)-2]
@@ -218,8 +230,9 @@ func TestGetRangeProof(t *testing.T) {
 		"removed key from middle of response": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				response.KeyValues = append(response.KeyValues[:100], response.KeyValues[101:]...)
@@ -229,8 +242,9 @@ func TestGetRangeProof(t *testing.T) {
 		"all proof keys removed from response": {
 			db: largeTrieDB,
 			request: &RangeProofRequest{
-				Root:  largeTrieRoot,
-				Limit: defaultLeafRequestLimit,
+				Root:       largeTrieRoot,
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
 			},
 			modifyResponse: func(response *merkledb.RangeProof) {
 				response.StartProof = nil
@@ -264,10 +278,13 @@ func TestRetries(t *testing.T) {
 	root, err := db.GetMerkleRoot(context.Background())
 	require.NoError(err)
 
-	maxRequests := 4
+	// The peer is dropped after [maxConsecutiveInvalidResponses] invalid
+	// responses, so the last attempt must be before that.
+	maxRequests := maxConsecutiveInvalidResponses
 	request := &RangeProofRequest{
-		Root:  root,
-		Limit: uint16(keyCount),
+		Root:       root,
+		KeyLimit:   uint16(keyCount),
+		BytesLimit: defaultRequestByteSizeLimit,
 	}
 
 	responseCount := 0
@@ -284,3 +301,192 @@ func TestRetries(t *testing.T) {
 
 	require.Equal(responseCount, maxRequests) // check the client performed retries.
 }
+
+func TestGetRangeProofBytesLimit(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+	require := require.New(t)
+
+	db, _, err := generateTrieWithMinKeyLen(t, r, 10_000, 1)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	bytesLimit := uint32(16 * units.KiB)
+	request := &RangeProofRequest{
+		Root:       root,
+		KeyLimit:   defaultLeafRequestLimit,
+		BytesLimit: bytesLimit,
+	}
+	proof, err := sendRequest(t, db, request, 1, nil)
+	require.NoError(err)
+	require.NotEmpty(proof.KeyValues)
+	require.Less(len(proof.KeyValues), defaultLeafRequestLimit)
+
+	proofBytes, err := merkledb.Codec.EncodeRangeProof(merkledb.Version, proof)
+	require.NoError(err)
+	require.LessOrEqual(len(proofBytes), int(bytesLimit))
+}
+
+// A client must be able to sync from servers that only decode requests
+// encoded with [Version].
+func TestGetRangeProofLegacyServer(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+	require := require.New(t)
+
+	db, _, err := generateTrieWithMinKeyLen(t, r, 2*defaultLeafRequestLimit, 1)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	// The codec of a server that hasn't upgraded.
+	legacyCodec := codec.NewManager(maxMessageSize)
+	c := linearcodec.NewDefault()
+	require.NoError(c.RegisterType(&legacyChangeProofRequest{}))
+	require.NoError(c.RegisterType(&legacyRangeProofRequest{}))
+	require.NoError(legacyCodec.RegisterCodec(Version, c))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sender := common.NewMockSender(ctrl)
+	clientNodeID, serverNodeID := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	networkClient := NewNetworkClient(sender, clientNodeID, 1, logging.NoLog{})
+	require.NoError(networkClient.Connected(context.Background(), serverNodeID, version.CurrentApp))
+	client, err := NewClient(&ClientConfig{
+		NetworkClient: networkClient,
+		Metrics:       &mockMetrics{},
+		Log:           logging.NoLog{},
+	})
+	require.NoError(err)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	sender.EXPECT().SendAppRequest(
+		gomock.Any(), // ctx
+		gomock.Any(), // nodeIDs
+		gomock.Any(), // requestID
+		gomock.Any(), // requestBytes
+	).DoAndReturn(
+		func(_ context.Context, _ set.Set[ids.NodeID], requestID uint32, requestBytes []byte) error {
+			var request Request
+			if _, err := legacyCodec.Unmarshal(requestBytes, &request); err != nil {
+				return err
+			}
+			legacyRequest, ok := request.(*legacyRangeProofRequest)
+			require.True(ok)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				proof, err := db.GetRangeProofAtRoot(context.Background(), legacyRequest.Root, legacyRequest.Start, legacyRequest.End, int(legacyRequest.Limit))
+				require.NoError(err)
+				proofBytes, err := merkledb.Codec.EncodeRangeProof(merkledb.Version, proof)
+				require.NoError(err)
+				require.NoError(networkClient.AppResponse(context.Background(), serverNodeID, requestID, proofBytes))
+			}()
+			return nil
+		},
+	)
+
+	proof, err := client.GetRangeProof(context.Background(), &RangeProofRequest{
+		Root:       root,
+		KeyLimit:   defaultLeafRequestLimit,
+		BytesLimit: defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+	require.Len(proof.KeyValues, defaultLeafRequestLimit)
+}
+
+func TestInvalidResponsesDropPeer(t *testing.T) {
+	require := require.New(t)
+
+	networkClient := NewNetworkClient(nil, ids.GenerateTestNodeID(), 1, logging.NoLog{})
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(networkClient.Connected(context.Background(), nodeID, version.CurrentApp))
+
+	for i := 0; i < maxConsecutiveInvalidResponses-1; i++ {
+		networkClient.TrackInvalidResponse(nodeID)
+		_, ok := networkClient.GetPeer(version.CurrentApp)
+		require.True(ok)
+	}
+
+	// A valid response resets the count of invalid responses.
+	networkClient.TrackBandwidth(nodeID, 1)
+	for i := 0; i < maxConsecutiveInvalidResponses-1; i++ {
+		networkClient.TrackInvalidResponse(nodeID)
+	}
+	peerID, ok := networkClient.GetPeer(version.CurrentApp)
+	require.True(ok)
+	require.Equal(nodeID, peerID)
+
+	networkClient.TrackInvalidResponse(nodeID)
+	_, ok = networkClient.GetPeer(version.CurrentApp)
+	require.False(ok)
+
+	// Reconnecting allows the peer to be sent requests again.
+	require.NoError(networkClient.Disconnected(context.Background(), nodeID))
+	require.NoError(networkClient.Connected(context.Background(), nodeID, version.CurrentApp))
+	peerID, ok = networkClient.GetPeer(version.CurrentApp)
+	require.True(ok)
+	require.Equal(nodeID, peerID)
+}
+
+func TestLimitRequest(t *testing.T) {
+	request := &RangeProofRequest{
+		KeyLimit:   defaultLeafRequestLimit,
+		BytesLimit: defaultRequestByteSizeLimit,
+	}
+
+	tests := []struct {
+		name               string
+		bandwidth          float64
+		expectedKeyLimit   uint16
+		expectedBytesLimit uint32
+	}{
+		{
+			name:               "unmeasured peer",
+			expectedKeyLimit:   defaultLeafRequestLimit,
+			expectedBytesLimit: defaultRequestByteSizeLimit,
+		},
+		{
+			name:               "fast peer",
+			bandwidth:          10 * defaultRequestByteSizeLimit,
+			expectedKeyLimit:   defaultLeafRequestLimit,
+			expectedBytesLimit: defaultRequestByteSizeLimit,
+		},
+		{
+			name:               "slow peer",
+			bandwidth:          defaultRequestByteSizeLimit / 4,
+			expectedKeyLimit:   defaultLeafRequestLimit / 4,
+			expectedBytesLimit: defaultRequestByteSizeLimit / 4,
+		},
+		{
+			name:               "very slow peer",
+			bandwidth:          1,
+			expectedKeyLimit:   minKeyLimit,
+			expectedBytesLimit: minBytesLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			networkClient := NewNetworkClient(nil, ids.GenerateTestNodeID(), 1, logging.NoLog{})
+			nodeID := ids.GenerateTestNodeID()
+			require.NoError(networkClient.Connected(context.Background(), nodeID, version.CurrentApp))
+			if tt.bandwidth > 0 {
+				networkClient.TrackBandwidth(nodeID, tt.bandwidth)
+			}
+			c, err := NewClient(&ClientConfig{
+				NetworkClient: networkClient,
+				Metrics:       &mockMetrics{},
+				Log:           logging.NoLog{},
+			})
+			require.NoError(err)
+
+			keyLimit, bytesLimit := c.(*client).limitRequest(nodeID, request).limits()
+			require.Equal(tt.expectedKeyLimit, keyLimit)
+			require.Equal(tt.expectedBytesLimit, bytesLimit)
+		})
+	}
+}
@@ -8,27 +8,61 @@ import (
 	"github.com/VidarSolutions/avalanchego/codec/linearcodec"
 	"github.com/VidarSolutions/avalanchego/utils/units"
 	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+	"github.com/VidarSolutions/avalanchego/version"
 )
 
 const (
 	Version        = 0 // TODO danlaine unexport this
 	maxMessageSize = 1 * units.MiB
+
+	// Requests encoded with [Version] only limit the number of keys in the
+	// requested proof. Requests encoded with [requestVersion] also limit the
+	// size of the requested proof.
+	requestVersion = 1
 )
 
-var syncCodec codec.Manager
+var (
+	syncCodec codec.Manager
+
+	// minRequestVersionApp is the first node version that decodes requests
+	// encoded with [requestVersion]. Older peers are sent requests encoded
+	// with [Version].
+	minRequestVersionApp = &version.Application{
+		Major: 1,
+		Minor: 9,
+		Patch: 17,
+	}
+)
 
 func init() {
 	syncCodec = codec.NewManager(maxMessageSize)
+	legacyCodec := linearcodec.NewDefault()
 	c := linearcodec.NewDefault()
 
 	errs := wrappers.Errs{}
 	errs.Add(
+		legacyCodec.RegisterType(&legacyChangeProofRequest{}),
+		legacyCodec.RegisterType(&legacyRangeProofRequest{}),
+		syncCodec.RegisterCodec(Version, legacyCodec),
+
 		c.RegisterType(&ChangeProofRequest{}),
 		c.RegisterType(&RangeProofRequest{}),
-		syncCodec.RegisterCodec(Version, c),
+		syncCodec.RegisterCodec(requestVersion, c),
 	)
 
 	if errs.Errored() {
 		panic(errs.Err)
 	}
 }
+
+// marshalRequest encodes [request] for a peer running [peerVersion]. If the
+// peer can't decode requests encoded with [requestVersion], or its version
+// isn't known, the request is encoded with [Version] and its bytes limit is
+// dropped.
+func marshalRequest(request Request, peerVersion *version.Application) ([]byte, error) {
+	if peerVersion != nil && peerVersion.Compare(minRequestVersionApp) >= 0 {
+		return syncCodec.Marshal(requestVersion, &request)
+	}
+	request = request.legacy()
+	return syncCodec.Marshal(Version, &request)
+}
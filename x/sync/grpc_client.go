@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/x/merkledb"
+
+	syncpb "github.com/VidarSolutions/avalanchego/proto/pb/sync"
+)
+
+var _ Client = (*grpcClient)(nil)
+
+type GRPCClientConfig struct {
+	Log     logging.Logger
+	Metrics SyncMetrics
+	// The branch factor and hasher of the trie being synced. Range proofs
+	// are verified with them. If unset, merkledb's defaults are used.
+	// They must match those of the database being synced to.
+	BranchFactor merkledb.BranchFactor
+	Hasher       merkledb.Hasher
+}
+
+// grpcClient fetches proofs from a [GRPCServer] rather than from peers.
+type grpcClient struct {
+	client      syncpb.SyncClient
+	log         logging.Logger
+	metrics     SyncMetrics
+	rangeProofs rangeProofParser
+}
+
+// NewGRPCClient returns a Client that sends requests to the [GRPCServer] that
+// [client] is connected to. As with the client returned by [NewClient], the
+// responses are verified and failed requests are retried until the context
+// is canceled.
+func NewGRPCClient(client syncpb.SyncClient, config GRPCClientConfig) (Client, error) {
+	rangeProofs, err := newRangeProofParser(config.BranchFactor, config.Hasher)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcClient{
+		client:      client,
+		log:         config.Log,
+		metrics:     config.Metrics,
+		rangeProofs: rangeProofs,
+	}, nil
+}
+
+func (c *grpcClient) GetChangeProof(ctx context.Context, req *ChangeProofRequest, db *merkledb.Database) (*merkledb.ChangeProof, error) {
+	return retryGRPC(ctx, c, req, func(ctx context.Context) (*merkledb.ChangeProof, error) {
+		resp, err := c.client.GetChangeProof(ctx, &syncpb.GetChangeProofRequest{
+			StartRootHash: req.StartingRoot[:],
+			EndRootHash:   req.EndingRoot[:],
+			StartKey:      req.Start,
+			EndKey:        req.End,
+			KeyLimit:      uint32(req.KeyLimit),
+			BytesLimit:    req.BytesLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parseChangeProof(ctx, db, req, req.KeyLimit, resp.Proof)
+	})
+}
+
+func (c *grpcClient) GetRangeProof(ctx context.Context, req *RangeProofRequest) (*merkledb.RangeProof, error) {
+	return retryGRPC(ctx, c, req, func(ctx context.Context) (*merkledb.RangeProof, error) {
+		resp, err := c.client.GetRangeProof(ctx, &syncpb.GetRangeProofRequest{
+			RootHash:   req.Root[:],
+			StartKey:   req.Start,
+			EndKey:     req.End,
+			KeyLimit:   uint32(req.KeyLimit),
+			BytesLimit: req.BytesLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return c.rangeProofs.parse(ctx, req, req.KeyLimit, resp.Proof)
+	})
+}
+
+// retryGRPC calls [getFn] until it returns a nil error or [ctx] expires.
+// Requests that the server rejected as invalid aren't retried.
+func retryGRPC[T any](
+	ctx context.Context,
+	client *grpcClient,
+	request Request,
+	getFn func(context.Context) (*T, error),
+) (*T, error) {
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		// If the context has finished, return the context error early.
+		if err := ctx.Err(); err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("request failed after %d attempts with last error %w and ctx error %s", attempt, lastErr, err)
+			}
+			return nil, err
+		}
+
+		client.metrics.RequestMade()
+		response, err := getFn(ctx)
+		if err == nil {
+			client.metrics.RequestSucceeded()
+			return response, nil
+		}
+		client.metrics.RequestFailed()
+
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+
+		client.log.Debug("request failed, retrying",
+			zap.Int("attempt", attempt),
+			zap.Stringer("request", request),
+			zap.Error(err))
+
+		if err != ctx.Err() {
+			// if [err] is being propagated from [ctx], avoid overwriting [lastErr].
+			lastErr = err
+			time.Sleep(failedRequestSleepInterval)
+		}
+	}
+}
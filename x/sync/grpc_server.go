@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/time/rate"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/math"
+	"github.com/VidarSolutions/avalanchego/x/merkledb"
+
+	syncpb "github.com/VidarSolutions/avalanchego/proto/pb/sync"
+)
+
+var (
+	_ syncpb.SyncServer = (*GRPCServer)(nil)
+
+	errInvalidMaxResponseSize = errors.New("max response size must be positive")
+)
+
+type GRPCServerConfig struct {
+	// The maximum size, in bytes, of a proof to return.
+	// This overrides the BytesLimit of a request if it's greater.
+	MaxResponseSize int
+	// The number of requests per second that are served.
+	// Requests beyond this rate fail with codes.ResourceExhausted.
+	// If 0, the rate isn't limited.
+	RequestsPerSecond float64
+	// The number of requests that can be served at once before the rate
+	// limit applies. Ignored if [RequestsPerSecond] is 0.
+	RequestBurst int
+}
+
+// GRPCServer serves the proofs that [NetworkServer] serves to peers over
+// gRPC, so that a database can be synced without joining the network.
+type GRPCServer struct {
+	syncpb.UnsafeSyncServer
+
+	db              *merkledb.Database
+	log             logging.Logger
+	maxResponseSize int
+	// Nil if the rate isn't limited.
+	limiter *rate.Limiter
+}
+
+func NewGRPCServer(db *merkledb.Database, log logging.Logger, config GRPCServerConfig) (*GRPCServer, error) {
+	if config.MaxResponseSize <= 0 {
+		return nil, errInvalidMaxResponseSize
+	}
+
+	s := &GRPCServer{
+		db:              db,
+		log:             log,
+		maxResponseSize: config.MaxResponseSize,
+	}
+	if config.RequestsPerSecond > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), config.RequestBurst)
+	}
+	return s, nil
+}
+
+func (s *GRPCServer) GetChangeProof(
+	ctx context.Context,
+	req *syncpb.GetChangeProofRequest,
+) (*syncpb.GetChangeProofResponse, error) {
+	if err := s.allow(); err != nil {
+		return nil, err
+	}
+
+	startRoot, err := ids.ToID(req.StartRootHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	endRoot, err := ids.ToID(req.EndRootHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	changeProofReq := &ChangeProofRequest{
+		StartingRoot: startRoot,
+		EndingRoot:   endRoot,
+		Start:        req.StartKey,
+		End:          req.EndKey,
+		KeyLimit:     uint16(math.Min(req.KeyLimit, maxKeyValuesLimit)),
+		BytesLimit:   req.BytesLimit,
+	}
+	if !isValidChangeProofRequest(changeProofReq) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid change proof request %s", changeProofReq)
+	}
+
+	keyLimit, bytesLimit := getLimits(changeProofReq, s.maxResponseSize)
+	proofBytes, err := getChangeProof(ctx, s.db, changeProofReq, keyLimit, bytesLimit)
+	if err != nil {
+		if errors.Is(err, merkledb.ErrRootIDNotPresent) || errors.Is(err, merkledb.ErrStartRootNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.log.Warn(
+			"unexpected error generating change proof",
+			zap.Stringer("req", changeProofReq),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return &syncpb.GetChangeProofResponse{
+		Proof: proofBytes,
+	}, nil
+}
+
+func (s *GRPCServer) GetRangeProof(
+	ctx context.Context,
+	req *syncpb.GetRangeProofRequest,
+) (*syncpb.GetRangeProofResponse, error) {
+	if err := s.allow(); err != nil {
+		return nil, err
+	}
+
+	root, err := ids.ToID(req.RootHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rangeProofReq := &RangeProofRequest{
+		Root:       root,
+		Start:      req.StartKey,
+		End:        req.EndKey,
+		KeyLimit:   uint16(math.Min(req.KeyLimit, maxKeyValuesLimit)),
+		BytesLimit: req.BytesLimit,
+	}
+	if !isValidRangeProofRequest(rangeProofReq) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid range proof request %s", rangeProofReq)
+	}
+
+	keyLimit, bytesLimit := getLimits(rangeProofReq, s.maxResponseSize)
+	proofBytes, err := getRangeProof(ctx, s.db, rangeProofReq, keyLimit, bytesLimit)
+	if err != nil {
+		if errors.Is(err, merkledb.ErrRootIDNotPresent) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.log.Warn(
+			"unexpected error generating range proof",
+			zap.Stringer("req", rangeProofReq),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return &syncpb.GetRangeProofResponse{
+		Proof: proofBytes,
+	}, nil
+}
+
+// Returns an error if serving another request would exceed the rate limit.
+func (s *GRPCServer) allow() error {
+	if s.limiter == nil || s.limiter.Allow() {
+		return nil
+	}
+	return status.Error(codes.ResourceExhausted, "request rate limit exceeded")
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/units"
+	"github.com/VidarSolutions/avalanchego/vms/rpcchainvm/grpcutils"
+	"github.com/VidarSolutions/avalanchego/x/merkledb"
+
+	syncpb "github.com/VidarSolutions/avalanchego/proto/pb/sync"
+)
+
+// Serves [db] over gRPC and returns a client connected to it.
+func newGRPCSyncClient(t *testing.T, db *merkledb.Database, config GRPCServerConfig) syncpb.SyncClient {
+	require := require.New(t)
+
+	listener, err := grpcutils.NewListener()
+	require.NoError(err)
+
+	syncServer, err := NewGRPCServer(db, logging.NoLog{}, config)
+	require.NoError(err)
+	server := grpcutils.NewServer()
+	syncpb.RegisterSyncServer(server, syncServer)
+	go grpcutils.Serve(listener, server)
+
+	conn, err := grpcutils.Dial(listener.Addr().String())
+	require.NoError(err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		_ = listener.Close()
+	})
+	return syncpb.NewSyncClient(conn)
+}
+
+func TestGRPCSync(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+
+	dbToSync, err := generateTrie(t, r, 5000)
+	require.NoError(err)
+	syncRoot, err := dbToSync.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	syncClient := newGRPCSyncClient(t, dbToSync, GRPCServerConfig{
+		MaxResponseSize: 64 * units.KiB,
+	})
+
+	db, err := merkledb.New(
+		context.Background(),
+		memdb.New(),
+		merkledb.Config{
+			Tracer:        newNoopTracer(),
+			HistoryLength: 0,
+			NodeCacheSize: 1000,
+		},
+	)
+	require.NoError(err)
+	client, err := NewGRPCClient(syncClient, GRPCClientConfig{
+		Log:     logging.NoLog{},
+		Metrics: &mockMetrics{},
+	})
+	require.NoError(err)
+	syncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                client,
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+	})
+	require.NoError(err)
+	require.NoError(syncer.StartSyncing(context.Background()))
+	require.NoError(syncer.Wait(context.Background()))
+
+	newRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(syncRoot, newRoot)
+}
+
+func TestGRPCSyncBranchFactorsAndHashers(t *testing.T) {
+	branchFactors := []merkledb.BranchFactor{
+		merkledb.BranchFactor2,
+		merkledb.BranchFactor4,
+		merkledb.BranchFactor16,
+		merkledb.BranchFactor256,
+	}
+	hashers := map[string]merkledb.Hasher{
+		"sha256":    merkledb.SHA256Hasher,
+		"keccak256": merkledb.Keccak256Hasher,
+	}
+	for _, bf := range branchFactors {
+		for hasherName, hasher := range hashers {
+			bf, hasher := bf, hasher
+			t.Run(fmt.Sprintf("branch factor %d %s", bf, hasherName), func(t *testing.T) {
+				require := require.New(t)
+				r := rand.New(rand.NewSource(int64(bf))) // #nosec G404
+
+				config := merkledb.Config{
+					Tracer:        newNoopTracer(),
+					HistoryLength: 1000,
+					NodeCacheSize: 1000,
+					BranchFactor:  bf,
+					Hasher:        hasher,
+				}
+				dbToSync, err := merkledb.New(context.Background(), memdb.New(), config)
+				require.NoError(err)
+				batch := dbToSync.NewBatch()
+				for i := 0; i < 1000; i++ {
+					key := make([]byte, r.Intn(50))
+					_, _ = r.Read(key)
+					require.NoError(batch.Put(key, key))
+				}
+				require.NoError(batch.Write())
+				syncRoot, err := dbToSync.GetMerkleRoot(context.Background())
+				require.NoError(err)
+
+				syncClient := newGRPCSyncClient(t, dbToSync, GRPCServerConfig{
+					MaxResponseSize: 16 * units.KiB,
+				})
+
+				db, err := merkledb.New(context.Background(), memdb.New(), config)
+				require.NoError(err)
+				client, err := NewGRPCClient(syncClient, GRPCClientConfig{
+					Log:          logging.NoLog{},
+					Metrics:      &mockMetrics{},
+					BranchFactor: bf,
+					Hasher:       hasher,
+				})
+				require.NoError(err)
+				syncer, err := NewStateSyncManager(StateSyncConfig{
+					SyncDB:                db,
+					Client:                client,
+					TargetRoot:            syncRoot,
+					SimultaneousWorkLimit: 5,
+					Log:                   logging.NoLog{},
+				})
+				require.NoError(err)
+				require.NoError(syncer.StartSyncing(context.Background()))
+				require.NoError(syncer.Wait(context.Background()))
+
+				newRoot, err := db.GetMerkleRoot(context.Background())
+				require.NoError(err)
+				require.Equal(syncRoot, newRoot)
+			})
+		}
+	}
+}
+
+func TestGRPCServerMaxResponseSize(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+
+	db, err := generateTrie(t, r, 5000)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	maxResponseSize := 16 * units.KiB
+	syncClient := newGRPCSyncClient(t, db, GRPCServerConfig{
+		MaxResponseSize: maxResponseSize,
+	})
+
+	resp, err := syncClient.GetRangeProof(context.Background(), &syncpb.GetRangeProofRequest{
+		RootHash:   root[:],
+		KeyLimit:   defaultLeafRequestLimit,
+		BytesLimit: defaultRequestByteSizeLimit,
+	})
+	require.NoError(err)
+	require.LessOrEqual(len(resp.Proof), maxResponseSize)
+
+	proof := &merkledb.RangeProof{}
+	_, err = merkledb.Codec.DecodeRangeProof(resp.Proof, proof)
+	require.NoError(err)
+	require.NotEmpty(proof.KeyValues)
+	require.Less(len(proof.KeyValues), defaultLeafRequestLimit)
+}
+
+func TestGRPCServerRateLimit(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+
+	db, err := generateTrie(t, r, 100)
+	require.NoError(err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	syncClient := newGRPCSyncClient(t, db, GRPCServerConfig{
+		MaxResponseSize:   defaultRequestByteSizeLimit,
+		RequestsPerSecond: 0.001,
+		RequestBurst:      2,
+	})
+
+	req := &syncpb.GetRangeProofRequest{
+		RootHash:   root[:],
+		KeyLimit:   defaultLeafRequestLimit,
+		BytesLimit: defaultRequestByteSizeLimit,
+	}
+	for i := 0; i < 2; i++ {
+		_, err := syncClient.GetRangeProof(context.Background(), req)
+		require.NoError(err)
+	}
+	_, err = syncClient.GetRangeProof(context.Background(), req)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGRPCServerInvalidRequests(t *testing.T) {
+	r := rand.New(rand.NewSource(1)) // #nosec G404
+
+	db, err := generateTrie(t, r, 100)
+	require.NoError(t, err)
+	root, err := db.GetMerkleRoot(context.Background())
+	require.NoError(t, err)
+
+	syncClient := newGRPCSyncClient(t, db, GRPCServerConfig{
+		MaxResponseSize: defaultRequestByteSizeLimit,
+	})
+
+	unknownRoot := ids.GenerateTestID()
+	tests := []struct {
+		name         string
+		req          *syncpb.GetRangeProofRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "invalid root",
+			req: &syncpb.GetRangeProofRequest{
+				RootHash:   []byte{1},
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "no key limit",
+			req: &syncpb.GetRangeProofRequest{
+				RootHash:   root[:],
+				BytesLimit: defaultRequestByteSizeLimit,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "start after end",
+			req: &syncpb.GetRangeProofRequest{
+				RootHash:   root[:],
+				StartKey:   []byte{2},
+				EndKey:     []byte{1},
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown root",
+			req: &syncpb.GetRangeProofRequest{
+				RootHash:   unknownRoot[:],
+				KeyLimit:   defaultLeafRequestLimit,
+				BytesLimit: defaultRequestByteSizeLimit,
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := syncClient.GetRangeProof(context.Background(), tt.req)
+			require.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -43,10 +43,28 @@ type NetworkClient interface {
 	// Returns response bytes, and ErrRequestFailed if the request should be retried.
 	Request(ctx context.Context, nodeID ids.NodeID, request []byte) ([]byte, error)
 
+	// GetPeer returns the peer that RequestAny would send a request to.
+	// Returns false if there are no peers with a node version greater than or
+	// equal to minVersion.
+	GetPeer(minVersion *version.Application) (ids.NodeID, bool)
+
+	// PeerBandwidth returns the average bandwidth of nodeID in bytes per
+	// second, or 0 if it hasn't been measured.
+	PeerBandwidth(nodeID ids.NodeID) float64
+
+	// PeerVersion returns the node version of nodeID, or nil if nodeID isn't
+	// connected.
+	PeerVersion(nodeID ids.NodeID) *version.Application
+
 	// TrackBandwidth should be called for each valid response with the bandwidth
-	// (length of response divided by request time), and with 0 if the response is invalid.
+	// (length of response divided by request time), and with 0 if the request failed.
 	TrackBandwidth(nodeID ids.NodeID, bandwidth float64)
 
+	// TrackInvalidResponse should be called for each invalid response.
+	// Peers that keep sending invalid responses are no longer returned by
+	// GetPeer or sent requests by RequestAny until they reconnect.
+	TrackInvalidResponse(nodeID ids.NodeID)
+
 	// The following declarations allow this interface to be embedded in the VM
 	// to handle incoming responses from peers.
 	AppResponse(context.Context, ids.NodeID, uint32, []byte) error
@@ -96,7 +114,7 @@ func (c *networkClient) AppResponse(_ context.Context, nodeID ids.NodeID, reques
 		zap.Int("responseLen", len(response)),
 	)
 
-	handler, exists := c.getRequestHandler(requestID)
+	handler, exists := c.getRequestHandler(nodeID, requestID)
 	if !exists {
 		// Should never happen since the engine should be managing outstanding requests
 		c.log.Error(
@@ -127,7 +145,7 @@ func (c *networkClient) AppRequestFailed(_ context.Context, nodeID ids.NodeID, r
 		zap.Uint32("requestID", requestID),
 	)
 
-	handler, exists := c.getRequestHandler(requestID)
+	handler, exists := c.getRequestHandler(nodeID, requestID)
 	if !exists {
 		// Should never happen since the engine should be managing outstanding requests
 		c.log.Error(
@@ -141,10 +159,10 @@ func (c *networkClient) AppRequestFailed(_ context.Context, nodeID ids.NodeID, r
 	return nil
 }
 
-// Returns the handler for [requestID] and marks the request as fulfilled.
+// Returns the handler for [requestID] and marks the request to [nodeID] as fulfilled.
 // This is called by either [AppResponse] or [AppRequestFailed].
 // Assumes [c.lock] is held.
-func (c *networkClient) getRequestHandler(requestID uint32) (ResponseHandler, bool) {
+func (c *networkClient) getRequestHandler(nodeID ids.NodeID, requestID uint32) (ResponseHandler, bool) {
 	handler, exists := c.outstandingRequestHandlers[requestID]
 	if !exists {
 		return nil, false
@@ -152,6 +170,7 @@ func (c *networkClient) getRequestHandler(requestID uint32) (ResponseHandler, bo
 	// mark message as processed, release activeRequests slot
 	delete(c.outstandingRequestHandlers, requestID)
 	c.activeRequests.Release(1)
+	c.peers.TrackRequestDone(nodeID)
 	return handler, true
 }
 
@@ -226,6 +245,7 @@ func (c *networkClient) request(ctx context.Context, nodeID ids.NodeID, request
 		// On failure, release the activeRequests slot and mark the message as processed.
 		c.activeRequests.Release(1)
 		delete(c.outstandingRequestHandlers, requestID)
+		c.peers.TrackRequestDone(nodeID)
 		c.lock.Unlock()
 		return nil, err
 	}
@@ -286,9 +306,37 @@ func (c *networkClient) Shutdown() {
 	c.peers = newPeerTracker(c.log)
 }
 
+func (c *networkClient) GetPeer(minVersion *version.Application) (ids.NodeID, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.peers.GetAnyPeer(minVersion)
+}
+
+func (c *networkClient) PeerBandwidth(nodeID ids.NodeID) float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.peers.Bandwidth(nodeID)
+}
+
+func (c *networkClient) PeerVersion(nodeID ids.NodeID) *version.Application {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.peers.Version(nodeID)
+}
+
 func (c *networkClient) TrackBandwidth(nodeID ids.NodeID, bandwidth float64) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	c.peers.TrackBandwidth(nodeID, bandwidth)
 }
+
+func (c *networkClient) TrackInvalidResponse(nodeID ids.NodeID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.peers.TrackInvalidResponse(nodeID)
+}
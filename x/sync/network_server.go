@@ -16,14 +16,24 @@ import (
 
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/snow/engine/common"
+	"github.com/VidarSolutions/avalanchego/utils/constants"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/math"
 	"github.com/VidarSolutions/avalanchego/x/merkledb"
 )
 
-// Maximum number of key-value pairs to return in a proof.
-// This overrides any other Limit specified in a RangeProofRequest
-// or ChangeProofRequest if the given Limit is greater.
-const maxKeyValuesLimit = 1024
+const (
+	// Maximum number of key-value pairs to return in a proof.
+	// This overrides any other KeyLimit specified in a RangeProofRequest
+	// or ChangeProofRequest if the given KeyLimit is greater.
+	maxKeyValuesLimit = 1024
+	// Maximum size, in bytes, of a proof to return.
+	// This overrides any other BytesLimit specified in a RangeProofRequest
+	// or ChangeProofRequest if the given BytesLimit is greater.
+	// It's well below the maximum message size so that the response fits
+	// in a message.
+	maxByteSizeLimit = constants.DefaultMaxMessageSize / 2
+)
 
 var _ Handler = (*NetworkServer)(nil)
 
@@ -125,7 +135,7 @@ func (s *NetworkServer) HandleChangeProofRequest(
 	requestID uint32,
 	req *ChangeProofRequest,
 ) error {
-	if req.Limit == 0 || req.EndingRoot == ids.Empty || (len(req.End) > 0 && bytes.Compare(req.Start, req.End) > 0) {
+	if !isValidChangeProofRequest(req) {
 		s.log.Debug(
 			"dropping invalid change proof request",
 			zap.Stringer("nodeID", nodeID),
@@ -135,13 +145,8 @@ func (s *NetworkServer) HandleChangeProofRequest(
 		return nil // dropping request
 	}
 
-	// override limit if it is greater than maxKeyValuesLimit
-	limit := req.Limit
-	if limit > maxKeyValuesLimit {
-		limit = maxKeyValuesLimit
-	}
-
-	changeProof, err := s.db.GetChangeProof(ctx, req.StartingRoot, req.EndingRoot, req.Start, req.End, int(limit))
+	keyLimit, bytesLimit := getLimits(req, maxByteSizeLimit)
+	proofBytes, err := getChangeProof(ctx, s.db, req, keyLimit, bytesLimit)
 	if err != nil {
 		// handle expected errors so clients cannot cause servers to spam warning logs.
 		if errors.Is(err, merkledb.ErrRootIDNotPresent) || errors.Is(err, merkledb.ErrStartRootNotFound) {
@@ -156,11 +161,6 @@ func (s *NetworkServer) HandleChangeProofRequest(
 		}
 		return err
 	}
-
-	proofBytes, err := merkledb.Codec.EncodeChangeProof(Version, changeProof)
-	if err != nil {
-		return err
-	}
 	return s.appSender.SendAppResponse(ctx, nodeID, requestID, proofBytes)
 }
 
@@ -172,7 +172,7 @@ func (s *NetworkServer) HandleRangeProofRequest(
 	requestID uint32,
 	req *RangeProofRequest,
 ) error {
-	if req.Limit == 0 || req.Root == ids.Empty || (len(req.End) > 0 && bytes.Compare(req.Start, req.End) > 0) {
+	if !isValidRangeProofRequest(req) {
 		s.log.Debug(
 			"dropping invalid range proof request",
 			zap.Stringer("nodeID", nodeID),
@@ -182,13 +182,8 @@ func (s *NetworkServer) HandleRangeProofRequest(
 		return nil // dropping request
 	}
 
-	// override limit if it is greater than maxKeyValuesLimit
-	limit := req.Limit
-	if limit > maxKeyValuesLimit {
-		limit = maxKeyValuesLimit
-	}
-
-	rangeProof, err := s.db.GetRangeProofAtRoot(ctx, req.Root, req.Start, req.End, int(limit))
+	keyLimit, bytesLimit := getLimits(req, maxByteSizeLimit)
+	proofBytes, err := getRangeProof(ctx, s.db, req, keyLimit, bytesLimit)
 	if err != nil {
 		// handle expected errors so clients cannot cause servers to spam warning logs.
 		if errors.Is(err, merkledb.ErrRootIDNotPresent) {
@@ -203,10 +198,87 @@ func (s *NetworkServer) HandleRangeProofRequest(
 		}
 		return err
 	}
+	return s.appSender.SendAppResponse(ctx, nodeID, requestID, proofBytes)
+}
 
-	proofBytes, err := merkledb.Codec.EncodeRangeProof(Version, rangeProof)
-	if err != nil {
-		return err
+func isValidChangeProofRequest(req *ChangeProofRequest) bool {
+	return req.KeyLimit > 0 &&
+		req.BytesLimit > 0 &&
+		req.EndingRoot != ids.Empty &&
+		(len(req.End) == 0 || bytes.Compare(req.Start, req.End) <= 0)
+}
+
+func isValidRangeProofRequest(req *RangeProofRequest) bool {
+	return req.KeyLimit > 0 &&
+		req.BytesLimit > 0 &&
+		req.Root != ids.Empty &&
+		(len(req.End) == 0 || bytes.Compare(req.Start, req.End) <= 0)
+}
+
+// Returns the limits of [req], overridden by [maxKeyValuesLimit] and
+// [maxBytesLimit] if they're greater.
+func getLimits(req Request, maxBytesLimit int) (int, int) {
+	keyLimit, bytesLimit := req.limits()
+	return math.Min(int(keyLimit), maxKeyValuesLimit), math.Min(int(bytesLimit), maxBytesLimit)
+}
+
+// Returns the encoded change proof for [req] with at most [keyLimit] keys.
+// The number of keys is reduced until the encoded proof is at most
+// [bytesLimit] bytes. If a proof with a single key is larger than
+// [bytesLimit], it's returned anyway so that the requester can make progress.
+func getChangeProof(
+	ctx context.Context,
+	db *merkledb.Database,
+	req *ChangeProofRequest,
+	keyLimit int,
+	bytesLimit int,
+) ([]byte, error) {
+	for {
+		changeProof, err := db.GetChangeProof(ctx, req.StartingRoot, req.EndingRoot, req.Start, req.End, keyLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		proofBytes, err := db.Codec().EncodeChangeProof(Version, changeProof)
+		if err != nil {
+			return nil, err
+		}
+
+		numKeys := len(changeProof.KeyValues) + len(changeProof.DeletedKeys)
+		if len(proofBytes) <= bytesLimit || numKeys <= 1 {
+			return proofBytes, nil
+		}
+		// The proof is too large, so try again with fewer keys.
+		keyLimit = numKeys / 2
+	}
+}
+
+// Returns the encoded range proof for [req] with at most [keyLimit] keys.
+// The number of keys is reduced until the encoded proof is at most
+// [bytesLimit] bytes. If a proof with a single key is larger than
+// [bytesLimit], it's returned anyway so that the requester can make progress.
+func getRangeProof(
+	ctx context.Context,
+	db *merkledb.Database,
+	req *RangeProofRequest,
+	keyLimit int,
+	bytesLimit int,
+) ([]byte, error) {
+	for {
+		rangeProof, err := db.GetRangeProofAtRoot(ctx, req.Root, req.Start, req.End, keyLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		proofBytes, err := db.Codec().EncodeRangeProof(Version, rangeProof)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(proofBytes) <= bytesLimit || len(rangeProof.KeyValues) <= 1 {
+			return proofBytes, nil
+		}
+		// The proof is too large, so try again with fewer keys.
+		keyLimit = len(rangeProof.KeyValues) / 2
 	}
-	return s.appSender.SendAppResponse(ctx, nodeID, requestID, proofBytes)
 }
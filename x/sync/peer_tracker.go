@@ -29,12 +29,24 @@ const (
 	// The probability that, when we select a peer, we select randomly rather
 	// than based on their performance.
 	randomPeerProbability = 0.2
+
+	// The number of consecutive invalid responses after which a peer is no
+	// longer sent requests until it reconnects.
+	maxConsecutiveInvalidResponses = 3
 )
 
 // information we track on a given peer
 type peerInfo struct {
 	version   *version.Application
 	bandwidth math.Averager
+	// The number of requests sent to the peer that haven't been responded to.
+	outstandingRequests int
+	// The number of invalid responses the peer has sent since its last
+	// valid response.
+	invalidResponses int
+	// True if the peer sent too many invalid responses and shouldn't be sent
+	// requests.
+	dropped bool
 }
 
 // Tracks the bandwidth of responses coming from peers,
@@ -115,6 +127,10 @@ func (p *peerTracker) GetAnyPeer(minVersion *version.Application) (ids.NodeID, b
 			if p.trackedPeers.Contains(nodeID) {
 				continue
 			}
+			// skip peers that sent too many invalid responses
+			if p.peers[nodeID].dropped {
+				continue
+			}
 			p.log.Debug(
 				"tracking peer",
 				zap.Int("trackedPeers", len(p.trackedPeers)),
@@ -130,7 +146,7 @@ func (p *peerTracker) GetAnyPeer(minVersion *version.Application) (ids.NodeID, b
 	)
 	useRand := rand.Float64() < randomPeerProbability // #nosec G404
 	if useRand {
-		nodeID, ok = p.responsivePeers.Peek()
+		nodeID, ok = p.getIdleResponsivePeer()
 	} else {
 		nodeID, _, ok = p.bandwidthHeap.Pop()
 	}
@@ -146,10 +162,78 @@ func (p *peerTracker) GetAnyPeer(minVersion *version.Application) (ids.NodeID, b
 	return nodeID, true
 }
 
+// Returns a responsive peer without outstanding requests, if there is one, so
+// that concurrent requests are spread across peers.
+// Otherwise, returns any responsive peer.
+func (p *peerTracker) getIdleResponsivePeer() (ids.NodeID, bool) {
+	for nodeID := range p.responsivePeers {
+		if p.peers[nodeID].outstandingRequests == 0 {
+			return nodeID, true
+		}
+	}
+	return p.responsivePeers.Peek()
+}
+
 // Record that we sent a request to [nodeID].
 func (p *peerTracker) TrackPeer(nodeID ids.NodeID) {
 	p.trackedPeers.Add(nodeID)
 	// p.numTrackedPeers.Set(float64(p.trackedPeers.Len()))
+
+	if peer := p.peers[nodeID]; peer != nil {
+		peer.outstandingRequests++
+	}
+}
+
+// Record that a request sent to [nodeID] was responded to or failed.
+func (p *peerTracker) TrackRequestDone(nodeID ids.NodeID) {
+	if peer := p.peers[nodeID]; peer != nil && peer.outstandingRequests > 0 {
+		peer.outstandingRequests--
+	}
+}
+
+// Record that [nodeID] sent an invalid response.
+// After [maxConsecutiveInvalidResponses] invalid responses in a row, [nodeID]
+// isn't returned by [GetAnyPeer] until it reconnects.
+func (p *peerTracker) TrackInvalidResponse(nodeID ids.NodeID) {
+	peer := p.peers[nodeID]
+	if peer == nil {
+		p.log.Debug("tracking invalid response for untracked peer", zap.Stringer("nodeID", nodeID))
+		return
+	}
+
+	p.TrackBandwidth(nodeID, 0)
+	peer.invalidResponses++
+	if peer.invalidResponses < maxConsecutiveInvalidResponses || peer.dropped {
+		return
+	}
+
+	p.log.Info("dropping peer that sent too many invalid responses",
+		zap.Stringer("nodeID", nodeID),
+		zap.Int("invalidResponses", peer.invalidResponses),
+	)
+	peer.dropped = true
+	p.bandwidthHeap.Remove(nodeID)
+	p.trackedPeers.Remove(nodeID)
+	p.responsivePeers.Remove(nodeID)
+}
+
+// Returns the average bandwidth of [nodeID] in bytes per second, or 0 if it
+// hasn't been measured.
+func (p *peerTracker) Bandwidth(nodeID ids.NodeID) float64 {
+	peer := p.peers[nodeID]
+	if peer == nil || peer.bandwidth == nil {
+		return 0
+	}
+	return peer.bandwidth.Read()
+}
+
+// Returns the node version of [nodeID], or nil if it isn't connected.
+func (p *peerTracker) Version(nodeID ids.NodeID) *version.Application {
+	peer := p.peers[nodeID]
+	if peer == nil {
+		return nil
+	}
+	return peer.version
 }
 
 // Record that we observed that [nodeID]'s bandwidth is [bandwidth].
@@ -162,6 +246,11 @@ func (p *peerTracker) TrackBandwidth(nodeID ids.NodeID, bandwidth float64) {
 		return
 	}
 
+	if peer.dropped {
+		// the peer shouldn't be sent requests until it reconnects
+		return
+	}
+
 	now := time.Now()
 	if peer.bandwidth == nil {
 		peer.bandwidth = math.NewAverager(bandwidth, bandwidthHalflife, now)
@@ -173,6 +262,7 @@ func (p *peerTracker) TrackBandwidth(nodeID ids.NodeID, bandwidth float64) {
 	if bandwidth == 0 {
 		p.responsivePeers.Remove(nodeID)
 	} else {
+		peer.invalidResponses = 0
 		p.responsivePeers.Add(nodeID)
 		// TODO danlaine: shouldn't we add the observation of 0
 		// to the average bandwidth in the if statement?
@@ -196,10 +286,7 @@ func (p *peerTracker) Connected(nodeID ids.NodeID, nodeVersion *version.Applicat
 	// Log a warning message since the consensus engine should never call Connected on a peer
 	// that we have already marked as Connected.
 	if nodeVersion.Compare(peer.version) != 0 {
-		p.peers[nodeID] = &peerInfo{
-			version:   nodeVersion,
-			bandwidth: peer.bandwidth,
-		}
+		peer.version = nodeVersion
 		p.log.Warn(
 			"updating node version of already connected peer",
 			zap.Stringer("nodeID", nodeID),
@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/VidarSolutions/avalanchego/database"
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+)
+
+var (
+	progressKey    = []byte("progress")
+	inFlightPrefix = []byte("inFlight")
+
+	errInvalidProgress = errors.New("invalid sync progress")
+)
+
+// The state of a sync, persisted so that it can be resumed after a restart.
+// The work items cover the entire key space without overlapping, other than
+// sharing their boundaries.
+type syncProgress struct {
+	TargetRoot ids.ID                 `serialize:"true"`
+	WorkItems  []syncProgressWorkItem `serialize:"true"`
+}
+
+// A persisted [syncWorkItem].
+// An empty [Start] means there is no lower bound.
+// An empty [End] means there is no upper bound.
+type syncProgressWorkItem struct {
+	Start       []byte `serialize:"true"`
+	End         []byte `serialize:"true"`
+	Priority    byte   `serialize:"true"`
+	LocalRootID ids.ID `serialize:"true"`
+}
+
+// Returns the progress stored in [db].
+// Returns database.ErrNotFound if there is no stored progress.
+func getSyncProgress(db database.KeyValueReader) (*syncProgress, error) {
+	progressBytes, err := db.Get(progressKey)
+	if err != nil {
+		return nil, err
+	}
+	progress := &syncProgress{}
+	if _, err := syncCodec.Unmarshal(progressBytes, progress); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidProgress, err)
+	}
+	return progress, progress.verify()
+}
+
+func putSyncProgress(db database.KeyValueWriter, progress *syncProgress) error {
+	progressBytes, err := syncCodec.Marshal(Version, progress)
+	if err != nil {
+		return err
+	}
+	return db.Put(progressKey, progressBytes)
+}
+
+// Records that the range of [item] is about to be modified by the work item
+// with sequence number [seq]. The range may not be synced to any root until
+// [deleteInFlight] is called with a larger sequence number.
+func putInFlight(db database.KeyValueWriter, seq uint64, item syncProgressWorkItem) error {
+	itemBytes, err := syncCodec.Marshal(Version, &item)
+	if err != nil {
+		return err
+	}
+	return db.Put(inFlightKey(seq), itemBytes)
+}
+
+// Returns the work items recorded by [putInFlight] and the sequence number
+// after the largest recorded one.
+func getInFlight(db database.Iteratee) ([]syncProgressWorkItem, uint64, error) {
+	it := db.NewIteratorWithPrefix(inFlightPrefix)
+	defer it.Release()
+
+	var (
+		items   []syncProgressWorkItem
+		nextSeq uint64
+	)
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(inFlightPrefix)+wrappers.LongLen {
+			return nil, 0, fmt.Errorf("%w: invalid in flight key %x", errInvalidProgress, key)
+		}
+		item := syncProgressWorkItem{}
+		if _, err := syncCodec.Unmarshal(it.Value(), &item); err != nil {
+			return nil, 0, fmt.Errorf("%w: %w", errInvalidProgress, err)
+		}
+		items = append(items, item)
+		if seq := binary.BigEndian.Uint64(key[len(inFlightPrefix):]); seq >= nextSeq {
+			nextSeq = seq + 1
+		}
+	}
+	return items, nextSeq, it.Error()
+}
+
+// Deletes the work items recorded by [putInFlight] with sequence numbers less
+// than [seq].
+func deleteInFlight(db database.Database, seq uint64) error {
+	it := db.NewIteratorWithPrefix(inFlightPrefix)
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		if bytes.Compare(key, inFlightKey(seq)) >= 0 {
+			break
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func inFlightKey(seq uint64) []byte {
+	key := make([]byte, len(inFlightPrefix)+wrappers.LongLen)
+	copy(key, inFlightPrefix)
+	binary.BigEndian.PutUint64(key[len(inFlightPrefix):], seq)
+	return key
+}
+
+// Sorts the work items by their start and verifies that they cover the entire
+// key space without overlapping.
+func (p *syncProgress) verify() error {
+	slices.SortFunc(p.WorkItems, func(a, b syncProgressWorkItem) bool {
+		return bytes.Compare(a.Start, b.Start) < 0
+	})
+
+	if len(p.WorkItems) == 0 {
+		return fmt.Errorf("%w: no work items", errInvalidProgress)
+	}
+	if len(p.WorkItems[0].Start) != 0 {
+		return fmt.Errorf("%w: first work item starts at %x", errInvalidProgress, p.WorkItems[0].Start)
+	}
+	for i, item := range p.WorkItems {
+		switch priority(item.Priority) {
+		case lowPriority, medPriority, highPriority:
+		default:
+			return fmt.Errorf("%w: unknown priority %d", errInvalidProgress, item.Priority)
+		}
+
+		isLast := i == len(p.WorkItems)-1
+		switch {
+		case isLast && len(item.End) != 0:
+			return fmt.Errorf("%w: last work item ends at %x", errInvalidProgress, item.End)
+		case !isLast && len(item.End) == 0:
+			return fmt.Errorf("%w: work item starting at %x has no upper bound", errInvalidProgress, item.Start)
+		case !isLast && bytes.Compare(item.Start, item.End) > 0:
+			return fmt.Errorf("%w: work item starts at %x after its end %x", errInvalidProgress, item.Start, item.End)
+		case !isLast && !bytes.Equal(item.End, p.WorkItems[i+1].Start):
+			return fmt.Errorf("%w: work item ending at %x isn't followed by a work item starting there", errInvalidProgress, item.End)
+		}
+	}
+	return nil
+}
+
+// Returns true if the ranges of [item] and [other] may contain a common key.
+func (item syncProgressWorkItem) overlaps(other syncProgressWorkItem) bool {
+	return (len(item.End) == 0 || bytes.Compare(other.Start, item.End) <= 0) &&
+		(len(other.End) == 0 || bytes.Compare(item.Start, other.End) <= 0)
+}
+
+func newSyncProgressWorkItem(item *syncWorkItem) syncProgressWorkItem {
+	return syncProgressWorkItem{
+		Start:       item.start,
+		End:         item.end,
+		Priority:    byte(item.priority),
+		LocalRootID: item.LocalRootID,
+	}
+}
+
+// Returns the [syncWorkItem] that [item] was persisted from.
+func (item syncProgressWorkItem) workItem() *syncWorkItem {
+	var start, end []byte
+	if len(item.Start) != 0 {
+		start = item.Start
+	}
+	if len(item.End) != 0 {
+		end = item.End
+	}
+	return newWorkItem(item.LocalRootID, start, end, priority(item.Priority))
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/database/memdb"
+	"github.com/VidarSolutions/avalanchego/ids"
+)
+
+func TestSyncProgress(t *testing.T) {
+	require := require.New(t)
+
+	rootID := ids.GenerateTestID()
+	progress := &syncProgress{
+		TargetRoot: rootID,
+		WorkItems: []syncProgressWorkItem{
+			{
+				Start:       []byte{1},
+				End:         nil,
+				Priority:    byte(lowPriority),
+				LocalRootID: ids.Empty,
+			},
+			{
+				Start:       nil,
+				End:         []byte{1},
+				Priority:    byte(highPriority),
+				LocalRootID: rootID,
+			},
+		},
+	}
+
+	db := memdb.New()
+	require.NoError(putSyncProgress(db, progress))
+	gotProgress, err := getSyncProgress(db)
+	require.NoError(err)
+	require.Equal(rootID, gotProgress.TargetRoot)
+	require.Len(gotProgress.WorkItems, 2)
+
+	// The work items are sorted by their start.
+	require.Equal(newWorkItem(rootID, nil, []byte{1}, highPriority), gotProgress.WorkItems[0].workItem())
+	require.Equal(newWorkItem(ids.Empty, []byte{1}, nil, lowPriority), gotProgress.WorkItems[1].workItem())
+}
+
+func TestSyncProgressVerify(t *testing.T) {
+	tests := []struct {
+		name      string
+		workItems []syncProgressWorkItem
+		expectErr bool
+	}{
+		{
+			name: "entire key space",
+			workItems: []syncProgressWorkItem{
+				{Priority: byte(lowPriority)},
+			},
+		},
+		{
+			name: "contiguous ranges",
+			workItems: []syncProgressWorkItem{
+				{End: []byte{1}, Priority: byte(lowPriority)},
+				{Start: []byte{1}, End: []byte{1, 2}, Priority: byte(medPriority)},
+				{Start: []byte{1, 2}, Priority: byte(highPriority)},
+			},
+		},
+		{
+			name:      "no work items",
+			expectErr: true,
+		},
+		{
+			name: "missing start of key space",
+			workItems: []syncProgressWorkItem{
+				{Start: []byte{1}, Priority: byte(lowPriority)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing end of key space",
+			workItems: []syncProgressWorkItem{
+				{End: []byte{1}, Priority: byte(lowPriority)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "gap",
+			workItems: []syncProgressWorkItem{
+				{End: []byte{1}, Priority: byte(lowPriority)},
+				{Start: []byte{2}, Priority: byte(lowPriority)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "overlap",
+			workItems: []syncProgressWorkItem{
+				{End: []byte{2}, Priority: byte(lowPriority)},
+				{Start: []byte{1}, Priority: byte(lowPriority)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid priority",
+			workItems: []syncProgressWorkItem{
+				{Priority: 0},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := &syncProgress{WorkItems: tt.workItems}
+			err := progress.verify()
+			if tt.expectErr {
+				require.ErrorIs(t, err, errInvalidProgress)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInFlight(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	items, nextSeq, err := getInFlight(db)
+	require.NoError(err)
+	require.Empty(items)
+	require.Zero(nextSeq)
+
+	item0 := syncProgressWorkItem{End: []byte{1}, Priority: byte(lowPriority)}
+	item1 := syncProgressWorkItem{Start: []byte{1}, Priority: byte(highPriority), LocalRootID: ids.GenerateTestID()}
+	require.NoError(putInFlight(db, 0, item0))
+	require.NoError(putInFlight(db, 256, item1))
+	require.NoError(putSyncProgress(db, &syncProgress{WorkItems: []syncProgressWorkItem{item0, item1}}))
+
+	items, nextSeq, err = getInFlight(db)
+	require.NoError(err)
+	require.Len(items, 2)
+	require.Equal(item0.workItem(), items[0].workItem())
+	require.Equal(item1.workItem(), items[1].workItem())
+	require.Equal(uint64(257), nextSeq)
+
+	// Only the work items before the sequence number are deleted.
+	require.NoError(deleteInFlight(db, 256))
+	items, nextSeq, err = getInFlight(db)
+	require.NoError(err)
+	require.Len(items, 1)
+	require.Equal(item1.workItem(), items[0].workItem())
+	require.Equal(uint64(257), nextSeq)
+
+	// The progress isn't affected.
+	_, err = getSyncProgress(db)
+	require.NoError(err)
+}
+
+func TestSyncProgressWorkItemOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        syncProgressWorkItem
+		b        syncProgressWorkItem
+		overlaps bool
+	}{
+		{
+			name:     "entire key space",
+			a:        syncProgressWorkItem{},
+			b:        syncProgressWorkItem{Start: []byte{1}, End: []byte{2}},
+			overlaps: true,
+		},
+		{
+			name:     "shared boundary",
+			a:        syncProgressWorkItem{End: []byte{1}},
+			b:        syncProgressWorkItem{Start: []byte{1}},
+			overlaps: true,
+		},
+		{
+			name:     "contained",
+			a:        syncProgressWorkItem{Start: []byte{1}, End: []byte{4}},
+			b:        syncProgressWorkItem{Start: []byte{2}, End: []byte{3}},
+			overlaps: true,
+		},
+		{
+			name: "disjoint",
+			a:    syncProgressWorkItem{End: []byte{1}},
+			b:    syncProgressWorkItem{Start: []byte{2}},
+		},
+		{
+			name: "disjoint bounded",
+			a:    syncProgressWorkItem{Start: []byte{1}, End: []byte{2}},
+			b:    syncProgressWorkItem{Start: []byte{3}, End: []byte{4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.overlaps, tt.a.overlaps(tt.b))
+			require.Equal(t, tt.overlaps, tt.b.overlaps(tt.a))
+		})
+	}
+}
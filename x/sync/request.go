@@ -14,12 +14,22 @@ import (
 var (
 	_ Request = (*RangeProofRequest)(nil)
 	_ Request = (*ChangeProofRequest)(nil)
+	_ Request = (*legacyRangeProofRequest)(nil)
+	_ Request = (*legacyChangeProofRequest)(nil)
 )
 
 // A request to this node for a proof.
 type Request interface {
 	fmt.Stringer
 	Handle(ctx context.Context, nodeID ids.NodeID, requestID uint32, h Handler) error
+
+	// Returns the maximum number of key/values and the maximum size, in
+	// bytes, of the requested proof.
+	limits() (keyLimit uint16, bytesLimit uint32)
+	// Returns a copy of the request with the given limits.
+	withLimits(keyLimit uint16, bytesLimit uint32) Request
+	// Returns the request in the format encoded with [Version].
+	legacy() Request
 }
 
 type rangeProofHandler interface {
@@ -49,11 +59,15 @@ type Handler interface {
 	changeProofHandler
 }
 
+// RangeProofRequest is a request to receive trie leaves at specified Root within Start and End byte range
+// KeyLimit outlines maximum number of leaves to return starting at Start
+// BytesLimit outlines maximum size of the response in bytes
 type RangeProofRequest struct {
-	Root  ids.ID `serialize:"true"`
-	Start []byte `serialize:"true"`
-	End   []byte `serialize:"true"`
-	Limit uint16 `serialize:"true"`
+	Root       ids.ID `serialize:"true"`
+	Start      []byte `serialize:"true"`
+	End        []byte `serialize:"true"`
+	KeyLimit   uint16 `serialize:"true"`
+	BytesLimit uint32 `serialize:"true"`
 }
 
 func (r *RangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, requestID uint32, h Handler) error {
@@ -62,22 +76,45 @@ func (r *RangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, reque
 
 func (r RangeProofRequest) String() string {
 	return fmt.Sprintf(
-		"RangeProofRequest(Root=%s, Start=%s, End=%s, Limit=%d)",
+		"RangeProofRequest(Root=%s, Start=%s, End=%s, KeyLimit=%d, BytesLimit=%d)",
 		r.Root,
 		hex.EncodeToString(r.Start),
 		hex.EncodeToString(r.End),
-		r.Limit,
+		r.KeyLimit,
+		r.BytesLimit,
 	)
 }
 
+func (r *RangeProofRequest) limits() (uint16, uint32) {
+	return r.KeyLimit, r.BytesLimit
+}
+
+func (r *RangeProofRequest) withLimits(keyLimit uint16, bytesLimit uint32) Request {
+	request := *r
+	request.KeyLimit = keyLimit
+	request.BytesLimit = bytesLimit
+	return &request
+}
+
+func (r *RangeProofRequest) legacy() Request {
+	return &legacyRangeProofRequest{
+		Root:  r.Root,
+		Start: r.Start,
+		End:   r.End,
+		Limit: r.KeyLimit,
+	}
+}
+
 // ChangeProofRequest is a request to receive trie leaves at specified Root within Start and End byte range
-// Limit outlines maximum number of leaves to returns starting at Start
+// KeyLimit outlines maximum number of leaves to returns starting at Start
+// BytesLimit outlines maximum size of the response in bytes
 type ChangeProofRequest struct {
 	StartingRoot ids.ID `serialize:"true"`
 	EndingRoot   ids.ID `serialize:"true"`
 	Start        []byte `serialize:"true"`
 	End          []byte `serialize:"true"`
-	Limit        uint16 `serialize:"true"`
+	KeyLimit     uint16 `serialize:"true"`
+	BytesLimit   uint32 `serialize:"true"`
 }
 
 func (r *ChangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, requestID uint32, h Handler) error {
@@ -86,11 +123,113 @@ func (r *ChangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, requ
 
 func (r ChangeProofRequest) String() string {
 	return fmt.Sprintf(
-		"ChangeProofRequest(StartRoot=%s, EndRoot=%s, Start=%s, End=%s, Limit=%d)",
+		"ChangeProofRequest(StartRoot=%s, EndRoot=%s, Start=%s, End=%s, KeyLimit=%d, BytesLimit=%d)",
 		r.StartingRoot,
 		r.EndingRoot,
 		hex.EncodeToString(r.Start),
 		hex.EncodeToString(r.End),
-		r.Limit,
+		r.KeyLimit,
+		r.BytesLimit,
 	)
 }
+
+func (r *ChangeProofRequest) limits() (uint16, uint32) {
+	return r.KeyLimit, r.BytesLimit
+}
+
+func (r *ChangeProofRequest) withLimits(keyLimit uint16, bytesLimit uint32) Request {
+	request := *r
+	request.KeyLimit = keyLimit
+	request.BytesLimit = bytesLimit
+	return &request
+}
+
+func (r *ChangeProofRequest) legacy() Request {
+	return &legacyChangeProofRequest{
+		StartingRoot: r.StartingRoot,
+		EndingRoot:   r.EndingRoot,
+		Start:        r.Start,
+		End:          r.End,
+		Limit:        r.KeyLimit,
+	}
+}
+
+// legacyRangeProofRequest is a [RangeProofRequest] sent to or by a node that
+// encodes requests with [Version]. It doesn't limit the size of the proof.
+type legacyRangeProofRequest struct {
+	Root  ids.ID `serialize:"true"`
+	Start []byte `serialize:"true"`
+	End   []byte `serialize:"true"`
+	Limit uint16 `serialize:"true"`
+}
+
+func (r *legacyRangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, requestID uint32, h Handler) error {
+	return h.HandleRangeProofRequest(ctx, nodeID, requestID, r.upgrade())
+}
+
+func (r legacyRangeProofRequest) String() string {
+	return r.upgrade().String()
+}
+
+func (r *legacyRangeProofRequest) limits() (uint16, uint32) {
+	return r.upgrade().limits()
+}
+
+func (r *legacyRangeProofRequest) withLimits(keyLimit uint16, bytesLimit uint32) Request {
+	return r.upgrade().withLimits(keyLimit, bytesLimit)
+}
+
+func (r *legacyRangeProofRequest) legacy() Request {
+	return r
+}
+
+func (r *legacyRangeProofRequest) upgrade() *RangeProofRequest {
+	return &RangeProofRequest{
+		Root:       r.Root,
+		Start:      r.Start,
+		End:        r.End,
+		KeyLimit:   r.Limit,
+		BytesLimit: maxByteSizeLimit,
+	}
+}
+
+// legacyChangeProofRequest is a [ChangeProofRequest] sent to or by a node that
+// encodes requests with [Version]. It doesn't limit the size of the proof.
+type legacyChangeProofRequest struct {
+	StartingRoot ids.ID `serialize:"true"`
+	EndingRoot   ids.ID `serialize:"true"`
+	Start        []byte `serialize:"true"`
+	End          []byte `serialize:"true"`
+	Limit        uint16 `serialize:"true"`
+}
+
+func (r *legacyChangeProofRequest) Handle(ctx context.Context, nodeID ids.NodeID, requestID uint32, h Handler) error {
+	return h.HandleChangeProofRequest(ctx, nodeID, requestID, r.upgrade())
+}
+
+func (r legacyChangeProofRequest) String() string {
+	return r.upgrade().String()
+}
+
+func (r *legacyChangeProofRequest) limits() (uint16, uint32) {
+	return r.upgrade().limits()
+}
+
+func (r *legacyChangeProofRequest) withLimits(keyLimit uint16, bytesLimit uint32) Request {
+	return r.upgrade().withLimits(keyLimit, bytesLimit)
+}
+
+func (r *legacyChangeProofRequest) legacy() Request {
+	return r
+}
+
+func (r *legacyChangeProofRequest) upgrade() *ChangeProofRequest {
+	return &ChangeProofRequest{
+		StartingRoot: r.StartingRoot,
+		EndingRoot:   r.EndingRoot,
+		Start:        r.Start,
+		End:          r.End,
+		KeyLimit:     r.Limit,
+		BytesLimit:   maxByteSizeLimit,
+	}
+}
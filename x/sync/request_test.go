@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/VidarSolutions/avalanchego/ids"
+	"github.com/VidarSolutions/avalanchego/utils/wrappers"
+	"github.com/VidarSolutions/avalanchego/version"
+)
+
+// Requests sent by nodes that only limit the number of keys in a proof are
+// still handled.
+func TestUnmarshalLegacyRequest(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		startingRoot = ids.GenerateTestID()
+		endingRoot   = ids.GenerateTestID()
+		start        = []byte{1}
+		end          = []byte{2, 3}
+		limit        = uint16(100)
+	)
+
+	// A range proof request encoded before proofs could be limited by size.
+	p := wrappers.Packer{MaxSize: maxMessageSize}
+	p.PackShort(Version)
+	p.PackInt(1) // RangeProofRequest type ID
+	p.PackFixedBytes(endingRoot[:])
+	p.PackBytes(start)
+	p.PackBytes(end)
+	p.PackShort(limit)
+	require.NoError(p.Err)
+
+	var request Request
+	codecVersion, err := syncCodec.Unmarshal(p.Bytes, &request)
+	require.NoError(err)
+	require.Equal(uint16(Version), codecVersion)
+	require.Equal(&legacyRangeProofRequest{
+		Root:  endingRoot,
+		Start: start,
+		End:   end,
+		Limit: limit,
+	}, request)
+
+	keyLimit, bytesLimit := request.limits()
+	require.Equal(limit, keyLimit)
+	require.Equal(uint32(maxByteSizeLimit), bytesLimit)
+
+	// A change proof request encoded before proofs could be limited by size.
+	p = wrappers.Packer{MaxSize: maxMessageSize}
+	p.PackShort(Version)
+	p.PackInt(0) // ChangeProofRequest type ID
+	p.PackFixedBytes(startingRoot[:])
+	p.PackFixedBytes(endingRoot[:])
+	p.PackBytes(start)
+	p.PackBytes(end)
+	p.PackShort(limit)
+	require.NoError(p.Err)
+
+	request = nil
+	_, err = syncCodec.Unmarshal(p.Bytes, &request)
+	require.NoError(err)
+
+	handler := &requestRecorder{}
+	require.NoError(request.Handle(context.Background(), ids.GenerateTestNodeID(), 0, handler))
+	require.Equal(&ChangeProofRequest{
+		StartingRoot: startingRoot,
+		EndingRoot:   endingRoot,
+		Start:        start,
+		End:          end,
+		KeyLimit:     limit,
+		BytesLimit:   maxByteSizeLimit,
+	}, handler.changeProofRequest)
+}
+
+// Requests are encoded with [requestVersion] only for peers that can decode
+// them.
+func TestMarshalRequest(t *testing.T) {
+	request := &ChangeProofRequest{
+		StartingRoot: ids.GenerateTestID(),
+		EndingRoot:   ids.GenerateTestID(),
+		Start:        []byte{1},
+		End:          []byte{2},
+		KeyLimit:     100,
+		BytesLimit:   1024,
+	}
+
+	tests := []struct {
+		name            string
+		peerVersion     *version.Application
+		expectedVersion uint16
+		expectedRequest Request
+	}{
+		{
+			name:            "unknown peer version",
+			expectedVersion: Version,
+			expectedRequest: request.legacy(),
+		},
+		{
+			name:            "legacy peer",
+			peerVersion:     version.CurrentApp,
+			expectedVersion: Version,
+			expectedRequest: &legacyChangeProofRequest{
+				StartingRoot: request.StartingRoot,
+				EndingRoot:   request.EndingRoot,
+				Start:        request.Start,
+				End:          request.End,
+				Limit:        request.KeyLimit,
+			},
+		},
+		{
+			name:            "upgraded peer",
+			peerVersion:     minRequestVersionApp,
+			expectedVersion: requestVersion,
+			expectedRequest: request,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			requestBytes, err := marshalRequest(request, tt.peerVersion)
+			require.NoError(err)
+
+			var parsedRequest Request
+			codecVersion, err := syncCodec.Unmarshal(requestBytes, &parsedRequest)
+			require.NoError(err)
+			require.Equal(tt.expectedVersion, codecVersion)
+			require.Equal(tt.expectedRequest, parsedRequest)
+		})
+	}
+}
+
+type requestRecorder struct {
+	rangeProofRequest  *RangeProofRequest
+	changeProofRequest *ChangeProofRequest
+}
+
+func (r *requestRecorder) HandleRangeProofRequest(_ context.Context, _ ids.NodeID, _ uint32, request *RangeProofRequest) error {
+	r.rangeProofRequest = request
+	return nil
+}
+
+func (r *requestRecorder) HandleChangeProofRequest(_ context.Context, _ ids.NodeID, _ uint32, request *ChangeProofRequest) error {
+	r.changeProofRequest = request
+	return nil
+}
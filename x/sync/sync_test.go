@@ -16,6 +16,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/database/memdb"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/trace"
@@ -35,11 +36,11 @@ type mockClient struct {
 }
 
 func (client *mockClient) GetChangeProof(ctx context.Context, request *ChangeProofRequest, _ *merkledb.Database) (*merkledb.ChangeProof, error) {
-	return client.db.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.Limit))
+	return client.db.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.KeyLimit))
 }
 
 func (client *mockClient) GetRangeProof(ctx context.Context, request *RangeProofRequest) (*merkledb.RangeProof, error) {
-	return client.db.GetRangeProofAtRoot(ctx, request.Root, request.Start, request.End, int(request.Limit))
+	return client.db.GetRangeProofAtRoot(ctx, request.Root, request.Start, request.End, int(request.KeyLimit))
 }
 
 func Test_Creation(t *testing.T) {
@@ -530,6 +531,190 @@ func Test_Sync_Result_Correct_Root_With_Sync_Restart(t *testing.T) {
 	}
 }
 
+func Test_Sync_Resume_With_Persisted_Progress(t *testing.T) {
+	require := require.New(t)
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	dbToSync, err := generateTrie(t, r, 5000)
+	require.NoError(err)
+	syncRoot, err := dbToSync.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	db, err := merkledb.New(
+		context.Background(),
+		memdb.New(),
+		merkledb.Config{
+			Tracer:        newNoopTracer(),
+			HistoryLength: 0,
+			NodeCacheSize: 1000,
+		},
+	)
+	require.NoError(err)
+	progressDB := memdb.New()
+
+	syncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                &mockClient{db: dbToSync},
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+	require.NoError(syncer.StartSyncing(context.Background()))
+
+	time.Sleep(15 * time.Millisecond)
+	syncer.Close()
+
+	// Sync may have completed before it was closed.
+	progress, err := getSyncProgress(progressDB)
+	require.NoError(err)
+	require.Equal(syncRoot, progress.TargetRoot)
+
+	newSyncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                &mockClient{db: dbToSync},
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+	require.NoError(newSyncer.StartSyncing(context.Background()))
+	require.NoError(newSyncer.Wait(context.Background()))
+
+	newRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(syncRoot, newRoot)
+
+	// The progress is deleted once the sync completes.
+	_, err = getSyncProgress(progressDB)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func Test_Sync_Resume_Completed_Sync(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := rand.New(rand.NewSource(0)) // #nosec G404
+	dbToSync, err := generateTrie(t, r, 1000)
+	require.NoError(err)
+	syncRoot, err := dbToSync.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	db, err := merkledb.New(
+		context.Background(),
+		memdb.New(),
+		merkledb.Config{
+			Tracer:        newNoopTracer(),
+			HistoryLength: 0,
+			NodeCacheSize: 1000,
+		},
+	)
+	require.NoError(err)
+	progressDB := memdb.New()
+
+	syncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                &mockClient{db: dbToSync},
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+	require.NoError(syncer.StartSyncing(context.Background()))
+
+	// Don't call Wait so that the progress isn't deleted.
+	<-syncer.syncDoneChan
+	require.NoError(syncer.Error())
+
+	// Every range was synced, so the resumed sync doesn't send any requests.
+	newSyncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                NewMockClient(ctrl),
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+	require.NoError(newSyncer.StartSyncing(context.Background()))
+	<-newSyncer.syncDoneChan
+	require.NoError(newSyncer.Error())
+
+	// If the sync DB no longer matches the progress, the progress is
+	// discarded.
+	require.NoError(db.Put([]byte("modified"), []byte("value")))
+
+	newSyncer, err = NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                &mockClient{db: dbToSync},
+		TargetRoot:            syncRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+	require.NoError(newSyncer.StartSyncing(context.Background()))
+	require.NoError(newSyncer.Wait(context.Background()))
+
+	newRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	require.Equal(syncRoot, newRoot)
+}
+
+// Ranges that were being modified when the progress was persisted are synced
+// again when the sync is resumed.
+func Test_Sync_Resume_In_Flight(t *testing.T) {
+	require := require.New(t)
+
+	db, err := merkledb.New(
+		context.Background(),
+		memdb.New(),
+		merkledb.Config{
+			Tracer:        newNoopTracer(),
+			HistoryLength: 0,
+			NodeCacheSize: 1000,
+		},
+	)
+	require.NoError(err)
+	localRoot, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+
+	progressDB := memdb.New()
+	syncedItem := syncProgressWorkItem{End: []byte{1}, Priority: byte(lowPriority), LocalRootID: localRoot}
+	inFlightItem := syncProgressWorkItem{Start: []byte{1}, Priority: byte(lowPriority), LocalRootID: localRoot}
+	require.NoError(putSyncProgress(progressDB, &syncProgress{
+		TargetRoot: localRoot,
+		WorkItems:  []syncProgressWorkItem{syncedItem, inFlightItem},
+	}))
+	require.NoError(putInFlight(progressDB, 5, syncProgressWorkItem{Start: []byte{2}, End: []byte{3}}))
+
+	syncer, err := NewStateSyncManager(StateSyncConfig{
+		SyncDB:                db,
+		Client:                &mockClient{db: db},
+		TargetRoot:            localRoot,
+		SimultaneousWorkLimit: 5,
+		Log:                   logging.NoLog{},
+		ProgressDB:            progressDB,
+	})
+	require.NoError(err)
+
+	syncer.workLock.Lock()
+	resumed, err := syncer.resumeProgress(context.Background())
+	require.NoError(err)
+	require.True(resumed)
+	require.Equal(uint64(6), syncer.nextInFlight)
+
+	// Only the range that was being modified must be synced again.
+	require.Equal(1, syncer.processedWork.Len())
+	require.Equal(1, syncer.unprocessedWork.Len())
+	require.Equal(newWorkItem(ids.Empty, []byte{1}, nil, lowPriority), syncer.unprocessedWork.GetWork())
+	syncer.workLock.Unlock()
+}
+
 func Test_Sync_Error_During_Sync(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -561,7 +746,7 @@ func Test_Sync_Error_During_Sync(t *testing.T) {
 	).AnyTimes()
 	client.EXPECT().GetChangeProof(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 		func(ctx context.Context, request *ChangeProofRequest, _ *merkledb.Database) (*merkledb.ChangeProof, error) {
-			return dbToSync.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.Limit))
+			return dbToSync.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.KeyLimit))
 		},
 	).AnyTimes()
 
@@ -614,13 +799,13 @@ func Test_Sync_Result_Correct_Root_Update_Root_During(t *testing.T) {
 		client.EXPECT().GetRangeProof(gomock.Any(), gomock.Any()).DoAndReturn(
 			func(ctx context.Context, request *RangeProofRequest) (*merkledb.RangeProof, error) {
 				<-updatedRootChan
-				return dbToSync.GetRangeProofAtRoot(ctx, request.Root, request.Start, request.End, int(request.Limit))
+				return dbToSync.GetRangeProofAtRoot(ctx, request.Root, request.Start, request.End, int(request.KeyLimit))
 			},
 		).AnyTimes()
 		client.EXPECT().GetChangeProof(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 			func(ctx context.Context, request *ChangeProofRequest, _ *merkledb.Database) (*merkledb.ChangeProof, error) {
 				<-updatedRootChan
-				return dbToSync.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.Limit))
+				return dbToSync.GetChangeProof(ctx, request.StartingRoot, request.EndingRoot, request.Start, request.End, int(request.KeyLimit))
 			},
 		).AnyTimes()
 
@@ -8,19 +8,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/VidarSolutions/avalanchego/database"
 	"github.com/VidarSolutions/avalanchego/ids"
 	"github.com/VidarSolutions/avalanchego/utils/logging"
+	"github.com/VidarSolutions/avalanchego/utils/set"
 	"github.com/VidarSolutions/avalanchego/x/merkledb"
 )
 
 const (
-	defaultLeafRequestLimit = 1024
-	maxTokenWaitTime        = 5 * time.Second
+	defaultLeafRequestLimit     = 1024
+	defaultRequestByteSizeLimit = maxByteSizeLimit
+	maxTokenWaitTime            = 5 * time.Second
+
+	// The number of work items that are completed between persisting the
+	// progress of the sync.
+	progressPersistInterval = 32
 )
 
 var (
@@ -86,6 +94,21 @@ type StateSyncManager struct {
 	unprocessedWorkCond sync.Cond
 	// [workLock] must be held while accessing [processedWork].
 	processedWork *syncWorkHeap
+	// The work items being processed whose results haven't been added to
+	// [unprocessedWork] or [processedWork] yet.
+	// [workLock] must be held while accessing [processingWork].
+	processingWork set.Set[*syncWorkItem]
+	// The sequence number of the next work item to be processed.
+	// [workLock] must be held while accessing [nextInFlight].
+	nextInFlight uint64
+	// The number of work items completed since the progress was last
+	// persisted.
+	// [workLock] must be held while accessing [completedSinceProgress].
+	completedSinceProgress int
+
+	// Held while the progress is being persisted. Must not be acquired while
+	// holding [workLock].
+	persistLock sync.Mutex
 
 	// When this is closed:
 	// - [closed] is true.
@@ -115,6 +138,11 @@ type StateSyncConfig struct {
 	SimultaneousWorkLimit int
 	Log                   logging.Logger
 	TargetRoot            ids.ID
+	// If non-nil, the progress of the sync is persisted to [ProgressDB] so
+	// that the sync resumes from it, rather than starting over, if it's
+	// restarted with the same [SyncDB].
+	// The progress is deleted once the sync completes.
+	ProgressDB database.Database
 }
 
 func NewStateSyncManager(config StateSyncConfig) (*StateSyncManager, error) {
@@ -147,18 +175,31 @@ func NewStateSyncManager(config StateSyncConfig) (*StateSyncManager, error) {
 
 func (m *StateSyncManager) StartSyncing(ctx context.Context) error {
 	m.workLock.Lock()
-	defer m.workLock.Unlock()
-
 	if m.syncing {
+		m.workLock.Unlock()
 		return ErrAlreadyStarted
 	}
 
-	// Add work item to fetch the entire key range.
-	// Note that this will be the first work item to be processed.
-	m.unprocessedWork.Insert(newWorkItem(ids.Empty, nil, nil, lowPriority))
+	resumed, err := m.resumeProgress(ctx)
+	if err != nil {
+		m.workLock.Unlock()
+		return err
+	}
+	if !resumed {
+		// Add work item to fetch the entire key range.
+		// Note that this will be the first work item to be processed.
+		m.unprocessedWork.Insert(newWorkItem(ids.Empty, nil, nil, lowPriority))
+	}
 
 	m.syncing = true
 	ctx, m.cancelCtx = context.WithCancel(ctx)
+	m.workLock.Unlock()
+
+	// Replace any previous progress before work items are processed.
+	if err := m.persistProgress(); err != nil {
+		m.Close()
+		return err
+	}
 
 	go m.sync(ctx)
 	return nil
@@ -173,6 +214,11 @@ func (m *StateSyncManager) sync(ctx context.Context) {
 		// because Close() will try to acquire [m.workLock].
 		// Invariant: [m.workLock] is held when we return from this goroutine.
 		m.workLock.Unlock()
+		// Persist the work items completed since the last time the progress
+		// was persisted. This does nothing if [m] was closed.
+		if err := m.persistProgress(); err != nil {
+			m.setError(err)
+		}
 		m.Close()
 	}()
 
@@ -198,11 +244,14 @@ func (m *StateSyncManager) sync(ctx context.Context) {
 		}
 		m.processingWorkItems++
 		workItem := m.unprocessedWork.GetWork()
+		m.processingWork.Add(workItem)
+		seq := m.nextInFlight
+		m.nextInFlight++
 		// TODO danlaine: We won't release [m.workLock] until
 		// we've started a goroutine for each available work item.
 		// We can't apply proofs we receive until we release [m.workLock].
 		// Is this OK? Is it possible we end up with too many goroutines?
-		go m.doWork(ctx, workItem)
+		go m.doWork(ctx, workItem, seq)
 	}
 }
 
@@ -229,8 +278,9 @@ func (m *StateSyncManager) Close() {
 }
 
 // Processes [item] by fetching and applying a change or range proof.
+// [seq] is the sequence number that [item] was dispatched with.
 // Assumes [m.workLock] is not held.
-func (m *StateSyncManager) doWork(ctx context.Context, item *syncWorkItem) {
+func (m *StateSyncManager) doWork(ctx context.Context, item *syncWorkItem, seq uint64) {
 	// Wait until we get a work token or we close.
 	select {
 	case <-m.workTokens:
@@ -250,6 +300,15 @@ func (m *StateSyncManager) doWork(ctx context.Context, item *syncWorkItem) {
 		m.workLock.Unlock()
 	}()
 
+	if m.config.ProgressDB != nil {
+		// The persisted progress may claim that this range is synced, so
+		// record that it's being modified before applying any proofs.
+		if err := putInFlight(m.config.ProgressDB, seq, newSyncProgressWorkItem(item)); err != nil {
+			m.setError(err)
+			return
+		}
+	}
+
 	if item.LocalRootID == ids.Empty {
 		// the keys in this range have not been downloaded, so get all key/values
 		m.getAndApplyRangeProof(ctx, item)
@@ -276,7 +335,8 @@ func (m *StateSyncManager) getAndApplyChangeProof(ctx context.Context, workItem
 			EndingRoot:   rootID,
 			Start:        workItem.start,
 			End:          workItem.end,
-			Limit:        defaultLeafRequestLimit,
+			KeyLimit:     defaultLeafRequestLimit,
+			BytesLimit:   defaultRequestByteSizeLimit,
 		},
 		m.config.SyncDB,
 	)
@@ -296,8 +356,17 @@ func (m *StateSyncManager) getAndApplyChangeProof(ctx context.Context, workItem
 	// Add this range as a fresh uncompleted work item to the work heap.
 	// TODO danlaine send range proof instead of failure notification
 	if !changeproof.HadRootsInHistory {
+		m.workLock.Lock()
+		m.processingWork.Remove(workItem)
 		workItem.LocalRootID = ids.Empty
 		m.enqueueWork(workItem)
+		shouldPersist := m.markCompleted()
+		m.workLock.Unlock()
+		m.unprocessedWorkCond.Signal()
+
+		if shouldPersist {
+			m.tryPersistProgress()
+		}
 		return
 	}
 
@@ -329,10 +398,11 @@ func (m *StateSyncManager) getAndApplyRangeProof(ctx context.Context, workItem *
 	rootID := m.getTargetRoot()
 	proof, err := m.config.Client.GetRangeProof(ctx,
 		&RangeProofRequest{
-			Root:  rootID,
-			Start: workItem.start,
-			End:   workItem.end,
-			Limit: defaultLeafRequestLimit,
+			Root:       rootID,
+			Start:      workItem.start,
+			End:        workItem.end,
+			KeyLimit:   defaultLeafRequestLimit,
+			BytesLimit: defaultRequestByteSizeLimit,
 		},
 	)
 	if err != nil {
@@ -376,16 +446,18 @@ func (m *StateSyncManager) findNextKey(
 	localProofNodes := proofOfStart.Path
 
 	var result []byte
+	branchFactor := m.config.SyncDB.BranchFactor()
 	localIndex := len(localProofNodes) - 1
 	receivedIndex := len(receivedProofNodes) - 1
-	startKeyPath := merkledb.SerializedPath{Value: start, NibbleLength: 2 * len(start)}
+	startKeyPath := merkledb.NewSerializedPath(start, branchFactor)
 
 	// Just return the start key when the proof nodes contain keys that are not prefixes of the start key
 	// this occurs mostly in change proofs where the largest returned key was a deleted key.
 	// Since the key was deleted, it no longer shows up in the proof nodes
 	// for now, just fallback to using the start key, which is always correct.
 	// TODO: determine a more accurate nextKey in this scenario
-	if !startKeyPath.HasPrefix(localProofNodes[localIndex].KeyPath) || !startKeyPath.HasPrefix(receivedProofNodes[receivedIndex].KeyPath) {
+	if !startKeyPath.HasPrefix(localProofNodes[localIndex].KeyPath, branchFactor) ||
+		!startKeyPath.HasPrefix(receivedProofNodes[receivedIndex].KeyPath, branchFactor) {
 		return start, nil
 	}
 
@@ -395,17 +467,17 @@ func (m *StateSyncManager) findNextKey(
 		receivedNode := receivedProofNodes[receivedIndex]
 		// the two nodes have the same key
 		if localNode.KeyPath.Equal(receivedNode.KeyPath) {
-			startingChildIndex := byte(0)
-			if localNode.KeyPath.NibbleLength < startKeyPath.NibbleLength {
-				startingChildIndex = startKeyPath.NibbleVal(localNode.KeyPath.NibbleLength) + 1
+			startingChildIndex := 0
+			if localNode.KeyPath.TokenLength < startKeyPath.TokenLength {
+				startingChildIndex = int(startKeyPath.Token(localNode.KeyPath.TokenLength, branchFactor)) + 1
 			}
 			// the two nodes have the same path, so ensure that all children have matching ids
-			for childIndex := startingChildIndex; childIndex < 16; childIndex++ {
-				receivedChildID, receiveOk := receivedNode.Children[childIndex]
-				localChildID, localOk := localNode.Children[childIndex]
+			for childIndex := startingChildIndex; childIndex < int(branchFactor); childIndex++ {
+				receivedChildID, receiveOk := receivedNode.Children[byte(childIndex)]
+				localChildID, localOk := localNode.Children[byte(childIndex)]
 				// if they both don't have a child or have matching children, continue
 				if (receiveOk || localOk) && receivedChildID != localChildID {
-					result = localNode.KeyPath.AppendNibble(childIndex).Value
+					result = localNode.KeyPath.AppendToken(byte(childIndex), branchFactor).Value
 					break
 				}
 			}
@@ -420,7 +492,7 @@ func (m *StateSyncManager) findNextKey(
 
 		var branchNode merkledb.ProofNode
 
-		if receivedNode.KeyPath.NibbleLength > localNode.KeyPath.NibbleLength {
+		if receivedNode.KeyPath.TokenLength > localNode.KeyPath.TokenLength {
 			// the received proof has an extra node due to a branch that is not present locally
 			branchNode = receivedNode
 			receivedIndex--
@@ -431,9 +503,9 @@ func (m *StateSyncManager) findNextKey(
 		}
 
 		// the two nodes have different paths, so find where they branched
-		for nextKeyNibble := startKeyPath.NibbleVal(branchNode.KeyPath.NibbleLength) + 1; nextKeyNibble < 16; nextKeyNibble++ {
-			if _, ok := branchNode.Children[nextKeyNibble]; ok {
-				result = branchNode.KeyPath.AppendNibble(nextKeyNibble).Value
+		for nextKeyToken := int(startKeyPath.Token(branchNode.KeyPath.TokenLength, branchFactor)) + 1; nextKeyToken < int(branchFactor); nextKeyToken++ {
+			if _, ok := branchNode.Children[byte(nextKeyToken)]; ok {
+				result = branchNode.KeyPath.AppendToken(byte(nextKeyToken), branchFactor).Value
 				break
 			}
 		}
@@ -480,7 +552,20 @@ func (m *StateSyncManager) Wait(ctx context.Context) error {
 		return fmt.Errorf("%w: expected %s, got %s", ErrFinishedWithUnexpectedRoot, m.getTargetRoot(), root)
 	}
 	m.config.Log.Info("completed", zap.String("new root", root.String()))
-	return nil
+
+	if m.config.ProgressDB == nil {
+		return nil
+	}
+
+	// Wait for any progress that is being persisted to be written before
+	// deleting it.
+	m.persistLock.Lock()
+	defer m.persistLock.Unlock()
+
+	if err := m.config.ProgressDB.Delete(progressKey); err != nil {
+		return err
+	}
+	return deleteInFlight(m.config.ProgressDB, math.MaxUint64)
 }
 
 func (m *StateSyncManager) UpdateSyncTarget(syncTargetRoot ids.ID) error {
@@ -494,14 +579,13 @@ func (m *StateSyncManager) UpdateSyncTarget(syncTargetRoot ids.ID) error {
 	}
 
 	m.syncTargetLock.Lock()
-	defer m.syncTargetLock.Unlock()
-
 	if m.config.TargetRoot == syncTargetRoot {
 		// the target hasn't changed, so there is nothing to do
+		m.syncTargetLock.Unlock()
 		return nil
 	}
-
 	m.config.TargetRoot = syncTargetRoot
+	m.syncTargetLock.Unlock()
 
 	// move all completed ranges into the work heap with high priority
 	shouldSignal := m.processedWork.Len() > 0
@@ -540,9 +624,174 @@ func (m *StateSyncManager) setError(err error) {
 	go m.Close()
 }
 
+// Adds the work items from the persisted progress, if any, to the heaps.
+// Returns true if the progress was resumed.
+// Assumes [m.workLock] is held.
+func (m *StateSyncManager) resumeProgress(ctx context.Context) (bool, error) {
+	if m.config.ProgressDB == nil {
+		return false, nil
+	}
+
+	inFlightItems, nextInFlight, err := getInFlight(m.config.ProgressDB)
+	if err != nil {
+		return false, err
+	}
+	// Continue after the previous sequence numbers so that the in flight work
+	// items are deleted once the progress is persisted.
+	m.nextInFlight = nextInFlight
+
+	progress, err := getSyncProgress(m.config.ProgressDB)
+	switch {
+	case err == database.ErrNotFound:
+		return false, nil
+	case errors.Is(err, errInvalidProgress):
+		m.config.Log.Warn("discarding sync progress",
+			zap.Error(err),
+		)
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	// The ranges that were being modified when the progress was persisted
+	// may have been partially updated, so they must be synced again.
+	for i, item := range progress.WorkItems {
+		for _, inFlightItem := range inFlightItems {
+			if item.overlaps(inFlightItem) {
+				progress.WorkItems[i].LocalRootID = ids.Empty
+				break
+			}
+		}
+	}
+
+	// Verify the sync DB against the progress. If every range was synced to
+	// the same root, the sync DB must have that root. Otherwise, the sync DB
+	// was modified after the progress was persisted.
+	localRootIDs := set.Set[ids.ID]{}
+	for _, item := range progress.WorkItems {
+		localRootIDs.Add(item.LocalRootID)
+	}
+	if localRootID, ok := localRootIDs.Peek(); ok && localRootIDs.Len() == 1 && localRootID != ids.Empty {
+		rootID, err := m.config.SyncDB.GetMerkleRoot(ctx)
+		if err != nil {
+			return false, err
+		}
+		if rootID != localRootID {
+			m.config.Log.Warn("discarding sync progress that doesn't match the sync database",
+				zap.Stringer("expectedRoot", localRootID),
+				zap.Stringer("root", rootID),
+			)
+			return false, nil
+		}
+	}
+
+	targetRootID := m.getTargetRoot()
+	for _, progressItem := range progress.WorkItems {
+		item := progressItem.workItem()
+		switch item.LocalRootID {
+		case targetRootID:
+			m.processedWork.MergeInsert(item)
+		case ids.Empty:
+			m.unprocessedWork.Insert(item)
+		default:
+			// The range was synced to a previous target, so update it with
+			// high priority.
+			item.priority = highPriority
+			m.unprocessedWork.Insert(item)
+		}
+	}
+
+	m.config.Log.Info("resuming sync",
+		zap.Stringer("previousTargetRoot", progress.TargetRoot),
+		zap.Stringer("targetRoot", targetRootID),
+		zap.Int("numWorkItems", len(progress.WorkItems)),
+	)
+	return true, nil
+}
+
+// Records that a work item was completed. Returns true if the progress
+// should be persisted.
+// Assumes [m.workLock] is held.
+func (m *StateSyncManager) markCompleted() bool {
+	m.completedSinceProgress++
+	return m.completedSinceProgress >= progressPersistInterval
+}
+
+// Persists the progress, unless it's already being persisted. Errors are
+// fatal.
+// Assumes [m.workLock] is not held.
+func (m *StateSyncManager) tryPersistProgress() {
+	if m.config.ProgressDB == nil || !m.persistLock.TryLock() {
+		return
+	}
+	defer m.persistLock.Unlock()
+
+	if err := m.writeProgress(); err != nil {
+		m.setError(err)
+	}
+}
+
+// Persists the current work items, if [m.config.ProgressDB] is set.
+// Assumes [m.workLock] is not held.
+func (m *StateSyncManager) persistProgress() error {
+	if m.config.ProgressDB == nil {
+		return nil
+	}
+
+	m.persistLock.Lock()
+	defer m.persistLock.Unlock()
+
+	return m.writeProgress()
+}
+
+// Writes the current work items to [m.config.ProgressDB].
+// The work items that are being processed are persisted as not having been
+// synced, because their proofs may have been partially applied.
+// Assumes [m.persistLock] is held and [m.workLock] is not held.
+func (m *StateSyncManager) writeProgress() error {
+	m.workLock.Lock()
+	select {
+	case <-m.syncDoneChan:
+		// The heaps no longer track the work items once [m] is closed.
+		m.workLock.Unlock()
+		return nil
+	default:
+	}
+
+	processedItems := m.processedWork.Items()
+	unprocessedItems := m.unprocessedWork.Items()
+	progress := &syncProgress{
+		TargetRoot: m.getTargetRoot(),
+		WorkItems:  make([]syncProgressWorkItem, 0, len(processedItems)+len(unprocessedItems)+m.processingWork.Len()),
+	}
+	for _, item := range processedItems {
+		progress.WorkItems = append(progress.WorkItems, newSyncProgressWorkItem(item))
+	}
+	for _, item := range unprocessedItems {
+		progress.WorkItems = append(progress.WorkItems, newSyncProgressWorkItem(item))
+	}
+	for item := range m.processingWork {
+		progressItem := newSyncProgressWorkItem(item)
+		progressItem.LocalRootID = ids.Empty
+		progress.WorkItems = append(progress.WorkItems, progressItem)
+	}
+	// Every work item dispatched before now is either persisted as not
+	// synced or has been completed.
+	nextInFlight := m.nextInFlight
+	m.completedSinceProgress = 0
+	m.workLock.Unlock()
+
+	if err := putSyncProgress(m.config.ProgressDB, progress); err != nil {
+		return err
+	}
+	return deleteInFlight(m.config.ProgressDB, nextInFlight)
+}
+
 // Mark the range [start, end] as synced up to [rootID].
 // Assumes [m.workLock] is not held.
 func (m *StateSyncManager) completeWorkItem(ctx context.Context, workItem *syncWorkItem, largestHandledKey []byte, rootID ids.ID, proofOfLargestKey []merkledb.ProofNode) {
+	var remainingWorkItem *syncWorkItem
+
 	// if the last key is equal to the end, then the full range is completed
 	if !bytes.Equal(largestHandledKey, workItem.end) {
 		// find the next key to start querying by comparing the proofs for the last completed key
@@ -557,7 +806,7 @@ func (m *StateSyncManager) completeWorkItem(ctx context.Context, workItem *syncW
 		// nextStartKey being nil indicates that the entire range has been completed
 		if nextStartKey != nil {
 			// the full range wasn't completed, so enqueue a new work item for the range [nextStartKey, workItem.end]
-			m.enqueueWork(newWorkItem(workItem.LocalRootID, nextStartKey, workItem.end, workItem.priority))
+			remainingWorkItem = newWorkItem(workItem.LocalRootID, nextStartKey, workItem.end, workItem.priority)
 			largestHandledKey = nextStartKey
 		}
 	}
@@ -567,28 +816,37 @@ func (m *StateSyncManager) completeWorkItem(ctx context.Context, workItem *syncW
 		zap.Binary("start", workItem.start),
 		zap.Binary("end", largestHandledKey),
 	)
-	if m.getTargetRoot() == rootID {
-		m.workLock.Lock()
-		defer m.workLock.Unlock()
 
+	// [workItem] is replaced by the remaining and completed ranges atomically
+	// so that the persisted progress never contains overlapping ranges.
+	m.workLock.Lock()
+	m.processingWork.Remove(workItem)
+	if remainingWorkItem != nil {
+		m.enqueueWork(remainingWorkItem)
+	}
+
+	targetRootID := m.getTargetRoot()
+	if targetRootID == rootID {
 		m.processedWork.MergeInsert(newWorkItem(rootID, workItem.start, largestHandledKey, workItem.priority))
 	} else {
 		// the root has changed, so reinsert with high priority
 		m.enqueueWork(newWorkItem(rootID, workItem.start, largestHandledKey, highPriority))
 	}
+	shouldPersist := m.markCompleted()
+	m.workLock.Unlock()
+	m.unprocessedWorkCond.Signal()
+
+	if shouldPersist {
+		m.tryPersistProgress()
+	}
 }
 
 // Queue the given key range to be fetched and applied.
 // If there are sufficiently few unprocessed/processing work items,
 // splits the range into two items and queues them both.
-// Assumes [m.workLock] is not held.
+// Assumes [m.workLock] is held.
+// The caller must signal [m.unprocessedWorkCond].
 func (m *StateSyncManager) enqueueWork(item *syncWorkItem) {
-	m.workLock.Lock()
-	defer func() {
-		m.workLock.Unlock()
-		m.unprocessedWorkCond.Signal()
-	}()
-
 	if m.processingWorkItems+m.unprocessedWork.Len() > 2*m.config.SimultaneousWorkLimit {
 		// There are too many work items already, don't split the range
 		m.unprocessedWork.Insert(item)
@@ -148,6 +148,15 @@ func (wh *syncWorkHeap) MergeInsert(item *syncWorkItem) {
 	}
 }
 
+// Returns the work items in the heap in no particular order.
+func (wh *syncWorkHeap) Items() []*syncWorkItem {
+	items := make([]*syncWorkItem, len(wh.priorityHeap))
+	for i, item := range wh.priorityHeap {
+		items[i] = item.workItem
+	}
+	return items
+}
+
 // Deletes [item] from the heap.
 func (wh *syncWorkHeap) remove(item *heapItem) {
 	oldIndex := item.heapIndex